@@ -2,6 +2,8 @@ package v1alpha1
 
 const (
 	AnnotationKeyRefresh = "kargo.akuity.io/refresh"
+	AnnotationKeyAbort   = "kargo.akuity.io/abort"
+	AnnotationKeyForce   = "kargo.akuity.io/force"
 
 	AnnotationKeyOIDCEmails   = "rbac.kargo.akuity.io/email"
 	AnnotationKeyOIDCGroups   = "rbac.kargo.akuity.io/groups"
@@ -2,6 +2,11 @@ package v1alpha1
 
 import (
 	"context"
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,7 +42,9 @@ func GetFreight(
 //
 //  1. No upstreamStages are specified
 //     OR
-//  2. The Freight has has been verified in ANY of the specified upstream stages
+//  2. The Freight has has been verified in ANY (or, if the specified mode is
+//     UpstreamStagesModeAll, ALL) of the specified upstream stages for at least
+//     as long as each upstream stage's minimum soak duration
 //     OR
 //  3. The Freight is approved for the specified stage
 //
@@ -47,15 +54,14 @@ func GetFreight(
 func IsFreightAvailable(
 	freight *Freight,
 	stage string,
-	upstreamStages []string,
+	upstreamStages []StageSubscription,
+	mode UpstreamStagesMode,
 ) bool {
 	if len(upstreamStages) == 0 {
 		return true
 	}
-	for _, stage := range upstreamStages {
-		if _, ok := freight.Status.VerifiedIn[stage]; ok {
-			return true
-		}
+	if IsFreightVerifiedUpstream(freight, upstreamStages, mode, time.Now()) {
+		return true
 	}
 	if stage != "" {
 		if _, ok := freight.Status.ApprovedFor[stage]; ok {
@@ -64,3 +70,109 @@ func IsFreightAvailable(
 	}
 	return false
 }
+
+// IsFreightVerifiedUpstream answers whether, as of the specified time, the
+// specified Freight has been verified in the specified upstream stages for at
+// least as long as each upstream stage's minimum soak duration. When the
+// specified mode is UpstreamStagesModeAll, this must be true of ALL the
+// upstream stages. Otherwise, it need only be true of ANY of them.
+func IsFreightVerifiedUpstream(
+	freight *Freight,
+	upstreamStages []StageSubscription,
+	mode UpstreamStagesMode,
+	now time.Time,
+) bool {
+	if len(upstreamStages) == 0 {
+		return false
+	}
+	for _, upstreamStage := range upstreamStages {
+		ok := isFreightVerifiedIn(freight, upstreamStage, now)
+		if mode == UpstreamStagesModeAll && !ok {
+			return false
+		}
+		if mode != UpstreamStagesModeAll && ok {
+			return true
+		}
+	}
+	return mode == UpstreamStagesModeAll
+}
+
+func isFreightVerifiedIn(
+	freight *Freight,
+	upstreamStage StageSubscription,
+	now time.Time,
+) bool {
+	if _, ok := freight.Status.VerifiedIn[upstreamStage.Name]; !ok {
+		return false
+	}
+	return GetFreightSoakTimeRemaining(freight, upstreamStage, now) == 0
+}
+
+// GetFreightSoakTimeRemaining returns how much longer, as of the specified
+// time, the specified Freight must remain verified in the specified upstream
+// stage before that stage's minimum soak duration is satisfied. Zero is
+// returned if the upstream stage specifies no minimum soak duration or if the
+// minimum soak duration has already been satisfied. If the time at which the
+// Freight was verified is unknown (because it was verified before such times
+// were recorded), the Freight is treated as having already soaked for long
+// enough, since it was verified at least that long ago.
+func GetFreightSoakTimeRemaining(
+	freight *Freight,
+	upstreamStage StageSubscription,
+	now time.Time,
+) time.Duration {
+	if upstreamStage.MinSoakDuration == nil {
+		return 0
+	}
+	verified, ok := freight.Status.VerifiedIn[upstreamStage.Name]
+	if !ok {
+		return 0
+	}
+	if verified.VerifiedAt == nil {
+		return 0
+	}
+	soakedAt := verified.VerifiedAt.Add(upstreamStage.MinSoakDuration.Duration)
+	if remaining := soakedAt.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// ComposeFreight combines the specified pieces of Freight, indexed by the name
+// of the Warehouse from which each originated, into a single composite
+// FreightReference. The composite's ID is derived deterministically from the
+// IDs of its constituents, and its Commits, Images, and Charts are the combined
+// artifacts of all of them.
+func ComposeFreight(freightByWarehouse map[string]FreightReference) FreightReference {
+	warehouses := make([]string, 0, len(freightByWarehouse))
+	for warehouse := range freightByWarehouse {
+		warehouses = append(warehouses, warehouse)
+	}
+	sort.Strings(warehouses)
+	composite := FreightReference{
+		Constituents: make([]FreightConstituent, 0, len(warehouses)),
+	}
+	constituentKeys := make([]string, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		freight := freightByWarehouse[warehouse]
+		composite.Constituents = append(
+			composite.Constituents,
+			FreightConstituent{
+				ID:        freight.ID,
+				Warehouse: warehouse,
+			},
+		)
+		constituentKeys = append(
+			constituentKeys,
+			fmt.Sprintf("%s:%s", warehouse, freight.ID),
+		)
+		composite.Commits = append(composite.Commits, freight.Commits...)
+		composite.Images = append(composite.Images, freight.Images...)
+		composite.Charts = append(composite.Charts, freight.Charts...)
+	}
+	composite.ID = fmt.Sprintf(
+		"%x",
+		sha1.Sum([]byte(strings.Join(constituentKeys, "|"))),
+	)
+	return composite
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -67,7 +68,9 @@ func TestIsFreightAvailable(t *testing.T) {
 	testFreight := &Freight{
 		Status: FreightStatus{
 			VerifiedIn: map[string]VerifiedStage{
-				"fake-stage-1": {},
+				"fake-stage-1": {
+					VerifiedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+				},
 			},
 			ApprovedFor: map[string]ApprovedStage{
 				"fake-stage-2": {},
@@ -77,7 +80,8 @@ func TestIsFreightAvailable(t *testing.T) {
 	testCases := []struct {
 		name           string
 		stage          string
-		upstreamStages []string
+		upstreamStages []StageSubscription
+		mode           UpstreamStagesMode
 		available      bool
 	}{
 		{
@@ -86,19 +90,47 @@ func TestIsFreightAvailable(t *testing.T) {
 		},
 		{
 			name:           "verified in an upstream Stage",
-			upstreamStages: []string{"fake-stage-1"},
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}},
+			available:      true,
+		},
+		{
+			name:           "verified in one of all upstream Stages",
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}, {Name: "fake-stage-3"}},
+			mode:           UpstreamStagesModeAll,
+			available:      false,
+		},
+		{
+			name:           "verified in all upstream Stages",
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}},
+			mode:           UpstreamStagesModeAll,
 			available:      true,
 		},
+		{
+			name: "verified in an upstream Stage for long enough",
+			upstreamStages: []StageSubscription{{
+				Name:            "fake-stage-1",
+				MinSoakDuration: &metav1.Duration{Duration: 30 * time.Minute},
+			}},
+			available: true,
+		},
+		{
+			name: "not verified in an upstream Stage for long enough",
+			upstreamStages: []StageSubscription{{
+				Name:            "fake-stage-1",
+				MinSoakDuration: &metav1.Duration{Duration: 2 * time.Hour},
+			}},
+			available: false,
+		},
 		{
 			name:           "approved for Stage",
 			stage:          "fake-stage-2",
-			upstreamStages: []string{"fake-stage-3"},
+			upstreamStages: []StageSubscription{{Name: "fake-stage-3"}},
 			available:      true,
 		},
 		{
 			name:           "unavailable",
 			stage:          "fake-stage-3",
-			upstreamStages: []string{"upstream-stage-2"},
+			upstreamStages: []StageSubscription{{Name: "upstream-stage-2"}},
 			available:      false,
 		},
 	}
@@ -111,8 +143,110 @@ func TestIsFreightAvailable(t *testing.T) {
 					testFreight,
 					testCase.stage,
 					testCase.upstreamStages,
+					testCase.mode,
 				),
 			)
 		})
 	}
 }
+
+func TestGetFreightSoakTimeRemaining(t *testing.T) {
+	now := time.Now()
+	testFreight := &Freight{
+		Status: FreightStatus{
+			VerifiedIn: map[string]VerifiedStage{
+				"fake-stage-1": {
+					VerifiedAt: &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+				"fake-stage-2": {},
+			},
+		},
+	}
+	testCases := []struct {
+		name          string
+		upstreamStage StageSubscription
+		remaining     time.Duration
+	}{
+		{
+			name:          "no minimum soak duration",
+			upstreamStage: StageSubscription{Name: "fake-stage-1"},
+		},
+		{
+			name: "not verified in upstream Stage",
+			upstreamStage: StageSubscription{
+				Name:            "fake-stage-3",
+				MinSoakDuration: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "verification time unknown",
+			upstreamStage: StageSubscription{
+				Name:            "fake-stage-2",
+				MinSoakDuration: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "soaked for long enough",
+			upstreamStage: StageSubscription{
+				Name:            "fake-stage-1",
+				MinSoakDuration: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		},
+		{
+			name: "still soaking",
+			upstreamStage: StageSubscription{
+				Name:            "fake-stage-1",
+				MinSoakDuration: &metav1.Duration{Duration: 90 * time.Minute},
+			},
+			remaining: 30 * time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.remaining,
+				GetFreightSoakTimeRemaining(testFreight, testCase.upstreamStage, now),
+			)
+		})
+	}
+}
+
+func TestComposeFreight(t *testing.T) {
+	freightByWarehouse := map[string]FreightReference{
+		"warehouse-b": {
+			ID:     "freight-b",
+			Images: []Image{{RepoURL: "fake-image-repo", Tag: "fake-tag"}},
+		},
+		"warehouse-a": {
+			ID:      "freight-a",
+			Commits: []GitCommit{{RepoURL: "fake-git-repo", ID: "fake-commit"}},
+		},
+	}
+	composite := ComposeFreight(freightByWarehouse)
+	require.NotEmpty(t, composite.ID)
+	require.Equal(
+		t,
+		[]FreightConstituent{
+			{ID: "freight-a", Warehouse: "warehouse-a"},
+			{ID: "freight-b", Warehouse: "warehouse-b"},
+		},
+		composite.Constituents,
+	)
+	require.Equal(
+		t,
+		[]GitCommit{{RepoURL: "fake-git-repo", ID: "fake-commit"}},
+		composite.Commits,
+	)
+	require.Equal(
+		t,
+		[]Image{{RepoURL: "fake-image-repo", Tag: "fake-tag"}},
+		composite.Images,
+	)
+	require.Empty(t, composite.Charts)
+	// Composing the same Freight again should yield the same ID
+	require.Equal(t, composite.ID, ComposeFreight(freightByWarehouse).ID)
+	// Changing any constituent should change the ID
+	freightByWarehouse["warehouse-a"] = FreightReference{ID: "another-freight-a"}
+	require.NotEqual(t, composite.ID, ComposeFreight(freightByWarehouse).ID)
+}
@@ -37,6 +37,18 @@ func (f *Freight) GetStatus() *FreightStatus {
 	return &f.Status
 }
 
+// GetWarehouse returns the name of the Warehouse from which the Freight
+// originated. If this cannot be determined, an empty string is returned.
+func (f *Freight) GetWarehouse() string {
+	for _, ownerRef := range f.OwnerReferences {
+		if ownerRef.APIVersion == GroupVersion.String() &&
+			ownerRef.Kind == "Warehouse" {
+			return ownerRef.Name
+		}
+	}
+	return ""
+}
+
 // UpdateID deterministically calculates a piece of Freight's ID based on its
 // contents and assigns it to the ID field.
 func (f *Freight) UpdateID() {
@@ -114,14 +126,47 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty"`
+	// PendingApprovalFor describes the Stages for which this Freight has
+	// received one or more approvals, but not yet enough to satisfy the Stage's
+	// approval policy. Once a Stage's approval policy is satisfied, the Stage is
+	// moved from this field to the ApprovedFor field.
+	PendingApprovalFor map[string]PendingApproval `json:"pendingApprovalFor,omitempty"`
 }
 
 // VerifiedStage describes a Stage in which Freight has been verified.
-type VerifiedStage struct{}
+type VerifiedStage struct {
+	// VerifiedAt is the time at which the Freight was verified in the Stage.
+	// Freight verified before such times were recorded lacks this field. For the
+	// purposes of minimum soak durations, such Freight is treated as having
+	// already soaked for long enough.
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+}
 
 // ApprovedStage describes a Stage for which Freight has been (manually)
 // approved.
-type ApprovedStage struct{}
+type ApprovedStage struct {
+	// ApprovedAt is the time at which the Freight became approved for the Stage.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+	// Approvals records the individual approvals that, together, satisfied the
+	// Stage's approval policy.
+	Approvals []Approval `json:"approvals,omitempty"`
+}
+
+// PendingApproval describes approvals of Freight for a Stage that do not yet
+// satisfy the Stage's approval policy.
+type PendingApproval struct {
+	// Approvals records the individual approvals received so far.
+	Approvals []Approval `json:"approvals,omitempty"`
+}
+
+// Approval records a single user's approval of Freight for a Stage.
+type Approval struct {
+	// Approver identifies the user who gave the approval. This is the user's
+	// email address, if known, and their subject otherwise.
+	Approver string `json:"approver"`
+	// ApprovedAt is the time at which the approval was given.
+	ApprovedAt metav1.Time `json:"approvedAt"`
+}
 
 //+kubebuilder:object:root=true
 
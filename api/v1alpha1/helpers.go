@@ -15,12 +15,28 @@ func refreshObject(
 	c client.Client,
 	obj client.Object,
 	nowFunc func() time.Time,
+) error {
+	return patchAnnotation(
+		ctx,
+		c,
+		obj,
+		AnnotationKeyRefresh,
+		nowFunc().UTC().Format(time.RFC3339),
+	)
+}
+
+func patchAnnotation(
+	ctx context.Context,
+	c client.Client,
+	obj client.Object,
+	key string,
+	value string,
 ) error {
 	patchBytes := []byte(
 		fmt.Sprintf(
 			`{"metadata":{"annotations":{"%s":"%s"}}}`,
-			AnnotationKeyRefresh,
-			nowFunc().UTC().Format(time.RFC3339),
+			key,
+			value,
 		),
 	)
 	patch := client.RawPatch(types.MergePatchType, patchBytes)
@@ -75,3 +75,30 @@ func ClearPromotionRefresh(
 	}
 	return clearRefreshObject(ctx, c, &newPromo)
 }
+
+// AbortPromotion requests that the Promotion controller abort a Promotion by
+// setting an annotation on the Promotion. The annotation value is the timestamp
+// of the request. Aborting a Promotion that is already in a terminal phase has
+// no effect.
+func AbortPromotion(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) (*Promotion, error) {
+	promo := &Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespacedName.Namespace,
+			Name:      namespacedName.Name,
+		},
+	}
+	if err := patchAnnotation(
+		ctx,
+		c,
+		promo,
+		AnnotationKeyAbort,
+		time.Now().UTC().Format(time.RFC3339),
+	); err != nil {
+		return nil, errors.Wrap(err, "abort")
+	}
+	return promo, nil
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,3 +63,55 @@ func TestGetPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestAbortPromotion(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(*Promotion, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(promo *Promotion, err error) {
+				require.Error(t, err)
+				require.Nil(t, promo)
+			},
+		},
+
+		{
+			name: "found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			assertions: func(promo *Promotion, err error) {
+				require.NoError(t, err)
+				require.Contains(t, promo.Annotations, AnnotationKeyAbort)
+				_, err = time.Parse(time.RFC3339, promo.Annotations[AnnotationKeyAbort])
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promo, err := AbortPromotion(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+				},
+			)
+			testCase.assertions(promo, err)
+		})
+	}
+}
@@ -26,12 +26,16 @@ const (
 	// reasons. Further information about the failure can be found in the
 	// Promotion's status.
 	PromotionPhaseErrored PromotionPhase = "Errored"
+	// PromotionPhaseAborted denotes a Promotion that was aborted by a user
+	// before it could complete. An aborted Promotion is never resumed.
+	PromotionPhaseAborted PromotionPhase = "Aborted"
 )
 
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
 	switch *p {
-	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored:
+	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored,
+		PromotionPhaseAborted:
 		return true
 	default:
 		return false
@@ -95,6 +99,56 @@ type PromotionStatus struct {
 	// Metadata holds arbitrary metadata set by promotion mechanisms
 	// (e.g. for display purposes, or internal bookkeeping)
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// StartedAt is the time at which the Promotion began Running.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// Attempts records prior attempts to execute the Promotion that ended in an
+	// error and were retried in accordance with the Stage's promotion policy.
+	Attempts []PromotionAttempt `json:"attempts,omitempty"`
+	// Hooks records the Kubernetes Jobs that have been run as pre- and
+	// post-promotion hooks and their results.
+	Hooks []PromotionHookStatus `json:"hooks,omitempty"`
+}
+
+// PromotionHookType is the type of a promotion hook.
+type PromotionHookType string
+
+const (
+	PromotionHookTypePrePromotion  PromotionHookType = "PrePromotion"
+	PromotionHookTypePostPromotion PromotionHookType = "PostPromotion"
+)
+
+// PromotionHookPhase describes where a promotion hook currently is in its
+// lifecycle.
+type PromotionHookPhase string
+
+const (
+	PromotionHookPhaseRunning   PromotionHookPhase = "Running"
+	PromotionHookPhaseSucceeded PromotionHookPhase = "Succeeded"
+	PromotionHookPhaseFailed    PromotionHookPhase = "Failed"
+)
+
+// PromotionHookStatus describes a Kubernetes Job run as a pre- or
+// post-promotion hook.
+type PromotionHookStatus struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+	// Type indicates whether the hook was run before or after promotion.
+	Type PromotionHookType `json:"type"`
+	// JobName is the name of the Kubernetes Job created for the hook.
+	JobName string `json:"jobName"`
+	// Phase describes where the hook currently is in its lifecycle.
+	Phase PromotionHookPhase `json:"phase"`
+	// Message is a display message about the hook's result.
+	Message string `json:"message,omitempty"`
+}
+
+// PromotionAttempt describes a prior attempt to execute a Promotion that ended
+// in an error.
+type PromotionAttempt struct {
+	// FinishedAt is the time at which the attempt ended.
+	FinishedAt metav1.Time `json:"finishedAt"`
+	// Message describes the error that ended the attempt.
+	Message string `json:"message,omitempty"`
 }
 
 // WithPhase returns a copy of PromotionStatus with the given phase
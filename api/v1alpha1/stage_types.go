@@ -2,6 +2,9 @@ package v1alpha1
 
 import (
 	"os"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -153,18 +156,238 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty"`
+	// PromotionPolicy describes how Promotions to this Stage are executed,
+	// including how long they may run and whether they are retried if they end
+	// in an error.
+	PromotionPolicy *StagePromotionPolicy `json:"promotionPolicy,omitempty"`
+	// ApprovalPolicy describes who may (manually) approve Freight for this Stage
+	// and how many distinct approvals are required before the Freight counts as
+	// approved. If unspecified, a single approval by any user with permission to
+	// approve Freight is sufficient.
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty"`
+	// Lock, if set, locks the Stage on its current Freight. While a Stage is
+	// locked, it will not be auto-promoted and Promotions to the Stage will be
+	// rejected unless they are explicitly forced.
+	Lock *StageLock `json:"lock,omitempty"`
+	// HealthChecks describes Kubernetes workloads whose rollout status should
+	// be factored into the Stage's health. This permits the health of Stages
+	// that are not deployed using Argo CD to be assessed.
+	HealthChecks *HealthChecks `json:"healthChecks,omitempty"`
+	// Hooks describes Kubernetes Jobs to be run before and after each
+	// Promotion to this Stage.
+	Hooks *PromotionHooks `json:"hooks,omitempty"`
+}
+
+// PromotionHooks describes Kubernetes Jobs to be run before and after each
+// Promotion to a Stage.
+type PromotionHooks struct {
+	// PrePromotion lists hooks to be run, in order, before any promotion
+	// mechanisms are applied. If any of these fails, the Promotion fails
+	// without any promotion mechanisms having been applied.
+	PrePromotion []PromotionHook `json:"prePromotion,omitempty"`
+	// PostPromotion lists hooks to be run, in order, after all promotion
+	// mechanisms have been applied successfully. If any of these fails, the
+	// Promotion fails.
+	PostPromotion []PromotionHook `json:"postPromotion,omitempty"`
+}
+
+// PromotionHook describes a Kubernetes Job to be run before or after a
+// Promotion. Information about the Promotion and the Freight being promoted
+// is made available to every container in the Job's Pod through the
+// following environment variables:
+//
+//   - KARGO_PROJECT
+//   - KARGO_STAGE
+//   - KARGO_PROMOTION
+//   - KARGO_FREIGHT
+//   - KARGO_FREIGHT_COMMITS (JSON)
+//   - KARGO_FREIGHT_IMAGES (JSON)
+//   - KARGO_FREIGHT_CHARTS (JSON)
+type PromotionHook struct {
+	// Name uniquely identifies the hook among all hooks of the same type.
+	//
+	//+kubebuilder:validation:Required
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=40
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// JobTemplate is a template for the Kubernetes Job that implements the
+	// hook. The Job is created in the Stage's namespace. Its Pod may only run as
+	// a ServiceAccount (including the namespace's default ServiceAccount) that
+	// is annotated with kargo.akuity.io/authorized-stage to permit its use by
+	// the Stage. The Pod may not mount Secrets or host paths, reference Secrets
+	// from its containers' environments, share the host's network, PID or IPC
+	// namespaces, or run privileged containers.
+	//
+	//+kubebuilder:validation:Required
+	//+kubebuilder:validation:Schemaless
+	//+kubebuilder:validation:Type=object
+	//+kubebuilder:pruning:PreserveUnknownFields
+	JobTemplate batchv1.JobTemplateSpec `json:"jobTemplate"`
+}
+
+// HealthChecks describes checks that should be performed to assess the health
+// of a Stage.
+type HealthChecks struct {
+	// Workloads lists Kubernetes workloads whose rollout status should be
+	// factored into the Stage's health.
+	Workloads []WorkloadHealthCheck `json:"workloads,omitempty"`
+}
+
+// WorkloadKind is the kind of a Kubernetes workload whose health can be
+// assessed natively.
+//
+// +kubebuilder:validation:Enum={Deployment,StatefulSet,DaemonSet}
+type WorkloadKind string
+
+const (
+	WorkloadKindDeployment  WorkloadKind = "Deployment"
+	WorkloadKindStatefulSet WorkloadKind = "StatefulSet"
+	WorkloadKindDaemonSet   WorkloadKind = "DaemonSet"
+)
+
+// WorkloadHealthCheck identifies one or more Kubernetes workloads of a single
+// kind, either by name or by label selector. Exactly one of the Name or
+// Selector fields must be specified. Workloads whose containers reference
+// images from the Stage's current Freight are not considered healthy until they
+// reference the Freight's versions of those images.
+type WorkloadHealthCheck struct {
+	// Kind is the kind of the workload(s).
+	//
+	//+kubebuilder:validation:Required
+	Kind WorkloadKind `json:"kind"`
+	// Namespace is the namespace of the workload(s). Unless this is the Stage's
+	// own namespace, the namespace must be annotated with
+	// kargo.akuity.io/authorized-stage to permit the Stage to read workloads in
+	// it.
+	//
+	//+kubebuilder:validation:Required
+	//+kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Name is the name of a single workload.
+	Name string `json:"name,omitempty"`
+	// Selector is a set of labels used to select any number of workloads.
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// StageLock describes a lock placed on a Stage.
+type StageLock struct {
+	// LockedBy identifies the user who locked the Stage. It is set by Kargo
+	// whenever the lock is placed or changed. Any value supplied is overwritten.
+	LockedBy string `json:"lockedBy,omitempty"`
+	// LockedAt is the time at which the Stage was locked.
+	LockedAt *metav1.Time `json:"lockedAt,omitempty"`
+	// Reason is a human-readable explanation of why the Stage was locked.
+	Reason string `json:"reason,omitempty"`
+	// Until is the time at which the lock expires. If unspecified, the lock
+	// remains in effect until it is explicitly removed.
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// IsActive answers whether, as of the specified time, the StageLock is in
+// effect. A nil StageLock is never in effect.
+func (s *StageLock) IsActive(now time.Time) bool {
+	if s == nil {
+		return false
+	}
+	return s.Until == nil || now.Before(s.Until.Time)
+}
+
+// ApprovalPolicy describes the requirements that must be satisfied before
+// Freight counts as (manually) approved for a Stage.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of distinct approvers who must approve a
+	// piece of Freight before it counts as approved for the Stage.
+	//
+	//+kubebuilder:default=1
+	//+kubebuilder:validation:Minimum=1
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty"`
+	// AllowedGroups lists groups, any member of which may approve Freight for
+	// the Stage. If neither this field nor the AllowedEmails field is specified,
+	// any user with permission to approve Freight may do so.
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+	// AllowedEmails lists the email addresses of users who may approve Freight
+	// for the Stage. Email addresses are compared case-insensitively. If neither
+	// this field nor the AllowedGroups field is specified, any user with
+	// permission to approve Freight may do so. The Kargo admin user may always
+	// approve Freight.
+	AllowedEmails []string `json:"allowedEmails,omitempty"`
+}
+
+// StagePromotionPolicy describes how Promotions to a Stage are executed.
+type StagePromotionPolicy struct {
+	// Timeout is the maximum amount of time a Promotion may spend Running before
+	// it is marked as Failed. e.g. "30m". If unspecified, a Promotion may run
+	// indefinitely.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry describes how Promotions that end in an error are retried. If
+	// unspecified, Promotions that end in an error are not retried.
+	Retry *PromotionRetryPolicy `json:"retry,omitempty"`
+}
+
+// PromotionRetryPolicy describes how Promotions that end in an error are
+// retried.
+type PromotionRetryPolicy struct {
+	// Limit is the maximum number of times a Promotion that ended in an error
+	// will be retried.
+	//
+	//+kubebuilder:validation:Minimum=0
+	Limit int32 `json:"limit,omitempty"`
+	// Backoff is the amount of time to wait before the first retry. The amount of
+	// time waited doubles with each subsequent retry. e.g. "10s".
+	//
+	//+kubebuilder:default="10s"
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
 }
 
 // Subscriptions describes a Stage's sources of Freight.
 type Subscriptions struct {
 	// Warehouse is a subscription to a Warehouse. This field is mutually
-	// exclusive with the UpstreamStages field.
+	// exclusive with the Warehouses and UpstreamStages fields.
 	Warehouse string `json:"warehouse,omitempty"`
+	// Warehouses is a subscription to multiple Warehouses. A Stage that
+	// subscribes to multiple Warehouses is promoted to a composite of the
+	// latest Freight from each of them. Such a Stage cannot itself be
+	// subscribed to by other Stages. This field is mutually exclusive with the
+	// Warehouse and UpstreamStages fields.
+	Warehouses []string `json:"warehouses,omitempty"`
 	// UpstreamStages identifies other Stages as potential sources of Freight
-	// for this Stage. This field is mutually exclusive with the Repos field.
+	// for this Stage. This field is mutually exclusive with the Warehouse and
+	// Warehouses fields.
 	UpstreamStages []StageSubscription `json:"upstreamStages,omitempty"`
+	// UpstreamStagesMode specifies whether Freight must have been verified in
+	// Any (the default) or All of the Stages listed in the UpstreamStages field
+	// before it becomes available to this Stage.
+	//
+	//+kubebuilder:default=Any
+	UpstreamStagesMode UpstreamStagesMode `json:"upstreamStagesMode,omitempty"`
 }
 
+// GetWarehouses returns the names of all Warehouses subscribed to, regardless
+// of whether the subscription is expressed using the Warehouse field or the
+// Warehouses field.
+func (s *Subscriptions) GetWarehouses() []string {
+	if s == nil {
+		return nil
+	}
+	if s.Warehouse != "" {
+		return []string{s.Warehouse}
+	}
+	return s.Warehouses
+}
+
+// +kubebuilder:validation:Enum={Any,All}
+type UpstreamStagesMode string
+
+const (
+	// UpstreamStagesModeAny indicates Freight is available to a Stage once it
+	// has been verified in any of the Stage's upstream Stages.
+	UpstreamStagesModeAny UpstreamStagesMode = "Any"
+	// UpstreamStagesModeAll indicates Freight is available to a Stage only once
+	// it has been verified in all of the Stage's upstream Stages.
+	UpstreamStagesModeAll UpstreamStagesMode = "All"
+)
+
 // StageSubscription defines a subscription to Freight from another Stage.
 type StageSubscription struct {
 	// Name specifies the name of a Stage.
@@ -172,6 +395,11 @@ type StageSubscription struct {
 	//+kubebuilder:validation:MinLength=1
 	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
 	Name string `json:"name"`
+	// MinSoakDuration is the minimum amount of time Freight must have been
+	// verified in the upstream Stage before it becomes available to this Stage.
+	// e.g. "1h". If unspecified, Freight becomes available as soon as it is
+	// verified in the upstream Stage.
+	MinSoakDuration *metav1.Duration `json:"minSoakDuration,omitempty"`
 }
 
 // PromotionMechanisms describes how to incorporate Freight into a Stage.
@@ -186,6 +414,11 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty"`
+	// FluxUpdates describes updates that should be applied to Flux resources to
+	// incorporate Freight into the Stage. This field is optional, as such
+	// actions are not required in all cases. Note that all updates specified by
+	// the GitRepoUpdates field, if any, are applied BEFORE these.
+	FluxUpdates []FluxUpdate `json:"fluxUpdates,omitempty"`
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -390,6 +623,16 @@ type ArgoCDAppUpdate struct {
 	// SourceUpdates describes updates to be applied to various sources of the
 	// specified Argo CD Application resource.
 	SourceUpdates []ArgoCDSourceUpdate `json:"sourceUpdates,omitempty"`
+	// SyncOperation describes the sync operation to be initiated against the
+	// specified Argo CD Application resource once its sources have been updated.
+	// When this field is specified, the Promotion does not complete until the
+	// sync operation has completed and a sync operation that does not succeed
+	// fails the Promotion. When this field is not specified, a sync operation
+	// using the Application's own sync options is initiated, but Kargo does not
+	// wait for it to complete.
+	//
+	//+kubebuilder:validation:Optional
+	SyncOperation *ArgoCDSyncOperation `json:"syncOperation,omitempty"`
 }
 
 func (a *ArgoCDAppUpdate) AppNamespaceOrDefault() string {
@@ -437,6 +680,44 @@ type ArgoCDSourceUpdate struct {
 	Helm *ArgoCDHelm `json:"helm,omitempty"`
 }
 
+// ArgoCDSyncOperation describes an Argo CD sync operation to be initiated
+// against an Argo CD Application resource at the revision(s) being promoted.
+type ArgoCDSyncOperation struct {
+	// Prune specifies whether resources that are no longer defined in the
+	// Application's source(s) should be deleted during the sync operation.
+	Prune bool `json:"prune,omitempty"`
+	// Force specifies whether resources should be forcibly replaced (i.e.
+	// deleted and re-created) if they cannot be updated by applying changes.
+	Force bool `json:"force,omitempty"`
+	// Resources optionally limits the sync operation to the specified subset of
+	// the Application's resources. If left unspecified, all of the
+	// Application's resources are synced.
+	Resources []ArgoCDSyncResource `json:"resources,omitempty"`
+	// SyncOptions specifies Argo CD sync options (e.g. "ServerSideApply=true")
+	// to be used for the sync operation. If left unspecified, the sync options
+	// from the Application's sync policy are used.
+	SyncOptions []string `json:"syncOptions,omitempty"`
+}
+
+// ArgoCDSyncResource identifies a single resource belonging to an Argo CD
+// Application.
+type ArgoCDSyncResource struct {
+	// Group is the API group of the resource. It should be left unspecified for
+	// resources in the core API group.
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resource. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+	// Name is the name of the resource. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace is the namespace of the resource. It should be left unspecified
+	// for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // ArgoCDKustomize describes updates to an Argo CD Application source's
 // Kustomize-specific attributes to incorporate newly observed Freight into a
 // Stage.
@@ -498,6 +779,124 @@ type ArgoCDHelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value"`
 }
 
+// FluxResourceKind is the kind of a Flux resource that can be updated to
+// incorporate Freight into a Stage.
+//
+// +kubebuilder:validation:Enum={HelmRelease,OCIRepository,GitRepository,Kustomization}
+type FluxResourceKind string
+
+const (
+	FluxResourceKindHelmRelease   FluxResourceKind = "HelmRelease"
+	FluxResourceKindOCIRepository FluxResourceKind = "OCIRepository"
+	FluxResourceKindGitRepository FluxResourceKind = "GitRepository"
+	FluxResourceKindKustomization FluxResourceKind = "Kustomization"
+)
+
+// FluxUpdate describes updates that should be applied to a Flux resource to
+// incorporate Freight into a Stage.
+type FluxUpdate struct {
+	// Kind is the kind of the Flux resource to be updated. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:Required
+	Kind FluxResourceKind `json:"kind"`
+	// Namespace is the namespace of the Flux resource to be updated. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource to be updated. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Name string `json:"name"`
+	// HelmRelease describes updates to a HelmRelease. This field may only be
+	// specified when Kind is HelmRelease.
+	HelmRelease *FluxHelmReleaseUpdate `json:"helmRelease,omitempty"`
+	// OCIRepository describes updates to an OCIRepository. This field may only
+	// be specified when Kind is OCIRepository. If left unspecified, an
+	// OCIRepository's ref is updated to the tag of the matching image or chart
+	// from the Freight.
+	OCIRepository *FluxOCIRepositoryUpdate `json:"ociRepository,omitempty"`
+	// Kustomization describes updates to a Kustomization. This field may only be
+	// specified when Kind is Kustomization.
+	Kustomization *FluxKustomizationUpdate `json:"kustomization,omitempty"`
+}
+
+// FluxHelmReleaseUpdate describes updates to a Flux HelmRelease to incorporate
+// Freight into a Stage.
+type FluxHelmReleaseUpdate struct {
+	// Chart, if specified, identifies a chart from the Freight whose version
+	// should be used as the HelmRelease's chart version.
+	Chart *FluxHelmChartUpdate `json:"chart,omitempty"`
+	// Images describes how specific image versions can be incorporated into the
+	// HelmRelease's values.
+	Images []FluxHelmImageUpdate `json:"images,omitempty"`
+}
+
+// FluxHelmChartUpdate identifies a chart from the Freight whose version should
+// be used as a HelmRelease's chart version.
+type FluxHelmChartUpdate struct {
+	// RepoURL along with the Name field identifies a chart from the Freight. The
+	// values of both fields should match the values of the fields of the same
+	// names in the Warehouse's chart subscription. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL"`
+	// Name along with the RepoURL field identifies a chart from the Freight.
+	Name string `json:"name,omitempty"`
+}
+
+// FluxHelmImageUpdate describes how a specific image version can be
+// incorporated into a HelmRelease's values.
+type FluxHelmImageUpdate struct {
+	// Image specifies a container image (without tag). This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Key specifies a key within the HelmRelease's values that is to be updated.
+	// Nested keys are separated by dots. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// Value specifies the new value for the specified key in the HelmRelease's
+	// values. Valid values are the same as for ArgoCDHelmImageUpdate. This is a
+	// required field.
+	Value ImageUpdateValueType `json:"value"`
+}
+
+// FluxOCIRepositoryUpdate describes updates to a Flux OCIRepository to
+// incorporate Freight into a Stage.
+type FluxOCIRepositoryUpdate struct {
+	// UseDigest specifies whether the OCIRepository's ref should be updated to
+	// the digest of the matching image from the Freight instead of its tag.
+	UseDigest bool `json:"useDigest,omitempty"`
+}
+
+// FluxKustomizationUpdate describes updates to a Flux Kustomization to
+// incorporate Freight into a Stage.
+type FluxKustomizationUpdate struct {
+	// Images describes how specific image versions can be incorporated into the
+	// Kustomization's image overrides.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Images []FluxKustomizeImageUpdate `json:"images"`
+}
+
+// FluxKustomizeImageUpdate describes how a specific image version can be
+// incorporated into a Kustomization's image overrides.
+type FluxKustomizeImageUpdate struct {
+	// Image specifies a container image (without tag). This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// UseDigest specifies whether the image's digest should be used instead of
+	// its tag.
+	UseDigest bool `json:"useDigest,omitempty"`
+}
+
 // StageStatus describes a Stages's current and recent Freight, health, and
 // more.
 type StageStatus struct {
@@ -537,6 +936,46 @@ type FreightReference struct {
 	// VerificationInfo is information about any verification process that was
 	// associated with this Freight for this Stage.
 	VerificationInfo *VerificationInfo `json:"verificationInfo,omitempty"`
+	// Constituents is non-empty only when this FreightReference is a composite of
+	// Freight from multiple Warehouses. In that case, it identifies each
+	// constituent piece of Freight and the Commits, Images, and Charts fields
+	// hold the combined artifacts of all of them.
+	Constituents []FreightConstituent `json:"constituents,omitempty"`
+}
+
+// FreightIDs returns the IDs of all the pieces of Freight that the
+// FreightReference refers to. For a composite FreightReference, these are the
+// IDs of its constituents. Otherwise, it is the FreightReference's own ID.
+func (f *FreightReference) FreightIDs() []string {
+	if len(f.Constituents) == 0 {
+		return []string{f.ID}
+	}
+	ids := make([]string, len(f.Constituents))
+	for i, constituent := range f.Constituents {
+		ids[i] = constituent.ID
+	}
+	return ids
+}
+
+// Includes returns a bool indicating whether the piece of Freight with the
+// specified ID is, or is a constituent of, the FreightReference.
+func (f *FreightReference) Includes(freightID string) bool {
+	for _, id := range f.FreightIDs() {
+		if id == freightID {
+			return true
+		}
+	}
+	return false
+}
+
+// FreightConstituent identifies one piece of Freight within a composite
+// FreightReference.
+type FreightConstituent struct {
+	// ID is the ID of the constituent Freight.
+	ID string `json:"id"`
+	// Warehouse is the name of the Warehouse from which the constituent Freight
+	// originated.
+	Warehouse string `json:"warehouse"`
 }
 
 type FreightReferenceStack []FreightReference
@@ -639,6 +1078,41 @@ type Health struct {
 	Issues []string `json:"issues,omitempty"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty"`
+	// Workloads describes the current state of any Kubernetes workloads
+	// referenced by the Stage's health checks.
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+	// FluxResources describes the current state of any related Flux resources.
+	FluxResources []FluxResourceStatus `json:"fluxResources,omitempty"`
+}
+
+// FluxResourceStatus describes the current state of a single Flux resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind FluxResourceKind `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Status is the health of the Flux resource.
+	Status HealthState `json:"status,omitempty"`
+	// Revision is the last revision observed by the Flux resource.
+	Revision string `json:"revision,omitempty"`
+	// Message clarifies the status of the Flux resource.
+	Message string `json:"message,omitempty"`
+}
+
+// WorkloadStatus describes the rollout status of a single Kubernetes workload.
+type WorkloadStatus struct {
+	// Kind is the kind of the workload.
+	Kind WorkloadKind `json:"kind"`
+	// Namespace is the namespace of the workload.
+	Namespace string `json:"namespace"`
+	// Name is the name of the workload.
+	Name string `json:"name"`
+	// Status is the health of the workload.
+	Status HealthState `json:"status,omitempty"`
+	// Message clarifies the status of the workload.
+	Message string `json:"message,omitempty"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestFreightReferenceStackEmpty(t *testing.T) {
@@ -149,3 +151,131 @@ func TestFreightReferenceStackPush(t *testing.T) {
 		})
 	}
 }
+
+func TestStageLockIsActive(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name           string
+		lock           *StageLock
+		expectedResult bool
+	}{
+		{
+			name:           "lock is nil",
+			lock:           nil,
+			expectedResult: false,
+		},
+		{
+			name:           "lock has no expiry",
+			lock:           &StageLock{},
+			expectedResult: true,
+		},
+		{
+			name: "lock has not expired",
+			lock: &StageLock{
+				Until: &metav1.Time{Time: now.Add(time.Hour)},
+			},
+			expectedResult: true,
+		},
+		{
+			name: "lock has expired",
+			lock: &StageLock{
+				Until: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			expectedResult: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.lock.IsActive(now))
+		})
+	}
+}
+
+func TestSubscriptionsGetWarehouses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		subs     *Subscriptions
+		expected []string
+	}{
+		{
+			name:     "subscriptions are nil",
+			subs:     nil,
+			expected: nil,
+		},
+		{
+			name:     "no warehouse subscriptions",
+			subs:     &Subscriptions{},
+			expected: nil,
+		},
+		{
+			name:     "single warehouse subscription",
+			subs:     &Subscriptions{Warehouse: "foo"},
+			expected: []string{"foo"},
+		},
+		{
+			name:     "multiple warehouse subscriptions",
+			subs:     &Subscriptions{Warehouses: []string{"foo", "bar"}},
+			expected: []string{"foo", "bar"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.subs.GetWarehouses())
+		})
+	}
+}
+
+func TestFreightReferenceIncludes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		freight   FreightReference
+		freightID string
+		expected  bool
+	}{
+		{
+			name:      "simple freight with matching ID",
+			freight:   FreightReference{ID: "foo"},
+			freightID: "foo",
+			expected:  true,
+		},
+		{
+			name:      "simple freight with non-matching ID",
+			freight:   FreightReference{ID: "foo"},
+			freightID: "bar",
+			expected:  false,
+		},
+		{
+			name: "composite freight with matching constituent",
+			freight: FreightReference{
+				ID: "composite",
+				Constituents: []FreightConstituent{
+					{ID: "foo", Warehouse: "a"},
+					{ID: "bar", Warehouse: "b"},
+				},
+			},
+			freightID: "bar",
+			expected:  true,
+		},
+		{
+			name: "composite freight without matching constituent",
+			freight: FreightReference{
+				ID: "composite",
+				Constituents: []FreightConstituent{
+					{ID: "foo", Warehouse: "a"},
+					{ID: "bar", Warehouse: "b"},
+				},
+			},
+			freightID: "composite",
+			expected:  false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.freight.Includes(testCase.freightID),
+			)
+		})
+	}
+}
@@ -21,14 +21,19 @@ import (
 	"github.com/akuity/kargo/internal/cli/cmd/create"
 	"github.com/akuity/kargo/internal/cli/cmd/dashboard"
 	"github.com/akuity/kargo/internal/cli/cmd/delete"
+	"github.com/akuity/kargo/internal/cli/cmd/diff"
 	"github.com/akuity/kargo/internal/cli/cmd/get"
+	"github.com/akuity/kargo/internal/cli/cmd/lint"
 	"github.com/akuity/kargo/internal/cli/cmd/login"
 	"github.com/akuity/kargo/internal/cli/cmd/logout"
+	"github.com/akuity/kargo/internal/cli/cmd/project"
+	"github.com/akuity/kargo/internal/cli/cmd/promotion"
 	"github.com/akuity/kargo/internal/cli/cmd/refresh"
 	"github.com/akuity/kargo/internal/cli/cmd/stage"
 	"github.com/akuity/kargo/internal/cli/cmd/update"
 	clicfg "github.com/akuity/kargo/internal/cli/config"
 	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/credentials"
 )
 
 // rootState holds state used internally by the root command.
@@ -48,6 +53,17 @@ func NewRootCommand(
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := buildRootContext(cmd.Context())
 
+			// Unless a project was explicitly specified, a context selected using
+			// the --context flag supplies the default project instead of the
+			// current context. If the selected context does not exist, commands
+			// that require it will report as much.
+			if projectFlag := cmd.Flags().Lookup("project"); opt.Context != "" &&
+				projectFlag != nil && !projectFlag.Changed {
+				if kargoCtx, err := cfg.GetContext(opt.Context); err == nil {
+					opt.Project = kargoCtx.Project
+				}
+			}
+
 			if opt.UseLocalServer {
 				restCfg, err := config.GetConfig()
 				if err != nil {
@@ -69,6 +85,10 @@ func NewRootCommand(
 					},
 					client,
 					client,
+					credentials.NewKubernetesDatabase(
+						client,
+						credentials.KubernetesDatabaseConfig{},
+					),
 				)
 				go srv.Serve(ctx, l) // nolint: errcheck
 				opt.LocalServerAddress = fmt.Sprintf("http://%s", l.Addr())
@@ -96,19 +116,24 @@ func NewRootCommand(
 		return nil, err
 	}
 	opt.PrintFlags = genericclioptions.NewPrintFlags("").WithTypeSetter(scheme)
+	option.Context(cmd.PersistentFlags(), opt)
 
 	cmd.AddCommand(apply.NewCommand(cfg, opt))
 	cmd.AddCommand(approve.NewCommand(cfg, opt))
-	cmd.AddCommand(cliconfigcmd.NewCommand(cfg))
+	cmd.AddCommand(cliconfigcmd.NewCommand(cfg, opt))
 	cmd.AddCommand(create.NewCommand(cfg, opt))
 	cmd.AddCommand(delete.NewCommand(cfg, opt))
+	cmd.AddCommand(diff.NewCommand(cfg, opt))
 	cmd.AddCommand(get.NewCommand(cfg, opt))
-	cmd.AddCommand(login.NewCommand(opt))
-	cmd.AddCommand(logout.NewCommand())
+	cmd.AddCommand(lint.NewCommand(cfg, opt))
+	cmd.AddCommand(login.NewCommand(cfg, opt))
+	cmd.AddCommand(logout.NewCommand(cfg, opt))
+	cmd.AddCommand(project.NewCommand(cfg, opt))
+	cmd.AddCommand(promotion.NewCommand(cfg, opt))
 	cmd.AddCommand(stage.NewCommand(cfg, opt))
 	cmd.AddCommand(refresh.NewCommand(cfg, opt))
 	cmd.AddCommand(update.NewCommand(cfg, opt))
-	cmd.AddCommand(dashboard.NewCommand(cfg))
+	cmd.AddCommand(dashboard.NewCommand(cfg, opt))
 	cmd.AddCommand(newVersionCommand(cfg, opt))
 	cmd.AddCommand(
 		cobracompletefig.CreateCompletionSpecCommand(
@@ -76,7 +76,11 @@ func newVersionCommand(
 }
 
 func getServerVersion(ctx context.Context, cfg config.CLIConfig, opt *option.Option) (*svcv1alpha1.VersionInfo, error) {
-	if cfg.APIAddress == "" || cfg.BearerToken == "" {
+	kargoCtx, err := cfg.GetContext(opt.Context)
+	if err != nil {
+		return nil, err
+	}
+	if kargoCtx.APIAddress == "" || kargoCtx.BearerToken == "" {
 		return nil, nil
 	}
 
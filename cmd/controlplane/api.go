@@ -20,6 +20,7 @@ import (
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
@@ -98,7 +99,19 @@ func newAPICommand() *cobra.Command {
 				}).Info("SSO via OpenID Connect is enabled")
 			}
 
-			srv := api.NewServer(cfg, kubeClient, internalClient)
+			// Credentials are looked up using a client that doesn't cache, because
+			// the API server is only permitted to read Secrets in Project namespaces
+			// and cannot, therefore, watch Secrets cluster-wide.
+			credentialsClient, err := client.New(restCfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return errors.Wrap(err, "create Kubernetes client for credentials")
+			}
+			credentialsDB := credentials.NewKubernetesDatabase(
+				credentialsClient,
+				credentials.KubernetesDatabaseConfigFromEnv(),
+			)
+
+			srv := api.NewServer(cfg, kubeClient, internalClient, credentialsDB)
 			l, err := net.Listen(
 				"tcp",
 				fmt.Sprintf(
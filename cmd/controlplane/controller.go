@@ -6,10 +6,13 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -247,6 +250,113 @@ func newControllerCommand() *cobra.Command {
 				log.Info("Argo Rollouts integration is disabled")
 			}
 
+			var fluxClient client.Client
+			if types.MustParseBool(os.GetEnv("FLUX_INTEGRATION_ENABLED", "true")) {
+				// If the env var is undefined, this will resolve to kubeconfig for the
+				// cluster the controller is running in.
+				//
+				// It is typically defined if this controller is running somewhere other
+				// than where the Flux resources live. In a sharded topology, the
+				// default is usually what is wanted, since Flux typically runs in the
+				// shard clusters.
+				restCfg, err :=
+					kubernetes.GetRestConfig(ctx, os.GetEnv("FLUX_KUBECONFIG", ""))
+				if err != nil {
+					return errors.Wrap(
+						err,
+						"error loading REST config for Flux client",
+					)
+				}
+				restCfg.ContentType = runtime.ContentTypeJSON
+
+				if fluxExists(ctx, restCfg) {
+					log.Info("Flux integration is enabled")
+					// Flux resources are manipulated as unstructured objects, so no
+					// scheme is needed.
+					if fluxClient, err = client.New(restCfg, client.Options{}); err != nil {
+						return errors.Wrap(err, "error initializing Flux client")
+					}
+				} else {
+					log.Warn(
+						"Flux integration was enabled, but no Flux CRDs were found. " +
+							"Proceeding without Flux integration.",
+					)
+				}
+			} else {
+				log.Info("Flux integration is disabled")
+			}
+
+			var workloadsClient client.Client
+			{
+				// If the env var is undefined, this will resolve to kubeconfig for the
+				// cluster the controller is running in.
+				//
+				// It is typically defined if this controller is running somewhere other
+				// than where the Kubernetes workloads referenced by Stage health checks
+				// live. In a sharded topology, the default is usually what is wanted,
+				// since workloads are deployed to the shard clusters.
+				restCfg, err :=
+					kubernetes.GetRestConfig(ctx, os.GetEnv("WORKLOADS_KUBECONFIG", ""))
+				if err != nil {
+					return errors.Wrap(
+						err,
+						"error loading REST config for Kubernetes workloads client",
+					)
+				}
+				scheme := runtime.NewScheme()
+				if err = appsv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes apps API to Kubernetes workloads client "+
+							"scheme",
+					)
+				}
+				if err = corev1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes core API to Kubernetes workloads client "+
+							"scheme",
+					)
+				}
+				if workloadsClient, err = client.New(
+					restCfg,
+					client.Options{
+						Scheme: scheme,
+					},
+				); err != nil {
+					return errors.Wrap(
+						err,
+						"error initializing Kubernetes workloads client",
+					)
+				}
+			}
+
+			var jobsClient client.Client
+			{
+				// Promotion hook Jobs are run in Project namespaces, which live
+				// alongside the Kargo resources.
+				scheme := runtime.NewScheme()
+				if err := batchv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes batch API to Kubernetes Jobs client "+
+							"scheme",
+					)
+				}
+				var err error
+				if jobsClient, err = client.New(
+					kargoMgr.GetConfig(),
+					client.Options{
+						Scheme: scheme,
+					},
+				); err != nil {
+					return errors.Wrap(
+						err,
+						"error initializing Kubernetes Jobs client",
+					)
+				}
+			}
+
 			credentialsDB := credentials.NewKubernetesDatabase(
 				kargoMgr.GetClient(),
 				credentials.KubernetesDatabaseConfigFromEnv(),
@@ -256,6 +366,8 @@ func newControllerCommand() *cobra.Command {
 				ctx,
 				kargoMgr,
 				argocdMgr,
+				fluxClient,
+				jobsClient,
 				credentialsDB,
 				shardName,
 			); err != nil {
@@ -267,6 +379,9 @@ func newControllerCommand() *cobra.Command {
 				kargoMgr,
 				argocdMgr,
 				rolloutsMgr,
+				fluxClient,
+				workloadsClient,
+				credentialsDB,
 				stages.ReconcilerConfigFromEnv(),
 			); err != nil {
 				return errors.Wrap(err, "error setting up Stages reconciler")
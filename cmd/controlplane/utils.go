@@ -42,3 +42,18 @@ func argoRolloutsExists(ctx context.Context, restCfg *rest.Config) bool {
 	}
 	return false
 }
+
+func fluxExists(ctx context.Context, restCfg *rest.Config) bool {
+	if client, err := dynamic.NewForConfig(restCfg); err == nil {
+		if _, err = client.Resource(
+			schema.GroupVersionResource{
+				Group:    "source.toolkit.fluxcd.io",
+				Version:  "v1",
+				Resource: "gitrepositories",
+			},
+		).List(ctx, metav1.ListOptions{Limit: 1}); err == nil {
+			return true
+		}
+	}
+	return false
+}
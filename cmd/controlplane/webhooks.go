@@ -93,7 +93,10 @@ func newWebhooksServerCommand() *cobra.Command {
 			if err = promotion.SetupWebhookWithManager(mgr); err != nil {
 				return errors.Wrap(err, "setup Promotion webhook")
 			}
-			if err = stage.SetupWebhookWithManager(mgr); err != nil {
+			if err = stage.SetupWebhookWithManager(
+				mgr,
+				stage.WebhookConfigFromEnv(),
+			); err != nil {
 				return errors.Wrap(err, "setup Stage webhook")
 			}
 			if err = warehouse.SetupWebhookWithManager(mgr); err != nil {
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) AbortPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.AbortPromotionRequest],
+) (*connect.Response[svcv1alpha1.AbortPromotionResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name should not be empty"))
+	}
+	if err := s.validateProject(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	objKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	promo, err := kargoapi.GetPromotion(ctx, s.client, objKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get promotion")
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("promotion %q not found", req.Msg.GetName()),
+		)
+	}
+	if promo.Status.Phase.IsTerminal() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"promotion %q has already completed with phase %q",
+				req.Msg.GetName(),
+				promo.Status.Phase,
+			),
+		)
+	}
+
+	if _, err = kargoapi.AbortPromotion(ctx, s.client, objKey); err != nil {
+		return nil, errors.Wrap(err, "abort promotion")
+	}
+	return connect.NewResponse(&svcv1alpha1.AbortPromotionResponse{}), nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestAbortPromotion(t *testing.T) {
+	testSets := map[string]struct {
+		req          *svcv1alpha1.AbortPromotionRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"empty project": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "",
+				Name:    "",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"empty name": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "kargo-demo",
+				Name:    "",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "kargo-x",
+				Name:    "test",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"non-existing Promotion": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"completed Promotion": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "kargo-demo",
+				Name:    "succeeded",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		"running Promotion": {
+			req: &svcv1alpha1.AbortPromotionRequest{
+				Project: "kargo-demo",
+				Name:    "test",
+			},
+		},
+	}
+	for name, ts := range testSets {
+		ts := ts
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								mustNewObject[kargoapi.Promotion]("testdata/promotion.yaml"),
+								mustNewObject[kargoapi.Promotion]("testdata/promotion-succeeded.yaml"),
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: client,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			_, err = svr.AbortPromotion(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+
+			promo, err := kargoapi.GetPromotion(ctx, client, types.NamespacedName{
+				Namespace: ts.req.GetProject(),
+				Name:      ts.req.GetName(),
+			})
+			require.NoError(t, err)
+			require.Contains(t, promo.Annotations, kargoapi.AnnotationKeyAbort)
+		})
+	}
+}
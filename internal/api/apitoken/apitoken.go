@@ -0,0 +1,159 @@
+// Package apitoken provides types and functions for managing API tokens issued
+// by the Kargo API server to robot accounts. A robot account is an ordinary
+// ServiceAccount in a Project namespace. A record of every API token issued to
+// a robot account is kept in an annotation on its ServiceAccount, so that
+// removing a record revokes the corresponding token.
+package apitoken
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AnnotationKey is the key of the ServiceAccount annotation in which records
+// of the API tokens issued to a robot account are kept.
+const AnnotationKey = "kargo.akuity.io/api-tokens"
+
+const serviceAccountSubjectPrefix = "system:serviceaccount:"
+
+// Record is a record of an API token issued to a robot account. It never
+// contains the token itself.
+type Record struct {
+	// ID uniquely identifies the token. It is also the value of the token's JTI
+	// claim.
+	ID string `json:"id"`
+	// Name is a human-friendly description of the token's purpose.
+	Name string `json:"name,omitempty"`
+	// Scopes are the names of the API methods the token may be used to invoke.
+	// If empty, the token may be used to invoke any API method.
+	Scopes []string `json:"scopes,omitempty"`
+	// CreatedAt is the time at which the token was issued.
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Expired returns true if the token the Record describes has expired as of the
+// specified time.
+func (r Record) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Allows returns true if the token the Record describes may be used to invoke
+// the specified API method.
+func (r Record) Allows(method string) bool {
+	return allows(r.Scopes, method)
+}
+
+// Claims are the claims of an API token.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Scopes are the names of the API methods the token may be used to invoke.
+	// If empty, the token may be used to invoke any API method.
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// Allows returns true if the token bearing the Claims may be used to invoke
+// the specified API method.
+func (c Claims) Allows(method string) bool {
+	return allows(c.Scopes, method)
+}
+
+func allows(scopes []string, method string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		if scope == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Subject returns the value to be used in the SUB claim of API tokens issued to
+// the specified ServiceAccount. It is the same username Kubernetes itself uses
+// for the ServiceAccount.
+func Subject(serviceAccount types.NamespacedName) string {
+	return fmt.Sprintf(
+		"%s%s:%s",
+		serviceAccountSubjectPrefix,
+		serviceAccount.Namespace,
+		serviceAccount.Name,
+	)
+}
+
+// ServiceAccountFromSubject parses the value of the SUB claim of an API token
+// and returns the ServiceAccount the token was issued to.
+func ServiceAccountFromSubject(subject string) (types.NamespacedName, error) {
+	parts := strings.Split(
+		strings.TrimPrefix(subject, serviceAccountSubjectPrefix),
+		":",
+	)
+	if !strings.HasPrefix(subject, serviceAccountSubjectPrefix) ||
+		len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{},
+			errors.Errorf("%q does not identify a ServiceAccount", subject)
+	}
+	return types.NamespacedName{
+		Namespace: parts[0],
+		Name:      parts[1],
+	}, nil
+}
+
+// GetRecords returns the records of all API tokens issued to the specified
+// ServiceAccount.
+func GetRecords(sa *corev1.ServiceAccount) ([]Record, error) {
+	val, ok := sa.Annotations[AnnotationKey]
+	if !ok || val == "" {
+		return nil, nil
+	}
+	var records []Record
+	if err := json.Unmarshal([]byte(val), &records); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error unmarshaling API token records of ServiceAccount %q in "+
+				"namespace %q",
+			sa.Name,
+			sa.Namespace,
+		)
+	}
+	return records, nil
+}
+
+// SetRecords replaces the records of all API tokens issued to the specified
+// ServiceAccount with the specified records.
+func SetRecords(sa *corev1.ServiceAccount, records []Record) error {
+	if len(records) == 0 {
+		delete(sa.Annotations, AnnotationKey)
+		return nil
+	}
+	val, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling API token records")
+	}
+	if sa.Annotations == nil {
+		sa.Annotations = map[string]string{}
+	}
+	sa.Annotations[AnnotationKey] = string(val)
+	return nil
+}
+
+// RemoveExpired returns the subset of the specified records that have not
+// expired as of the specified time.
+func RemoveExpired(records []Record, now time.Time) []Record {
+	unexpired := make([]Record, 0, len(records))
+	for _, record := range records {
+		if !record.Expired(now) {
+			unexpired = append(unexpired, record)
+		}
+	}
+	return unexpired
+}
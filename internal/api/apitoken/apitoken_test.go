@@ -0,0 +1,136 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAllows(t *testing.T) {
+	testCases := []struct {
+		name    string
+		scopes  []string
+		method  string
+		allowed bool
+	}{
+		{
+			name:    "no scopes",
+			method:  "ListProjects",
+			allowed: true,
+		},
+		{
+			name:    "method in scopes",
+			scopes:  []string{"PromoteStage", "ListProjects"},
+			method:  "ListProjects",
+			allowed: true,
+		},
+		{
+			name:    "method not in scopes",
+			scopes:  []string{"PromoteStage"},
+			method:  "ListProjects",
+			allowed: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.allowed,
+				Record{Scopes: testCase.scopes}.Allows(testCase.method),
+			)
+			require.Equal(
+				t,
+				testCase.allowed,
+				Claims{Scopes: testCase.scopes}.Allows(testCase.method),
+			)
+		})
+	}
+}
+
+func TestServiceAccountFromSubject(t *testing.T) {
+	testSA := types.NamespacedName{
+		Namespace: "fake-project",
+		Name:      "fake-robot",
+	}
+	testCases := []struct {
+		name       string
+		subject    string
+		assertions func(types.NamespacedName, error)
+	}{
+		{
+			name:    "not a ServiceAccount",
+			subject: "someone@example.com",
+			assertions: func(_ types.NamespacedName, err error) {
+				require.ErrorContains(t, err, "does not identify a ServiceAccount")
+			},
+		},
+		{
+			name:    "missing name",
+			subject: "system:serviceaccount:fake-project:",
+			assertions: func(_ types.NamespacedName, err error) {
+				require.ErrorContains(t, err, "does not identify a ServiceAccount")
+			},
+		},
+		{
+			name:    "success",
+			subject: Subject(testSA),
+			assertions: func(sa types.NamespacedName, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testSA, sa)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(ServiceAccountFromSubject(testCase.subject))
+		})
+	}
+}
+
+func TestRecords(t *testing.T) {
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-robot",
+		},
+	}
+	records, err := GetRecords(sa)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	testRecords := []Record{
+		{
+			ID:        "fake-id",
+			Name:      "fake-name",
+			Scopes:    []string{"PromoteStage"},
+			CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	require.NoError(t, SetRecords(sa, testRecords))
+	require.Contains(t, sa.Annotations, AnnotationKey)
+	records, err = GetRecords(sa)
+	require.NoError(t, err)
+	require.Equal(t, testRecords, records)
+
+	require.NoError(t, SetRecords(sa, nil))
+	require.NotContains(t, sa.Annotations, AnnotationKey)
+
+	sa.Annotations[AnnotationKey] = "not json"
+	_, err = GetRecords(sa)
+	require.ErrorContains(t, err, "error unmarshaling API token records")
+}
+
+func TestRemoveExpired(t *testing.T) {
+	now := time.Now()
+	records := []Record{
+		{ID: "expired", ExpiresAt: now.Add(-time.Hour)},
+		{ID: "expiring", ExpiresAt: now},
+		{ID: "unexpired", ExpiresAt: now.Add(time.Hour)},
+	}
+	require.Equal(t, records[2:], RemoveExpired(records, now))
+}
@@ -3,14 +3,18 @@ package api
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/api/user"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -54,27 +58,131 @@ func (s *server) ApproveFreight(
 		return nil, errors.Wrap(err, "get stage")
 	}
 
-	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = map[string]kargoapi.ApprovedStage{}
+	if _, ok := freight.Status.ApprovedFor[stageName]; ok {
+		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
-	if _, ok := newStatus.ApprovedFor[stageName]; ok {
-		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+	approver, err := getApprover(ctx, &stage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple approvers may approve the same Freight at the same time, so the
+	// approval is recorded with an update that fails if the Freight has changed
+	// since it was read. When that happens, the Freight is read again and the
+	// approval is re-applied.
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.client.Get(ctx, freightKey, &freight); err != nil {
+			return err
+		}
+		if !recordApproval(&freight.Status, &stage, approver, metav1.Now()) {
+			return nil
+		}
+		return s.client.Status().Update(ctx, &freight)
+	}); err != nil {
+		return nil, errors.Wrap(err, "update freight status")
 	}
 
-	newStatus.ApprovedFor[stageName] = kargoapi.ApprovedStage{}
+	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+}
+
+// recordApproval records, in the provided FreightStatus, the specified
+// approver's approval of the Freight for the specified Stage. If this satisfies
+// the Stage's approval policy, the Freight becomes approved for the Stage. It
+// returns false if the status was left unchanged because the Freight is
+// already approved for the Stage or because the approver has already approved
+// it.
+func recordApproval(
+	status *kargoapi.FreightStatus,
+	stage *kargoapi.Stage,
+	approver string,
+	now metav1.Time,
+) bool {
+	if _, ok := status.ApprovedFor[stage.Name]; ok {
+		return false
+	}
 
-	if err := kubeclient.PatchStatus(
-		ctx,
-		s.client,
-		&freight,
-		func(status *kargoapi.FreightStatus) {
-			*status = newStatus
+	pending := status.PendingApprovalFor[stage.Name]
+	for _, approval := range pending.Approvals {
+		if approval.Approver == approver {
+			// This approver has already approved this Freight for this Stage
+			return false
+		}
+	}
+	pending.Approvals = append(
+		pending.Approvals,
+		kargoapi.Approval{
+			Approver:   approver,
+			ApprovedAt: now,
 		},
-	); err != nil {
-		return nil, errors.Wrap(err, "patch status")
+	)
+
+	requiredApprovals := 1
+	if stage.Spec.ApprovalPolicy != nil &&
+		stage.Spec.ApprovalPolicy.RequiredApprovals > 1 {
+		requiredApprovals = int(stage.Spec.ApprovalPolicy.RequiredApprovals)
 	}
+	if len(pending.Approvals) >= requiredApprovals {
+		// The Stage's approval policy is satisfied
+		if status.ApprovedFor == nil {
+			status.ApprovedFor = map[string]kargoapi.ApprovedStage{}
+		}
+		status.ApprovedFor[stage.Name] = kargoapi.ApprovedStage{
+			ApprovedAt: &now,
+			Approvals:  pending.Approvals,
+		}
+		delete(status.PendingApprovalFor, stage.Name)
+	} else {
+		if status.PendingApprovalFor == nil {
+			status.PendingApprovalFor = map[string]kargoapi.PendingApproval{}
+		}
+		status.PendingApprovalFor[stage.Name] = pending
+	}
+	return true
+}
 
-	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+// getApprover returns the identity of the user bound to the provided context
+// after verifying that the Stage's approval policy, if any, permits that user
+// to approve Freight for the Stage. The admin user is exempt from the policy's
+// allow lists. Emails are compared case-insensitively.
+func getApprover(ctx context.Context, stage *kargoapi.Stage) (string, error) {
+	u, _ := user.InfoFromContext(ctx)
+	approver := getUserIdentity(u)
+	policy := stage.Spec.ApprovalPolicy
+	if policy == nil || u.IsAdmin {
+		return approver, nil
+	}
+	if approver == "" {
+		return "", connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf(
+				"stage %q has an approval policy, but the identity of the "+
+					"approver could not be determined",
+				stage.Name,
+			),
+		)
+	}
+	if len(policy.AllowedGroups) == 0 && len(policy.AllowedEmails) == 0 {
+		return approver, nil
+	}
+	if u.Email != "" {
+		for _, email := range policy.AllowedEmails {
+			if strings.EqualFold(email, u.Email) {
+				return approver, nil
+			}
+		}
+	}
+	for _, group := range u.Groups {
+		if slices.Contains(policy.AllowedGroups, group) {
+			return approver, nil
+		}
+	}
+	return "", connect.NewError(
+		connect.CodePermissionDenied,
+		fmt.Errorf(
+			"%q is not permitted to approve Freight for stage %q",
+			approver,
+			stage.Name,
+		),
+	)
 }
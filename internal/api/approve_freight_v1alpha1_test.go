@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApproveFreightConcurrentApproval(t *testing.T) {
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{
+			IsAdmin: true,
+		},
+	)
+	freightKey := types.NamespacedName{
+		Namespace: "kargo-demo",
+		Name:      "abc123",
+	}
+	var internalClient client.Client
+	var concurrentApprovalRecorded bool
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				internalClient = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						&kargoapi.Stage{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "kargo-demo",
+								Name:      "prod",
+							},
+							Spec: &kargoapi.StageSpec{
+								ApprovalPolicy: &kargoapi.ApprovalPolicy{
+									RequiredApprovals: 2,
+								},
+							},
+						},
+						&kargoapi.Freight{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: freightKey.Namespace,
+								Name:      freightKey.Name,
+							},
+						},
+					).
+					WithInterceptorFuncs(interceptor.Funcs{
+						// Another approver's approval lands between this request
+						// reading the Freight and updating it
+						SubResourceUpdate: func(
+							ctx context.Context,
+							c client.Client,
+							subResourceName string,
+							obj client.Object,
+							opts ...client.SubResourceUpdateOption,
+						) error {
+							if !concurrentApprovalRecorded {
+								concurrentApprovalRecorded = true
+								freight := &kargoapi.Freight{}
+								require.NoError(t, c.Get(ctx, freightKey, freight))
+								freight.Status.PendingApprovalFor = map[string]kargoapi.PendingApproval{
+									"prod": {
+										Approvals: []kargoapi.Approval{{Approver: "mando@example.com"}},
+									},
+								}
+								require.NoError(t, c.Status().Update(ctx, freight))
+							}
+							return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+						},
+					}).
+					WithStatusSubresource(&kargoapi.Freight{}).
+					Build()
+				return internalClient, nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	svr := &server{
+		client:                    kubeClient,
+		externalValidateProjectFn: func(context.Context, client.Client, string) error { return nil },
+	}
+
+	_, err = svr.ApproveFreight(ctx, connect.NewRequest(&svcv1alpha1.ApproveFreightRequest{
+		Project: freightKey.Namespace,
+		Stage:   "prod",
+		Id:      freightKey.Name,
+	}))
+	require.NoError(t, err)
+	require.True(t, concurrentApprovalRecorded)
+
+	// Neither approval was lost, so the Freight is now approved
+	freight := &kargoapi.Freight{}
+	require.NoError(t, internalClient.Get(ctx, freightKey, freight))
+	require.Empty(t, freight.Status.PendingApprovalFor)
+	approved, ok := freight.Status.ApprovedFor["prod"]
+	require.True(t, ok)
+	require.Len(t, approved.Approvals, 2)
+	require.Equal(t, "mando@example.com", approved.Approvals[0].Approver)
+	require.Equal(t, "admin", approved.Approvals[1].Approver)
+}
+
+func TestGetApprover(t *testing.T) {
+	testPolicy := &kargoapi.ApprovalPolicy{
+		RequiredApprovals: 2,
+		AllowedGroups:     []string{"release-managers"},
+		AllowedEmails:     []string{"mando@example.com"},
+	}
+	testCases := []struct {
+		name         string
+		userInfo     *user.Info
+		policy       *kargoapi.ApprovalPolicy
+		errExpected  bool
+		expectedCode connect.Code
+		approver     string
+	}{
+		{
+			name:     "no policy; admin",
+			userInfo: &user.Info{IsAdmin: true},
+			approver: "admin",
+		},
+		{
+			name: "no policy; unknown user",
+		},
+		{
+			name:         "policy; unknown user",
+			policy:       &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+			errExpected:  true,
+			expectedCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "policy without allow lists; user identified by subject",
+			userInfo: &user.Info{Subject: "hansolo"},
+			policy:   &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+			approver: "hansolo",
+		},
+		{
+			name:     "user allowed by email",
+			userInfo: &user.Info{Subject: "mando", Email: "mando@example.com"},
+			policy:   testPolicy,
+			approver: "mando@example.com",
+		},
+		{
+			name:     "user allowed by email with different case",
+			userInfo: &user.Info{Subject: "mando", Email: "Mando@Example.com"},
+			policy:   testPolicy,
+			approver: "Mando@Example.com",
+		},
+		{
+			name: "user allowed by group",
+			userInfo: &user.Info{
+				Email:  "hansolo@example.com",
+				Groups: []string{"smugglers", "release-managers"},
+			},
+			policy:   testPolicy,
+			approver: "hansolo@example.com",
+		},
+		{
+			name: "user not allowed",
+			userInfo: &user.Info{
+				Email:  "jarjar@example.com",
+				Groups: []string{"gungans"},
+			},
+			policy:       testPolicy,
+			errExpected:  true,
+			expectedCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "admin exempt from allow lists",
+			userInfo: &user.Info{IsAdmin: true},
+			policy:   testPolicy,
+			approver: "admin",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			approver, err := getApprover(
+				ctx,
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-stage",
+					},
+					Spec: &kargoapi.StageSpec{
+						ApprovalPolicy: testCase.policy,
+					},
+				},
+			)
+			if testCase.errExpected {
+				require.Error(t, err)
+				require.Equal(t, testCase.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.approver, approver)
+		})
+	}
+}
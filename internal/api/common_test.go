@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func TestSetStageLockedBy(t *testing.T) {
+	newLockedStage := func(lockedBy string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				Lock: &kargoapi.StageLock{
+					LockedBy: lockedBy,
+					Reason:   "fake-reason",
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name             string
+		obj              client.Object
+		existing         client.Object
+		expectedLockedBy string
+	}{
+		{
+			name: "not locked",
+			obj:  &kargoapi.Stage{Spec: &kargoapi.StageSpec{}},
+		},
+		{
+			name:             "lock placed",
+			obj:              newLockedStage("someone-else"),
+			expectedLockedBy: "fake-user@example.com",
+		},
+		{
+			name:             "lock changed",
+			obj:              newLockedStage("someone-else"),
+			existing:         newLockedStage("fake-user@example.com"),
+			expectedLockedBy: "fake-user@example.com",
+		},
+		{
+			name:             "lock unchanged",
+			obj:              newLockedStage("someone-else"),
+			existing:         newLockedStage("someone-else"),
+			expectedLockedBy: "someone-else",
+		},
+		{
+			name: "unstructured lock placed",
+			obj: &unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": kargoapi.GroupVersion.String(),
+					"kind":       "Stage",
+					"metadata": map[string]any{
+						"name": "fake-stage",
+					},
+					"spec": map[string]any{
+						"lock": map[string]any{
+							"lockedBy": "someone-else",
+						},
+					},
+				},
+			},
+			expectedLockedBy: "fake-user@example.com",
+		},
+	}
+	ctx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{
+			Subject: "fake-subject",
+			Email:   "fake-user@example.com",
+		},
+	)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := setStageLockedBy(ctx, testCase.obj, testCase.existing)
+			require.NoError(t, err)
+			stage, err := asStage(testCase.obj)
+			require.NoError(t, err)
+			if testCase.expectedLockedBy == "" {
+				require.Nil(t, stage.Spec.Lock)
+				return
+			}
+			require.Equal(t, testCase.expectedLockedBy, stage.Spec.Lock.LockedBy)
+		})
+	}
+}
+
+func TestSetStageLockedByIgnoresOtherKinds(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": kargoapi.GroupVersion.String(),
+			"kind":       "Warehouse",
+			"spec": map[string]any{
+				"lock": map[string]any{
+					"lockedBy": "someone-else",
+				},
+			},
+		},
+	}
+	require.NoError(t, setStageLockedBy(context.Background(), obj, nil))
+	lockedBy, _, err :=
+		unstructured.NestedString(obj.Object, "spec", "lock", "lockedBy")
+	require.NoError(t, err)
+	require.Equal(t, "someone-else", lockedBy)
+}
@@ -24,6 +24,7 @@ type ServerConfig struct {
 	TLSConfig                   *TLSConfig
 	OIDCConfig                  *oidc.Config
 	AdminConfig                 *AdminConfig
+	APITokenConfig              *APITokenConfig
 	DexProxyConfig              *dex.ProxyConfig
 	ArgoCDConfig                ArgoCDConfig
 	PermissiveCORSPolicyEnabled bool
@@ -44,6 +45,10 @@ func ServerConfigFromEnv() ServerConfig {
 		adminCfg := AdminConfigFromEnv()
 		cfg.AdminConfig = &adminCfg
 	}
+	if types.MustParseBool(os.GetEnv("API_TOKENS_ENABLED", "false")) {
+		apiTokenCfg := APITokenConfigFromEnv()
+		cfg.APITokenConfig = &apiTokenCfg
+	}
 	if types.MustParseBool(os.GetEnv("DEX_ENABLED", "false")) {
 		dexProxyCfg := dex.ProxyConfigFromEnv()
 		cfg.DexProxyConfig = &dexProxyCfg
@@ -90,6 +95,33 @@ func AdminConfigFromEnv() AdminConfig {
 	return cfg
 }
 
+// APITokenConfig represents configuration for API tokens issued to robot
+// accounts.
+type APITokenConfig struct {
+	// TokenIssuer is the value to be used in the ISS claim of API tokens. It must
+	// differ from the issuer of ID tokens for the admin account.
+	TokenIssuer string `envconfig:"API_TOKEN_ISSUER" required:"true"`
+	// TokenAudience is the value to be used in the AUD claim of API tokens.
+	TokenAudience string `envconfig:"API_TOKEN_AUDIENCE" required:"true"`
+	// TokenSigningKey is the key used to sign API tokens.
+	TokenSigningKey []byte `envconfig:"API_TOKEN_SIGNING_KEY" required:"true"`
+	// DefaultTokenTTL specifies how long API tokens are valid when no other
+	// duration is requested. i.e. The expiry will be the time of issue plus this
+	// duration.
+	DefaultTokenTTL time.Duration `envconfig:"API_TOKEN_DEFAULT_TTL" default:"720h"`
+	// MaxTokenTTL specifies the longest duration for which API tokens may be
+	// requested to be valid.
+	MaxTokenTTL time.Duration `envconfig:"API_TOKEN_MAX_TTL" default:"8760h"`
+}
+
+// APITokenConfigFromEnv returns an APITokenConfig populated from environment
+// variables.
+func APITokenConfigFromEnv() APITokenConfig {
+	var cfg APITokenConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type ArgoCDURLMap map[string]string
 
 func (a *ArgoCDURLMap) Decode(value string) error {
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// CreateAPIToken issues a new API token to a robot account. A robot account is
+// a ServiceAccount in a Project namespace. Requests bearing the token are
+// authorized as that ServiceAccount, so the caller must be permitted to create
+// tokens for it.
+func (s *server) CreateAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateAPITokenRequest],
+) (*connect.Response[svcv1alpha1.CreateAPITokenResponse], error) {
+	if err := s.validateAPITokensEnabled(); err != nil {
+		return nil, err
+	}
+
+	if err := validateProjectAndServiceAccountNonEmpty(
+		req.Msg.GetProject(),
+		req.Msg.GetServiceAccount(),
+	); err != nil {
+		return nil, err
+	}
+
+	ttl := s.cfg.APITokenConfig.DefaultTokenTTL
+	if req.Msg.GetTtl() != "" {
+		var err error
+		if ttl, err = time.ParseDuration(req.Msg.GetTtl()); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Wrapf(err, "error parsing ttl %q", req.Msg.GetTtl()),
+			)
+		}
+	}
+	if ttl <= 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("ttl must be positive"),
+		)
+	}
+	if ttl > s.cfg.APITokenConfig.MaxTokenTTL {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf(
+				"ttl must not exceed %s",
+				s.cfg.APITokenConfig.MaxTokenTTL,
+			),
+		)
+	}
+
+	if err := validateAPITokenScopes(req.Msg.GetScopes()); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	saKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetServiceAccount(),
+	}
+	if err := s.authorizeAPITokens(ctx, saKey); err != nil {
+		return nil, err
+	}
+	sa, err := s.getRobotAccount(ctx, saKey)
+	if err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	records, err := apitoken.GetRecords(sa)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	now := time.Now()
+	record := apitoken.Record{
+		ID:        uuid.NewString(),
+		Name:      req.Msg.GetName(),
+		Scopes:    req.Msg.GetScopes(),
+		CreatedAt: now.UTC().Truncate(time.Second),
+		ExpiresAt: now.Add(ttl).UTC().Truncate(time.Second),
+	}
+	token := jwt.NewWithClaims(
+		jwt.SigningMethodHS256,
+		apitoken.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(record.CreatedAt),
+				Issuer:    s.cfg.APITokenConfig.TokenIssuer,
+				Audience:  []string{s.cfg.APITokenConfig.TokenAudience},
+				NotBefore: jwt.NewNumericDate(record.CreatedAt),
+				Subject:   apitoken.Subject(saKey),
+				ID:        record.ID,
+				ExpiresAt: jwt.NewNumericDate(record.ExpiresAt),
+			},
+			Scopes: record.Scopes,
+		},
+	)
+	signedToken, err := token.SignedString(s.cfg.APITokenConfig.TokenSigningKey)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Wrap(err, "error signing API token"),
+		)
+	}
+
+	// Records of expired tokens serve no further purpose, so this is a good
+	// opportunity to prune them.
+	records = append(apitoken.RemoveExpired(records, now), record)
+	if err = apitoken.SetRecords(sa, records); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = s.internalClient.Update(ctx, sa); err != nil {
+		return nil, updateRobotAccountError(err, saKey)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.CreateAPITokenResponse{
+		ApiToken: apiTokenRecordToProto(saKey, record),
+		Token:    signedToken,
+	}), nil
+}
+
+// validateAPITokensEnabled returns an error if the server has not been
+// configured to issue API tokens.
+func (s *server) validateAPITokensEnabled() error {
+	if s.cfg.APITokenConfig == nil {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("API tokens are not enabled"),
+		)
+	}
+	return nil
+}
+
+func validateProjectAndServiceAccountNonEmpty(
+	project string,
+	serviceAccount string,
+) error {
+	if project == "" {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if serviceAccount == "" {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("service account should not be empty"),
+		)
+	}
+	return nil
+}
+
+// validateAPITokenScopes returns an error if any of the specified scopes is
+// not the name of a KargoService method.
+func validateAPITokenScopes(scopes []string) error {
+	methods := svcv1alpha1.File_service_v1alpha1_service_proto.Services().
+		ByName("KargoService").Methods()
+	for _, scope := range scopes {
+		if methods.ByName(protoreflect.Name(scope)) == nil {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("scope %q is not the name of an API method", scope),
+			)
+		}
+	}
+	return nil
+}
+
+// authorizeAPITokens returns an error if the user bound to the provided
+// context is not permitted to manage API tokens for the specified robot
+// account. If the key's name is empty, the user must be permitted to manage
+// API tokens for all robot accounts in the key's namespace.
+//
+// Requests bearing an API token are authorized as the robot account's
+// ServiceAccount, so only those who could obtain a Kubernetes token for that
+// ServiceAccount may manage its API tokens. Having been authorized in this
+// way, the user does not additionally need permission to read or update the
+// ServiceAccount in which the records of its API tokens are kept. Such
+// bookkeeping is done using the server's own internal client.
+func (s *server) authorizeAPITokens(
+	ctx context.Context,
+	key types.NamespacedName,
+) error {
+	return s.client.Authorize(
+		ctx,
+		"create",
+		corev1.SchemeGroupVersion.WithResource("serviceaccounts"),
+		"token",
+		key,
+	)
+}
+
+// getRobotAccount returns the ServiceAccount backing the specified robot
+// account. Callers must first have used authorizeAPITokens to authorize the
+// user, since the ServiceAccount is retrieved using the server's own internal
+// client.
+func (s *server) getRobotAccount(
+	ctx context.Context,
+	key types.NamespacedName,
+) (*corev1.ServiceAccount, error) {
+	sa := &corev1.ServiceAccount{}
+	if err := s.internalClient.Get(ctx, key, sa); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				errors.Errorf(
+					"ServiceAccount %q not found in namespace %q",
+					key.Name,
+					key.Namespace,
+				),
+			)
+		}
+		return nil, errors.Wrap(err, "get service account")
+	}
+	return sa, nil
+}
+
+func updateRobotAccountError(err error, key types.NamespacedName) error {
+	if kubeerr.IsConflict(err) {
+		return connect.NewError(
+			connect.CodeAborted,
+			errors.Errorf(
+				"ServiceAccount %q in namespace %q was modified concurrently; "+
+					"please try again",
+				key.Name,
+				key.Namespace,
+			),
+		)
+	}
+	return errors.Wrap(err, "update service account")
+}
+
+func apiTokenRecordToProto(
+	sa types.NamespacedName,
+	record apitoken.Record,
+) *svcv1alpha1.APIToken {
+	return &svcv1alpha1.APIToken{
+		Id:             record.ID,
+		Name:           record.Name,
+		Project:        sa.Namespace,
+		ServiceAccount: sa.Name,
+		Scopes:         record.Scopes,
+		CreateTime:     timestamppb.New(record.CreatedAt),
+		ExpiryTime:     timestamppb.New(record.ExpiresAt),
+	}
+}
@@ -0,0 +1,331 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	testCfg := &config.APITokenConfig{
+		TokenIssuer:     "fake-issuer",
+		TokenAudience:   "fake-audience",
+		TokenSigningKey: []byte("iwishtowashmyirishwristwatch"),
+		DefaultTokenTTL: time.Hour,
+		MaxTokenTTL:     24 * time.Hour,
+	}
+	testSets := map[string]struct {
+		cfg          *config.APITokenConfig
+		userInfo     *user.Info
+		tokenCreator bool
+		req          *svcv1alpha1.CreateAPITokenRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"API tokens not enabled": {
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		"empty service account": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"invalid ttl": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Ttl:            "forever",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"ttl exceeds maximum": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Ttl:            "48h",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"unknown scope": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Scopes:         []string{"DoEverything"},
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing ServiceAccount": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"not permitted to create tokens for ServiceAccount": {
+			cfg: testCfg,
+			// A user who is not bound to any ServiceAccount is denied without
+			// needing to ask the Kubernetes API server
+			userInfo: &user.Info{
+				Subject: "fake-subject",
+			},
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+			},
+			errExpected: true,
+		},
+		"success as non-admin permitted only to create tokens": {
+			cfg: testCfg,
+			userInfo: &user.Info{
+				Subject: "fake-subject",
+			},
+			tokenCreator: true,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Name:           "ci",
+				Scopes:         []string{"PromoteStage"},
+				Ttl:            "2h",
+			},
+		},
+		"success": {
+			cfg: testCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Name:           "ci",
+				Scopes:         []string{"PromoteStage"},
+				Ttl:            "2h",
+			},
+		},
+	}
+	for name, ts := range testSets {
+		ts := ts
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			userInfo := user.Info{
+				IsAdmin: true,
+			}
+			if ts.userInfo != nil {
+				userInfo = *ts.userInfo
+			}
+			ctx := user.ContextWithInfo(context.Background(), userInfo)
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+					&corev1.ServiceAccount{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "kargo-demo",
+							Name:      "robot",
+						},
+					},
+				).
+				Build()
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:         client,
+				internalClient: internalClient,
+				cfg: config.ServerConfig{
+					APITokenConfig: ts.cfg,
+				},
+			}
+			if ts.tokenCreator {
+				svr.client = newTokenCreatorClient(internalClient.Scheme())
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectFn = svr.validateProject
+			if ts.userInfo != nil {
+				svr.validateProjectFn = func(context.Context, string) error {
+					return nil
+				}
+			}
+			res, err := svr.CreateAPIToken(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				if ts.userInfo != nil {
+					require.True(t, kubeerr.IsForbidden(err))
+					return
+				}
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+
+			apiToken := res.Msg.GetApiToken()
+			require.NotEmpty(t, apiToken.GetId())
+			require.Equal(t, ts.req.GetName(), apiToken.GetName())
+			require.Equal(t, ts.req.GetProject(), apiToken.GetProject())
+			require.Equal(t, ts.req.GetServiceAccount(), apiToken.GetServiceAccount())
+			require.Equal(t, ts.req.GetScopes(), apiToken.GetScopes())
+			require.Equal(
+				t,
+				2*time.Hour,
+				apiToken.GetExpiryTime().AsTime().Sub(apiToken.GetCreateTime().AsTime()),
+			)
+
+			claims := apitoken.Claims{}
+			_, err = jwt.ParseWithClaims(
+				res.Msg.GetToken(),
+				&claims,
+				func(*jwt.Token) (any, error) {
+					return testCfg.TokenSigningKey, nil
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, apiToken.GetId(), claims.ID)
+			require.Equal(t, testCfg.TokenIssuer, claims.Issuer)
+			require.Equal(
+				t,
+				apitoken.Subject(types.NamespacedName{
+					Namespace: ts.req.GetProject(),
+					Name:      ts.req.GetServiceAccount(),
+				}),
+				claims.Subject,
+			)
+			require.Equal(t, ts.req.GetScopes(), claims.Scopes)
+
+			sa := &corev1.ServiceAccount{}
+			require.NoError(t, internalClient.Get(
+				ctx,
+				types.NamespacedName{
+					Namespace: ts.req.GetProject(),
+					Name:      ts.req.GetServiceAccount(),
+				},
+				sa,
+			))
+			records, err := apitoken.GetRecords(sa)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			require.Equal(t, apiToken.GetId(), records[0].ID)
+		})
+	}
+}
+
+// tokenCreatorClient simulates the authorizing client as it behaves for a
+// non-admin user who is permitted to create tokens for ServiceAccounts, but is
+// not permitted to otherwise read or modify them.
+type tokenCreatorClient struct {
+	client.Client
+}
+
+func newTokenCreatorClient(scheme *runtime.Scheme) kubernetes.Client {
+	forbidden := func(obj client.Object) error {
+		return kubeerr.NewForbidden(
+			corev1.SchemeGroupVersion.WithResource("serviceaccounts").GroupResource(),
+			obj.GetName(),
+			errors.New("not allowed"),
+		)
+	}
+	return &tokenCreatorClient{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(
+					_ context.Context,
+					_ client.WithWatch,
+					_ client.ObjectKey,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					return forbidden(obj)
+				},
+				List: func(
+					_ context.Context,
+					_ client.WithWatch,
+					list client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					return forbidden(&corev1.ServiceAccount{})
+				},
+				Update: func(
+					_ context.Context,
+					_ client.WithWatch,
+					obj client.Object,
+					_ ...client.UpdateOption,
+				) error {
+					return forbidden(obj)
+				},
+			}).
+			Build(),
+	}
+}
+
+func (c *tokenCreatorClient) Watch(
+	context.Context,
+	client.Object,
+	string,
+	metav1.ListOptions,
+) (watch.Interface, error) {
+	return nil, errors.New("not allowed")
+}
+
+func (c *tokenCreatorClient) Authorize(
+	_ context.Context,
+	verb string,
+	gvr schema.GroupVersionResource,
+	subresource string,
+	_ client.ObjectKey,
+) error {
+	if verb != "create" || gvr.Resource != "serviceaccounts" ||
+		subresource != "token" {
+		return errors.New("not allowed")
+	}
+	return nil
+}
@@ -36,6 +36,13 @@ func (s *server) createResource(
 	ctx context.Context,
 	obj *unstructured.Unstructured,
 ) *svcv1alpha1.CreateResourceResult {
+	if err := setStageLockedBy(ctx, obj, nil); err != nil {
+		return &svcv1alpha1.CreateResourceResult{
+			Result: &svcv1alpha1.CreateResourceResult_Error{
+				Error: err.Error(),
+			},
+		}
+	}
 	if err := s.client.Create(ctx, obj); err != nil {
 		return &svcv1alpha1.CreateResourceResult{
 			Result: &svcv1alpha1.CreateResourceResult_Error{
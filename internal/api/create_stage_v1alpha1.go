@@ -45,6 +45,9 @@ func (s *server) CreateStage(
 	if err := s.validateProject(ctx, stage.GetNamespace()); err != nil {
 		return nil, err
 	}
+	if err := setStageLockedBy(ctx, &stage, nil); err != nil {
+		return nil, err
+	}
 	if err := s.client.Create(ctx, &stage); err != nil {
 		if kubeerr.IsAlreadyExists(err) {
 			return nil, connect.NewError(connect.CodeAlreadyExists, err)
@@ -0,0 +1,355 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	libGit "github.com/akuity/kargo/internal/git"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	FreightChangeAdded   = "Added"
+	FreightChangeRemoved = "Removed"
+	FreightChangeChanged = "Changed"
+)
+
+// issueKeyRegex matches references to issues in commit messages. This covers
+// both Jira-style keys (e.g. ABC-123) and GitHub/GitLab-style references (e.g.
+// #123).
+var issueKeyRegex = regexp.MustCompile(`\b[A-Z][A-Z0-9_]+-[0-9]+\b|#[0-9]+\b`)
+
+// DiffFreight describes what changed between two pieces of Freight: images,
+// charts, and commits that were added, removed, or changed. For commits that
+// changed, the log of commits between the two is included.
+func (s *server) DiffFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.DiffFreightRequest],
+) (*connect.Response[svcv1alpha1.DiffFreightResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if req.Msg.GetFrom() == "" || req.Msg.GetTo() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("from and to should not be empty"),
+		)
+	}
+
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	from, err := s.getFreightByNameOrAlias(ctx, project, req.Msg.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getFreightByNameOrAlias(ctx, project, req.Msg.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	commitDiffs := diffCommits(from.Commits, to.Commits)
+	for _, commitDiff := range commitDiffs {
+		if commitDiff.Change != FreightChangeChanged {
+			continue
+		}
+		entries, err := s.getCommitLogFn(
+			ctx,
+			project,
+			commitDiff.RepoUrl,
+			commitDiff.FromId,
+			commitDiff.ToId,
+		)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		commitDiff.Log = make([]*svcv1alpha1.CommitLogEntry, len(entries))
+		for i, entry := range entries {
+			commitDiff.Log[i] = &svcv1alpha1.CommitLogEntry{
+				Id:        entry.ID,
+				Author:    entry.Author,
+				Message:   entry.Subject,
+				IssueKeys: parseIssueKeys(entry.Subject),
+			}
+		}
+	}
+
+	return connect.NewResponse(&svcv1alpha1.DiffFreightResponse{
+		Images:  diffImages(from.Images, to.Images),
+		Charts:  diffCharts(from.Charts, to.Charts),
+		Commits: commitDiffs,
+	}), nil
+}
+
+// getFreightByNameOrAlias returns the piece of Freight in the specified project
+// with the specified name or, failing that, the specified alias.
+func (s *server) getFreightByNameOrAlias(
+	ctx context.Context,
+	project string,
+	nameOrAlias string,
+) (*kargoapi.Freight, error) {
+	freight, err := s.getFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      nameOrAlias,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if freight != nil {
+		return freight, nil
+	}
+	freightList := kargoapi.FreightList{}
+	if err = s.listFreightFn(
+		ctx,
+		&freightList,
+		client.InNamespace(project),
+		client.MatchingLabels{kargoapi.AliasLabelKey: nameOrAlias},
+	); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(freightList.Items) == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Freight %q not found in namespace %q",
+				nameOrAlias,
+				project,
+			),
+		)
+	}
+	return &freightList.Items[0], nil
+}
+
+// getCommitLog fetches the history of the two specified commits from the
+// specified Git repository using credentials from the specified project and
+// returns the log of commits between them. Only commits are fetched; trees,
+// blobs, and unrelated branches are not.
+func (s *server) getCommitLog(
+	ctx context.Context,
+	project string,
+	repoURL string,
+	fromID string,
+	toID string,
+) ([]git.CommitLogEntry, error) {
+	repoCreds := git.RepoCredentials{}
+	creds, ok, err :=
+		s.credentialsDB.Get(ctx, project, credentials.TypeGit, repoURL)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for git repo %q",
+			repoURL,
+		)
+	}
+	if ok {
+		repoCreds = git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		}
+	}
+	repo, err := git.CloneHistory(repoURL, repoCreds, fromID, toID)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error fetching history from git repo %q",
+			repoURL,
+		)
+	}
+	defer repo.Close()
+	return repo.CommitLog(fromID, toID)
+}
+
+// diffImages returns the differences between two sets of images, matching
+// images by repository URL.
+func diffImages(from, to []kargoapi.Image) []*svcv1alpha1.ImageDiff {
+	diffs := []*svcv1alpha1.ImageDiff{}
+	toByRepo := make(map[string]kargoapi.Image, len(to))
+	for _, image := range to {
+		toByRepo[image.RepoURL] = image
+	}
+	fromRepos := make(map[string]struct{}, len(from))
+	for _, fromImage := range from {
+		fromRepos[fromImage.RepoURL] = struct{}{}
+		toImage, ok := toByRepo[fromImage.RepoURL]
+		if !ok {
+			diffs = append(diffs, &svcv1alpha1.ImageDiff{
+				RepoUrl:        fromImage.RepoURL,
+				Change:         FreightChangeRemoved,
+				FromTag:        fromImage.Tag,
+				FromDigest:     fromImage.Digest,
+				FromGitRepoUrl: fromImage.GitRepoURL,
+			})
+			continue
+		}
+		if fromImage.Tag == toImage.Tag && fromImage.Digest == toImage.Digest {
+			continue
+		}
+		diffs = append(diffs, &svcv1alpha1.ImageDiff{
+			RepoUrl:        fromImage.RepoURL,
+			Change:         FreightChangeChanged,
+			FromTag:        fromImage.Tag,
+			ToTag:          toImage.Tag,
+			FromDigest:     fromImage.Digest,
+			ToDigest:       toImage.Digest,
+			FromGitRepoUrl: fromImage.GitRepoURL,
+			ToGitRepoUrl:   toImage.GitRepoURL,
+			SourceDiffUrl: getImageSourceDiffURL(
+				fromImage.GitRepoURL,
+				toImage.GitRepoURL,
+			),
+		})
+	}
+	for _, toImage := range to {
+		if _, ok := fromRepos[toImage.RepoURL]; !ok {
+			diffs = append(diffs, &svcv1alpha1.ImageDiff{
+				RepoUrl:      toImage.RepoURL,
+				Change:       FreightChangeAdded,
+				ToTag:        toImage.Tag,
+				ToDigest:     toImage.Digest,
+				ToGitRepoUrl: toImage.GitRepoURL,
+			})
+		}
+	}
+	return diffs
+}
+
+// getImageSourceDiffURL returns a URL for comparing the source code of two
+// versions of an image, given URLs for the source code of each version. This
+// is only possible when both URLs point to the same GitHub repository, in the
+// form Warehouses record them in (i.e. <repo URL>/tree/<tag>). In all other
+// cases, an empty string is returned.
+func getImageSourceDiffURL(fromGitRepoURL, toGitRepoURL string) string {
+	fromRepo, fromRef, fromOK := strings.Cut(fromGitRepoURL, "/tree/")
+	toRepo, toRef, toOK := strings.Cut(toGitRepoURL, "/tree/")
+	if !fromOK || !toOK || fromRepo != toRepo ||
+		!strings.HasPrefix(fromRepo, "https://github.com/") {
+		return ""
+	}
+	return fmt.Sprintf("%s/compare/%s...%s", fromRepo, fromRef, toRef)
+}
+
+// diffCharts returns the differences between two sets of charts, matching
+// charts by repository URL and name.
+func diffCharts(from, to []kargoapi.Chart) []*svcv1alpha1.ChartDiff {
+	diffs := []*svcv1alpha1.ChartDiff{}
+	toByKey := make(map[string]kargoapi.Chart, len(to))
+	for _, chart := range to {
+		// path.Join accounts for the possibility that chart.Name is empty
+		toByKey[path.Join(chart.RepoURL, chart.Name)] = chart
+	}
+	fromKeys := make(map[string]struct{}, len(from))
+	for _, fromChart := range from {
+		key := path.Join(fromChart.RepoURL, fromChart.Name)
+		fromKeys[key] = struct{}{}
+		toChart, ok := toByKey[key]
+		if !ok {
+			diffs = append(diffs, &svcv1alpha1.ChartDiff{
+				RepoUrl:     fromChart.RepoURL,
+				Name:        fromChart.Name,
+				Change:      FreightChangeRemoved,
+				FromVersion: fromChart.Version,
+			})
+			continue
+		}
+		if fromChart.Version == toChart.Version {
+			continue
+		}
+		diffs = append(diffs, &svcv1alpha1.ChartDiff{
+			RepoUrl:     fromChart.RepoURL,
+			Name:        fromChart.Name,
+			Change:      FreightChangeChanged,
+			FromVersion: fromChart.Version,
+			ToVersion:   toChart.Version,
+		})
+	}
+	for _, toChart := range to {
+		if _, ok := fromKeys[path.Join(toChart.RepoURL, toChart.Name)]; !ok {
+			diffs = append(diffs, &svcv1alpha1.ChartDiff{
+				RepoUrl:   toChart.RepoURL,
+				Name:      toChart.Name,
+				Change:    FreightChangeAdded,
+				ToVersion: toChart.Version,
+			})
+		}
+	}
+	return diffs
+}
+
+// diffCommits returns the differences between two sets of commits, matching
+// commits by (normalized) repository URL. The commit log of commits that
+// changed is not populated.
+func diffCommits(from, to []kargoapi.GitCommit) []*svcv1alpha1.GitCommitDiff {
+	diffs := []*svcv1alpha1.GitCommitDiff{}
+	toByRepo := make(map[string]kargoapi.GitCommit, len(to))
+	for _, commit := range to {
+		toByRepo[libGit.NormalizeGitURL(commit.RepoURL)] = commit
+	}
+	fromRepos := make(map[string]struct{}, len(from))
+	for _, fromCommit := range from {
+		repo := libGit.NormalizeGitURL(fromCommit.RepoURL)
+		fromRepos[repo] = struct{}{}
+		toCommit, ok := toByRepo[repo]
+		if !ok {
+			diffs = append(diffs, &svcv1alpha1.GitCommitDiff{
+				RepoUrl: fromCommit.RepoURL,
+				Change:  FreightChangeRemoved,
+				FromId:  fromCommit.ID,
+			})
+			continue
+		}
+		if fromCommit.ID == toCommit.ID {
+			continue
+		}
+		diffs = append(diffs, &svcv1alpha1.GitCommitDiff{
+			RepoUrl: fromCommit.RepoURL,
+			Change:  FreightChangeChanged,
+			FromId:  fromCommit.ID,
+			ToId:    toCommit.ID,
+		})
+	}
+	for _, toCommit := range to {
+		if _, ok := fromRepos[libGit.NormalizeGitURL(toCommit.RepoURL)]; !ok {
+			diffs = append(diffs, &svcv1alpha1.GitCommitDiff{
+				RepoUrl: toCommit.RepoURL,
+				Change:  FreightChangeAdded,
+				ToId:    toCommit.ID,
+			})
+		}
+	}
+	return diffs
+}
+
+// parseIssueKeys returns the unique issue keys referenced in a commit message,
+// in the order in which they first appear.
+func parseIssueKeys(msg string) []string {
+	keys := []string{}
+	seen := map[string]struct{}{}
+	for _, key := range issueKeyRegex.FindAllString(msg, -1) {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
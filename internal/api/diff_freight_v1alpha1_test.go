@@ -0,0 +1,371 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestDiffFreight(t *testing.T) {
+	testFrom := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-from"},
+		Images: []kargoapi.Image{
+			{
+				RepoURL:    "fake-image",
+				GitRepoURL: "https://github.com/example/image/tree/v1.0.0",
+				Tag:        "v1.0.0",
+			},
+			{
+				RepoURL: "fake-unchanged-image",
+				Tag:     "v1.0.0",
+			},
+			{
+				RepoURL: "fake-removed-image",
+				Tag:     "v1.0.0",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RepoURL: "https://charts.example.com",
+				Name:    "fake-chart",
+				Version: "1.0.0",
+			},
+		},
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo",
+				ID:      "fake-from-commit",
+			},
+		},
+	}
+	testTo := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-to"},
+		Images: []kargoapi.Image{
+			{
+				RepoURL:    "fake-image",
+				GitRepoURL: "https://github.com/example/image/tree/v1.1.0",
+				Tag:        "v1.1.0",
+			},
+			{
+				RepoURL: "fake-unchanged-image",
+				Tag:     "v1.0.0",
+			},
+			{
+				RepoURL: "fake-added-image",
+				Tag:     "v1.0.0",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RepoURL: "https://charts.example.com",
+				Name:    "fake-chart",
+				Version: "1.1.0",
+			},
+		},
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo.git",
+				ID:      "fake-to-commit",
+			},
+		},
+	}
+	getFreightFn := func(
+		_ context.Context,
+		_ client.Client,
+		key types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		switch key.Name {
+		case testFrom.Name:
+			return testFrom, nil
+		case testTo.Name:
+			return testTo, nil
+		}
+		return nil, nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.DiffFreightRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.DiffFreightResponse], error)
+	}{
+		{
+			name:   "project not specified",
+			req:    &svcv1alpha1.DiffFreightRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "Freight not specified",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "fake-from",
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "fake-from",
+				To:      "fake-to",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.EqualError(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Freight not found",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "fake-from",
+				To:      "nonexistent",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "error getting commit log",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "fake-from",
+				To:      "fake-to",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getCommitLogFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					string,
+				) ([]git.CommitLogEntry, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.DiffFreightRequest{
+				Project: "fake-project",
+				From:    "fake-from",
+				To:      "fake-alias",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freightList, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freightList.Items = []kargoapi.Freight{*testTo}
+					return nil
+				},
+				getCommitLogFn: func(
+					_ context.Context,
+					_ string,
+					_ string,
+					fromID string,
+					toID string,
+				) ([]git.CommitLogEntry, error) {
+					require.Equal(t, "fake-from-commit", fromID)
+					require.Equal(t, "fake-to-commit", toID)
+					return []git.CommitLogEntry{
+						{
+							ID:      "fake-to-commit",
+							Author:  "Fake Author",
+							Subject: "Fix ABC-123 and #45 (again ABC-123)",
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.DiffFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.ImageDiff{
+						{
+							RepoUrl:        "fake-image",
+							Change:         FreightChangeChanged,
+							FromTag:        "v1.0.0",
+							ToTag:          "v1.1.0",
+							FromGitRepoUrl: "https://github.com/example/image/tree/v1.0.0",
+							ToGitRepoUrl:   "https://github.com/example/image/tree/v1.1.0",
+							SourceDiffUrl: "https://github.com/example/image/compare/" +
+								"v1.0.0...v1.1.0",
+						},
+						{
+							RepoUrl: "fake-removed-image",
+							Change:  FreightChangeRemoved,
+							FromTag: "v1.0.0",
+						},
+						{
+							RepoUrl: "fake-added-image",
+							Change:  FreightChangeAdded,
+							ToTag:   "v1.0.0",
+						},
+					},
+					res.Msg.GetImages(),
+				)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.ChartDiff{
+						{
+							RepoUrl:     "https://charts.example.com",
+							Name:        "fake-chart",
+							Change:      FreightChangeChanged,
+							FromVersion: "1.0.0",
+							ToVersion:   "1.1.0",
+						},
+					},
+					res.Msg.GetCharts(),
+				)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.GitCommitDiff{
+						{
+							RepoUrl: "https://github.com/example/repo",
+							Change:  FreightChangeChanged,
+							FromId:  "fake-from-commit",
+							ToId:    "fake-to-commit",
+							Log: []*svcv1alpha1.CommitLogEntry{
+								{
+									Id:        "fake-to-commit",
+									Author:    "Fake Author",
+									Message:   "Fix ABC-123 and #45 (again ABC-123)",
+									IssueKeys: []string{"ABC-123", "#45"},
+								},
+							},
+						},
+					},
+					res.Msg.GetCommits(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.DiffFreight(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
+
+func TestGetImageSourceDiffURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected string
+	}{
+		{
+			name: "unknown source",
+			from: "",
+			to:   "https://github.com/example/repo/tree/v1.1.0",
+		},
+		{
+			name: "different repositories",
+			from: "https://github.com/example/repo/tree/v1.0.0",
+			to:   "https://github.com/example/other-repo/tree/v1.1.0",
+		},
+		{
+			name: "not GitHub",
+			from: "https://gitlab.com/example/repo/tree/v1.0.0",
+			to:   "https://gitlab.com/example/repo/tree/v1.1.0",
+		},
+		{
+			name:     "same GitHub repository",
+			from:     "https://github.com/example/repo/tree/v1.0.0",
+			to:       "https://github.com/example/repo/tree/v1.1.0",
+			expected: "https://github.com/example/repo/compare/v1.0.0...v1.1.0",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				getImageSourceDiffURL(testCase.from, testCase.to),
+			)
+		})
+	}
+}
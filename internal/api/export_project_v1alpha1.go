@@ -0,0 +1,270 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// projectBundle is the set of resources making up an exported Project.
+type projectBundle struct {
+	project    *kargoapi.Project
+	secrets    []corev1.Secret
+	warehouses []kargoapi.Warehouse
+	stages     []kargoapi.Stage
+	freight    []kargoapi.Freight
+}
+
+// ExportProject serializes a Project, along with its Warehouses and Stages, as
+// a multi-document YAML manifest that ImportProject can consume. Freight,
+// including its approval and verification status, and credential Secrets are
+// optionally included. The values of credential Secrets are never exported;
+// only their names, labels and keys are.
+func (s *server) ExportProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ExportProjectRequest],
+) (*connect.Response[svcv1alpha1.ExportProjectResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	bundle := &projectBundle{project: &kargoapi.Project{}}
+	if err := s.client.Get(ctx, types.NamespacedName{Name: project}, bundle.project); err != nil {
+		return nil, errors.Wrap(err, "get project")
+	}
+	var warehouses kargoapi.WarehouseList
+	if err := s.client.List(ctx, &warehouses, client.InNamespace(project)); err != nil {
+		return nil, errors.Wrap(err, "list warehouses")
+	}
+	bundle.warehouses = warehouses.Items
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return nil, errors.Wrap(err, "list stages")
+	}
+	bundle.stages = stages.Items
+	if req.Msg.GetIncludeFreight() {
+		var freight kargoapi.FreightList
+		if err := s.listFreightFn(ctx, &freight, client.InNamespace(project)); err != nil {
+			return nil, errors.Wrap(err, "list freight")
+		}
+		bundle.freight = freight.Items
+	}
+	if req.Msg.GetIncludeCredentials() {
+		var secrets corev1.SecretList
+		if err := s.client.List(
+			ctx,
+			&secrets,
+			client.InNamespace(project),
+			client.HasLabels{credentials.SecretTypeLabelKey},
+		); err != nil {
+			return nil, errors.Wrap(err, "list secrets")
+		}
+		bundle.secrets = secrets.Items
+	}
+
+	manifest, err := marshalProjectBundle(bundle)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.ExportProjectResponse{
+		Manifest: manifest,
+	}), nil
+}
+
+// marshalProjectBundle returns a multi-document YAML manifest containing the
+// resources in the specified bundle, stripped of server-populated fields. The
+// Project comes first, followed by Secrets, Warehouses, Stages (upstream
+// Stages before their subscribers) and Freight, which is the order in which
+// ImportProject applies them.
+func marshalProjectBundle(bundle *projectBundle) ([]byte, error) {
+	objs := make(
+		[]any,
+		0,
+		1+len(bundle.secrets)+len(bundle.warehouses)+len(bundle.stages)+len(bundle.freight),
+	)
+	objs = append(objs, &kargoapi.Project{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kargoapi.GroupVersion.String(), Kind: "Project"},
+		ObjectMeta: exportObjectMeta(bundle.project.ObjectMeta),
+		Spec:       bundle.project.Spec,
+	})
+
+	secrets := sortedByName(bundle.secrets, func(s corev1.Secret) string { return s.Name })
+	for _, secret := range secrets {
+		// Only the keys are exported. Values must be filled in by hand before or
+		// after importing.
+		data := make(map[string][]byte, len(secret.Data)+len(secret.StringData))
+		for k := range secret.Data {
+			data[k] = []byte{}
+		}
+		for k := range secret.StringData {
+			data[k] = []byte{}
+		}
+		objs = append(objs, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: exportObjectMeta(secret.ObjectMeta),
+			Type:       secret.Type,
+			Data:       data,
+		})
+	}
+
+	warehouses := sortedByName(bundle.warehouses, func(w kargoapi.Warehouse) string { return w.Name })
+	for _, warehouse := range warehouses {
+		objs = append(objs, &kargoapi.Warehouse{
+			TypeMeta:   metav1.TypeMeta{APIVersion: kargoapi.GroupVersion.String(), Kind: "Warehouse"},
+			ObjectMeta: exportObjectMeta(warehouse.ObjectMeta),
+			Spec:       warehouse.Spec,
+		})
+	}
+
+	for _, stage := range sortStagesBySubscriptions(bundle.stages) {
+		objs = append(objs, &kargoapi.Stage{
+			TypeMeta:   metav1.TypeMeta{APIVersion: kargoapi.GroupVersion.String(), Kind: "Stage"},
+			ObjectMeta: exportObjectMeta(stage.ObjectMeta),
+			Spec:       stage.Spec,
+		})
+	}
+
+	freight := sortedByName(bundle.freight, func(f kargoapi.Freight) string { return f.Name })
+	for _, f := range freight {
+		meta := exportObjectMeta(f.ObjectMeta)
+		// The Warehouse the Freight came from is recorded as an owner reference.
+		// Its UID is meaningless outside this cluster, so ImportProject fills it
+		// in again.
+		for _, ownerRef := range f.OwnerReferences {
+			if ownerRef.APIVersion == kargoapi.GroupVersion.String() &&
+				ownerRef.Kind == "Warehouse" {
+				ownerRef.UID = ""
+				meta.OwnerReferences = append(meta.OwnerReferences, ownerRef)
+			}
+		}
+		objs = append(objs, &kargoapi.Freight{
+			TypeMeta:   metav1.TypeMeta{APIVersion: kargoapi.GroupVersion.String(), Kind: "Freight"},
+			ObjectMeta: meta,
+			ID:         f.ID,
+			Commits:    f.Commits,
+			Images:     f.Images,
+			Charts:     f.Charts,
+			Status:     f.Status,
+		})
+	}
+
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		data, err := sigyaml.Marshal(obj)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal resource")
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+// exportObjectMeta returns a copy of the specified ObjectMeta containing only
+// the fields that are meaningful outside the cluster it was read from.
+// Annotations that are either transient or may contain a copy of the
+// resource's full contents are dropped.
+func exportObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	exported := metav1.ObjectMeta{
+		Name:      meta.Name,
+		Namespace: meta.Namespace,
+		Labels:    meta.Labels,
+	}
+	for k, v := range meta.Annotations {
+		if k == kargoapi.AnnotationKeyRefresh || k == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+		if exported.Annotations == nil {
+			exported.Annotations = map[string]string{}
+		}
+		exported.Annotations[k] = v
+	}
+	return exported
+}
+
+// sortedByName returns a copy of the specified items sorted by name.
+func sortedByName[T any](items []T, name func(T) string) []T {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return name(sorted[i]) < name(sorted[j])
+	})
+	return sorted
+}
+
+// sortStagesBySubscriptions returns a copy of the specified Stages in which
+// every Stage appears after all the upstream Stages it subscribes to. Stages
+// that are otherwise unordered relative to one another are sorted by name.
+// Stages that are part of a subscription cycle cannot be ordered and are
+// appended last, sorted by name.
+func sortStagesBySubscriptions(stages []kargoapi.Stage) []kargoapi.Stage {
+	stages = sortedByName(stages, func(s kargoapi.Stage) string { return s.Name })
+	known := make(map[string]struct{}, len(stages))
+	for _, stage := range stages {
+		known[stage.Name] = struct{}{}
+	}
+	sorted := make([]kargoapi.Stage, 0, len(stages))
+	placed := make(map[string]struct{}, len(stages))
+	for len(sorted) < len(stages) {
+		progressed := false
+		for _, stage := range stages {
+			if _, ok := placed[stage.Name]; ok {
+				continue
+			}
+			if !upstreamStagesPlaced(stage, known, placed) {
+				continue
+			}
+			sorted = append(sorted, stage)
+			placed[stage.Name] = struct{}{}
+			progressed = true
+		}
+		if !progressed {
+			for _, stage := range stages {
+				if _, ok := placed[stage.Name]; !ok {
+					sorted = append(sorted, stage)
+				}
+			}
+			break
+		}
+	}
+	return sorted
+}
+
+// upstreamStagesPlaced returns true if every upstream Stage the specified
+// Stage subscribes to is either unknown or already placed.
+func upstreamStagesPlaced(
+	stage kargoapi.Stage,
+	known map[string]struct{},
+	placed map[string]struct{},
+) bool {
+	if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return true
+	}
+	for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+		if _, ok := known[upstream.Name]; !ok {
+			continue
+		}
+		if _, ok := placed[upstream.Name]; !ok {
+			return false
+		}
+	}
+	return true
+}
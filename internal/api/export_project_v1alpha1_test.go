@@ -0,0 +1,186 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestMarshalProjectBundle(t *testing.T) {
+	bundle := &projectBundle{
+		project: &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "kargo-demo",
+				UID:             "fake-uid",
+				ResourceVersion: "42",
+			},
+		},
+		secrets: []corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "repo",
+				Labels: map[string]string{
+					"kargo.akuity.io/secret-type": "repository",
+				},
+				Annotations: map[string]string{
+					corev1.LastAppliedConfigAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+				},
+			},
+			Data: map[string][]byte{"password": []byte("secret")},
+		}},
+		warehouses: []kargoapi.Warehouse{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "images",
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyRefresh: "now",
+				},
+			},
+			Spec: &kargoapi.WarehouseSpec{},
+		}},
+		stages: []kargoapi.Stage{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kargo-demo", Name: "a-uat"},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{{Name: "test"}},
+					},
+				},
+				Status: kargoapi.StageStatus{Phase: kargoapi.StagePhaseSteady},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kargo-demo", Name: "test"},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{Warehouse: "images"},
+				},
+			},
+		},
+		freight: []kargoapi.Freight{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "abc123",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: kargoapi.GroupVersion.String(),
+					Kind:       "Warehouse",
+					Name:       "images",
+					UID:        "fake-warehouse-uid",
+				}},
+			},
+			ID:     "abc123",
+			Images: []kargoapi.Image{{RepoURL: "nginx", Tag: "1.25.3"}},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{"test": {}},
+			},
+		}},
+	}
+
+	manifest, err := marshalProjectBundle(bundle)
+	require.NoError(t, err)
+
+	projects, others, err := splitYAML(manifest)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, "kargo-demo", projects[0].GetName())
+	require.Empty(t, projects[0].GetUID())
+	require.Empty(t, projects[0].GetResourceVersion())
+
+	var kinds, names []string
+	for _, obj := range others {
+		kinds = append(kinds, obj.GetKind())
+		names = append(names, obj.GetName())
+	}
+	require.Equal(t, []string{"Secret", "Warehouse", "Stage", "Stage", "Freight"}, kinds)
+	require.Equal(t, []string{"repo", "images", "test", "a-uat", "abc123"}, names)
+
+	// Secret values must never be exported, including by way of annotations
+	secret := others[0]
+	require.Empty(t, secret.GetAnnotations())
+	require.Equal(t, map[string]any{"password": ""}, secret.Object["data"])
+
+	// Transient annotations are dropped
+	require.Empty(t, others[1].GetAnnotations())
+
+	// Stage status is dropped
+	require.Empty(t, others[3].Object["status"])
+
+	// Freight status is kept and the Warehouse UID is dropped
+	freight := others[4]
+	require.Contains(t, freight.Object["status"], "verifiedIn")
+	ownerRefs := freight.GetOwnerReferences()
+	require.Len(t, ownerRefs, 1)
+	require.Equal(t, "images", ownerRefs[0].Name)
+	require.Empty(t, ownerRefs[0].UID)
+}
+
+func TestSortStagesBySubscriptions(t *testing.T) {
+	newStage := func(name string, upstream ...string) kargoapi.Stage {
+		subs := make([]kargoapi.StageSubscription, 0, len(upstream))
+		for _, u := range upstream {
+			subs = append(subs, kargoapi.StageSubscription{Name: u})
+		}
+		return kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{UpstreamStages: subs},
+			},
+		}
+	}
+	testCases := []struct {
+		name     string
+		stages   []kargoapi.Stage
+		expected []string
+	}{
+		{
+			name: "no stages",
+		},
+		{
+			name: "linear pipeline",
+			stages: []kargoapi.Stage{
+				newStage("prod", "uat"),
+				newStage("uat", "test"),
+				newStage("test"),
+			},
+			expected: []string{"test", "uat", "prod"},
+		},
+		{
+			name: "fan out and in",
+			stages: []kargoapi.Stage{
+				newStage("prod", "uat-a", "uat-b"),
+				newStage("uat-b", "test"),
+				newStage("uat-a", "test"),
+				newStage("test"),
+			},
+			expected: []string{"test", "uat-a", "uat-b", "prod"},
+		},
+		{
+			name: "unknown upstream stage",
+			stages: []kargoapi.Stage{
+				newStage("uat", "missing"),
+				newStage("test"),
+			},
+			expected: []string{"test", "uat"},
+		},
+		{
+			name: "cycle",
+			stages: []kargoapi.Stage{
+				newStage("b", "a"),
+				newStage("a", "b"),
+				newStage("test"),
+			},
+			expected: []string{"test", "a", "b"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var names []string
+			for _, stage := range sortStagesBySubscriptions(testCase.stages) {
+				names = append(names, stage.Name)
+			}
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
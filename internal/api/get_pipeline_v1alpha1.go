@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	PipelineNodeKindWarehouse = "Warehouse"
+	PipelineNodeKindStage     = "Stage"
+)
+
+// GetPipeline returns the graph of Warehouses and Stages in a Project. Nodes
+// are Warehouses and Stages. Edges are subscriptions and point from the source
+// of Freight to the subscribing Stage.
+func (s *server) GetPipeline(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetPipelineRequest],
+) (*connect.Response[svcv1alpha1.GetPipelineResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	var warehouses kargoapi.WarehouseList
+	if err := s.client.List(
+		ctx,
+		&warehouses,
+		client.InNamespace(req.Msg.GetProject()),
+	); err != nil {
+		return nil, errors.Wrap(err, "list warehouses")
+	}
+	var stages kargoapi.StageList
+	if err := s.client.List(
+		ctx,
+		&stages,
+		client.InNamespace(req.Msg.GetProject()),
+	); err != nil {
+		return nil, errors.Wrap(err, "list stages")
+	}
+	var freight kargoapi.FreightList
+	if err := s.listFreightFn(
+		ctx,
+		&freight,
+		client.InNamespace(req.Msg.GetProject()),
+	); err != nil {
+		return nil, errors.Wrap(err, "list freight")
+	}
+
+	nodes, edges := buildPipeline(warehouses.Items, stages.Items, freight.Items)
+	return connect.NewResponse(&svcv1alpha1.GetPipelineResponse{
+		Nodes: nodes,
+		Edges: edges,
+	}), nil
+}
+
+// buildPipeline returns the nodes and edges of the graph formed by the
+// specified Warehouses and Stages. Freight is used only to look up the aliases
+// of Stages' current Freight. Subscriptions to Warehouses or Stages that do not
+// exist are omitted. Nodes and edges are returned in a stable order.
+func buildPipeline(
+	warehouses []kargoapi.Warehouse,
+	stages []kargoapi.Stage,
+	freight []kargoapi.Freight,
+) ([]*svcv1alpha1.PipelineNode, []*svcv1alpha1.PipelineEdge) {
+	aliases := make(map[string]string, len(freight))
+	for _, f := range freight {
+		aliases[f.Name] = f.Labels[kargoapi.AliasLabelKey]
+	}
+
+	nodes := make([]*svcv1alpha1.PipelineNode, 0, len(warehouses)+len(stages))
+	warehouseNames := make(map[string]struct{}, len(warehouses))
+	for _, warehouse := range warehouses {
+		warehouseNames[warehouse.Name] = struct{}{}
+		nodes = append(nodes, &svcv1alpha1.PipelineNode{
+			Kind: PipelineNodeKindWarehouse,
+			Name: warehouse.Name,
+		})
+	}
+	stageNames := make(map[string]struct{}, len(stages))
+	for _, stage := range stages {
+		stageNames[stage.Name] = struct{}{}
+		node := &svcv1alpha1.PipelineNode{
+			Kind:  PipelineNodeKindStage,
+			Name:  stage.Name,
+			Phase: string(stage.Status.Phase),
+		}
+		if stage.Status.CurrentFreight != nil {
+			node.CurrentFreight = stage.Status.CurrentFreight.ID
+			node.CurrentFreightAlias = aliases[stage.Status.CurrentFreight.ID]
+		}
+		if stage.Status.Health != nil {
+			node.Health = string(stage.Status.Health.Status)
+		}
+		nodes = append(nodes, node)
+	}
+
+	var edges []*svcv1alpha1.PipelineEdge
+	for _, stage := range stages {
+		if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+			continue
+		}
+		for _, warehouse := range stage.Spec.Subscriptions.GetWarehouses() {
+			if _, ok := warehouseNames[warehouse]; !ok {
+				continue
+			}
+			edges = append(edges, &svcv1alpha1.PipelineEdge{
+				FromKind: PipelineNodeKindWarehouse,
+				FromName: warehouse,
+				ToKind:   PipelineNodeKindStage,
+				ToName:   stage.Name,
+			})
+		}
+		for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+			if _, ok := stageNames[upstream.Name]; !ok {
+				continue
+			}
+			edges = append(edges, &svcv1alpha1.PipelineEdge{
+				FromKind: PipelineNodeKindStage,
+				FromName: upstream.Name,
+				ToKind:   PipelineNodeKindStage,
+				ToName:   stage.Name,
+			})
+		}
+	}
+
+	// Warehouses are listed before Stages, since Freight flows from the former
+	// to the latter.
+	kindOrder := func(kind string) int {
+		if kind == PipelineNodeKindWarehouse {
+			return 0
+		}
+		return 1
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Kind != nodes[j].Kind {
+			return kindOrder(nodes[i].Kind) < kindOrder(nodes[j].Kind)
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+	sort.SliceStable(edges, func(i, j int) bool {
+		if edges[i].ToName != edges[j].ToName {
+			return edges[i].ToName < edges[j].ToName
+		}
+		if edges[i].FromKind != edges[j].FromKind {
+			return kindOrder(edges[i].FromKind) < kindOrder(edges[j].FromKind)
+		}
+		return edges[i].FromName < edges[j].FromName
+	})
+	return nodes, edges
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestBuildPipeline(t *testing.T) {
+	testCases := []struct {
+		name       string
+		warehouses []kargoapi.Warehouse
+		stages     []kargoapi.Stage
+		freight    []kargoapi.Freight
+		assertions func(*testing.T, []*svcv1alpha1.PipelineNode, []*svcv1alpha1.PipelineEdge)
+	}{
+		{
+			name: "empty project",
+			assertions: func(
+				t *testing.T,
+				nodes []*svcv1alpha1.PipelineNode,
+				edges []*svcv1alpha1.PipelineEdge,
+			) {
+				require.Empty(t, nodes)
+				require.Empty(t, edges)
+			},
+		},
+		{
+			name: "linear pipeline",
+			warehouses: []kargoapi.Warehouse{
+				{ObjectMeta: metav1.ObjectMeta{Name: "images"}},
+			},
+			stages: []kargoapi.Stage{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "uat"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{{Name: "test"}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouse: "images",
+						},
+					},
+					Status: kargoapi.StageStatus{
+						Phase: kargoapi.StagePhaseSteady,
+						CurrentFreight: &kargoapi.FreightReference{
+							ID: "abc123",
+						},
+						Health: &kargoapi.Health{
+							Status: kargoapi.HealthStateHealthy,
+						},
+					},
+				},
+			},
+			freight: []kargoapi.Freight{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "abc123",
+						Labels: map[string]string{
+							kargoapi.AliasLabelKey: "wonky-wombat",
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				nodes []*svcv1alpha1.PipelineNode,
+				edges []*svcv1alpha1.PipelineEdge,
+			) {
+				require.Equal(
+					t,
+					[]*svcv1alpha1.PipelineNode{
+						{Kind: PipelineNodeKindWarehouse, Name: "images"},
+						{
+							Kind:                PipelineNodeKindStage,
+							Name:                "test",
+							CurrentFreight:      "abc123",
+							CurrentFreightAlias: "wonky-wombat",
+							Health:              string(kargoapi.HealthStateHealthy),
+							Phase:               string(kargoapi.StagePhaseSteady),
+						},
+						{Kind: PipelineNodeKindStage, Name: "uat"},
+					},
+					nodes,
+				)
+				require.Equal(
+					t,
+					[]*svcv1alpha1.PipelineEdge{
+						{
+							FromKind: PipelineNodeKindWarehouse,
+							FromName: "images",
+							ToKind:   PipelineNodeKindStage,
+							ToName:   "test",
+						},
+						{
+							FromKind: PipelineNodeKindStage,
+							FromName: "test",
+							ToKind:   PipelineNodeKindStage,
+							ToName:   "uat",
+						},
+					},
+					edges,
+				)
+			},
+		},
+		{
+			name: "dangling subscriptions are omitted",
+			stages: []kargoapi.Stage{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouse: "missing",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "uat"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{{Name: "missing"}},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				nodes []*svcv1alpha1.PipelineNode,
+				edges []*svcv1alpha1.PipelineEdge,
+			) {
+				require.Len(t, nodes, 2)
+				require.Empty(t, edges)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			nodes, edges := buildPipeline(
+				testCase.warehouses,
+				testCase.stages,
+				testCase.freight,
+			)
+			testCase.assertions(t, nodes, edges)
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	ImportActionCreated   = "Created"
+	ImportActionUpdated   = "Updated"
+	ImportActionUnchanged = "Unchanged"
+)
+
+// ImportProject creates or updates the resources in a manifest produced by
+// ExportProject. Resources are applied in dependency order: the Project first,
+// then Secrets, Warehouses, Stages (upstream Stages before their subscribers)
+// and finally Freight. Importing the same manifest more than once is safe.
+// Existing Secrets are never modified, since exported Secrets carry no values.
+// If a Project name is specified, the resources are imported into a Project by
+// that name instead of the one they were exported from.
+func (s *server) ImportProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ImportProjectRequest],
+) (*connect.Response[svcv1alpha1.ImportProjectResponse], error) {
+	bundle, err := parseProjectBundle(
+		req.Msg.GetManifest(),
+		strings.TrimSpace(req.Msg.GetProject()),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var imported []*svcv1alpha1.ImportedResource
+	record := func(kind, name, action string) {
+		imported = append(imported, &svcv1alpha1.ImportedResource{
+			Kind:   kind,
+			Name:   name,
+			Action: action,
+		})
+	}
+
+	project := bundle.project
+	existingProject := &kargoapi.Project{}
+	action, err := s.importObject(ctx, project, existingProject, func() bool {
+		return mergeImportedSpec(existingProject, project, &existingProject.Spec, project.Spec)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "import Project %q", project.Name)
+	}
+	record("Project", project.Name, action)
+
+	for i := range bundle.secrets {
+		secret := &bundle.secrets[i]
+		if action, err = s.importObject(ctx, secret, &corev1.Secret{}, func() bool {
+			return false
+		}); err != nil {
+			return nil, errors.Wrapf(err, "import Secret %q", secret.Name)
+		}
+		record("Secret", secret.Name, action)
+	}
+
+	warehouseUIDs := make(map[string]types.UID, len(bundle.warehouses))
+	for i := range bundle.warehouses {
+		warehouse := &bundle.warehouses[i]
+		existing := &kargoapi.Warehouse{}
+		if action, err = s.importObject(ctx, warehouse, existing, func() bool {
+			return mergeImportedSpec(existing, warehouse, &existing.Spec, warehouse.Spec)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "import Warehouse %q", warehouse.Name)
+		}
+		warehouseUIDs[warehouse.Name] = warehouse.UID
+		if action != ImportActionCreated {
+			warehouseUIDs[warehouse.Name] = existing.UID
+		}
+		record("Warehouse", warehouse.Name, action)
+	}
+
+	for i := range bundle.stages {
+		stage := &bundle.stages[i]
+		// Any lock on an imported Stage is attributed to the user importing it.
+		if err = setStageLockedBy(ctx, stage, nil); err != nil {
+			return nil, errors.Wrapf(err, "import Stage %q", stage.Name)
+		}
+		existing := &kargoapi.Stage{}
+		if action, err = s.importObject(ctx, stage, existing, func() bool {
+			return mergeImportedSpec(existing, stage, &existing.Spec, stage.Spec)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "import Stage %q", stage.Name)
+		}
+		record("Stage", stage.Name, action)
+	}
+
+	for i := range bundle.freight {
+		freight := &bundle.freight[i]
+		if action, err = s.importFreight(ctx, freight, warehouseUIDs); err != nil {
+			return nil, errors.Wrapf(err, "import Freight %q", freight.Name)
+		}
+		record("Freight", freight.Name, action)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ImportProjectResponse{
+		Project:   project.Name,
+		Resources: imported,
+	}), nil
+}
+
+// parseProjectBundle parses a manifest produced by ExportProject. The manifest
+// must contain exactly one Project. All other resources must belong to that
+// Project's namespace. If target is non-empty, the Project is renamed to it
+// and all other resources are moved to the corresponding namespace.
+func parseProjectBundle(manifest []byte, target string) (*projectBundle, error) {
+	projects, otherResources, err := splitYAML(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse manifest")
+	}
+	if len(projects) != 1 {
+		return nil, errors.Errorf("manifest must contain exactly one Project; found %d", len(projects))
+	}
+
+	bundle := &projectBundle{project: &kargoapi.Project{}}
+	if err = fromUnstructured(projects[0], bundle.project); err != nil {
+		return nil, err
+	}
+	source := bundle.project.Name
+	if source == "" {
+		return nil, errors.New("Project name should not be empty")
+	}
+	if target == "" {
+		target = source
+	}
+	bundle.project.ObjectMeta = importObjectMeta(bundle.project.ObjectMeta, "")
+	bundle.project.Name = target
+
+	for _, obj := range otherResources {
+		if ns := obj.GetNamespace(); ns != "" && ns != source {
+			return nil, errors.Errorf(
+				"%s %q belongs to namespace %q, not to Project %q",
+				obj.GetKind(),
+				obj.GetName(),
+				ns,
+				source,
+			)
+		}
+		gvk := obj.GroupVersionKind()
+		switch {
+		case gvk.Group == "" && gvk.Kind == "Secret":
+			var secret corev1.Secret
+			if err = fromUnstructured(obj, &secret); err != nil {
+				return nil, err
+			}
+			secret.ObjectMeta = importObjectMeta(secret.ObjectMeta, target)
+			bundle.secrets = append(bundle.secrets, secret)
+		case gvk.Group == kargoapi.GroupVersion.Group && gvk.Kind == "Warehouse":
+			var warehouse kargoapi.Warehouse
+			if err = fromUnstructured(obj, &warehouse); err != nil {
+				return nil, err
+			}
+			warehouse.ObjectMeta = importObjectMeta(warehouse.ObjectMeta, target)
+			warehouse.Status = kargoapi.WarehouseStatus{}
+			bundle.warehouses = append(bundle.warehouses, warehouse)
+		case gvk.Group == kargoapi.GroupVersion.Group && gvk.Kind == "Stage":
+			var stage kargoapi.Stage
+			if err = fromUnstructured(obj, &stage); err != nil {
+				return nil, err
+			}
+			stage.ObjectMeta = importObjectMeta(stage.ObjectMeta, target)
+			stage.Status = kargoapi.StageStatus{}
+			bundle.stages = append(bundle.stages, stage)
+		case gvk.Group == kargoapi.GroupVersion.Group && gvk.Kind == "Freight":
+			var freight kargoapi.Freight
+			if err = fromUnstructured(obj, &freight); err != nil {
+				return nil, err
+			}
+			ownerRefs := freight.OwnerReferences
+			freight.ObjectMeta = importObjectMeta(freight.ObjectMeta, target)
+			freight.OwnerReferences = ownerRefs
+			bundle.freight = append(bundle.freight, freight)
+		default:
+			return nil, errors.Errorf(
+				"%s %q cannot be imported into a Project",
+				gvk.Kind,
+				obj.GetName(),
+			)
+		}
+	}
+	bundle.stages = sortStagesBySubscriptions(bundle.stages)
+	return bundle, nil
+}
+
+func fromUnstructured(obj unstructured.Unstructured, into any) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, into); err != nil {
+		return errors.Wrapf(err, "error converting %s %q", obj.GetKind(), obj.GetName())
+	}
+	return nil
+}
+
+// importObjectMeta returns a copy of the specified ObjectMeta that is suitable
+// for creating a resource in the specified namespace.
+func importObjectMeta(meta metav1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	imported := exportObjectMeta(meta)
+	imported.Namespace = namespace
+	return imported
+}
+
+// importObject creates the specified object if it does not already exist. If
+// it does exist, it is read into existing and merge is called to merge the
+// specified object into it. If merge reports that anything changed, existing
+// is updated. The action taken is returned.
+func (s *server) importObject(
+	ctx context.Context,
+	obj client.Object,
+	existing client.Object,
+	merge func() bool,
+) (string, error) {
+	err := s.client.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if kubeerr.IsNotFound(err) {
+		if err = s.client.Create(ctx, obj); err != nil {
+			return "", errors.Wrap(err, "create")
+		}
+		return ImportActionCreated, nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "get")
+	}
+	if !merge() {
+		return ImportActionUnchanged, nil
+	}
+	if err = s.client.Update(ctx, existing); err != nil {
+		return "", errors.Wrap(err, "update")
+	}
+	return ImportActionUpdated, nil
+}
+
+// mergeImportedSpec merges the labels, annotations and spec of an imported
+// resource into an existing one. Labels and annotations that exist only on the
+// existing resource are preserved. It returns true if anything changed.
+func mergeImportedSpec[T any](
+	existing metav1.Object,
+	imported metav1.Object,
+	existingSpec *T,
+	importedSpec T,
+) bool {
+	changed := false
+	labels, labelsChanged := mergeStringMaps(existing.GetLabels(), imported.GetLabels())
+	if labelsChanged {
+		existing.SetLabels(labels)
+		changed = true
+	}
+	annotations, annotationsChanged :=
+		mergeStringMaps(existing.GetAnnotations(), imported.GetAnnotations())
+	if annotationsChanged {
+		existing.SetAnnotations(annotations)
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(*existingSpec, importedSpec) {
+		*existingSpec = importedSpec
+		changed = true
+	}
+	return changed
+}
+
+func mergeStringMaps(dst, src map[string]string) (map[string]string, bool) {
+	changed := false
+	for k, v := range src {
+		if cur, ok := dst[k]; ok && cur == v {
+			continue
+		}
+		if dst == nil {
+			dst = make(map[string]string, len(src))
+		}
+		dst[k] = v
+		changed = true
+	}
+	return dst, changed
+}
+
+// importFreight creates the specified Freight if it does not already exist and
+// merges its approval and verification status into that of the Freight in the
+// cluster. The owner reference to the Warehouse the Freight came from is
+// resolved against the imported Warehouses, or failing that, the Warehouses
+// already in the Project.
+func (s *server) importFreight(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	warehouseUIDs map[string]types.UID,
+) (string, error) {
+	for i, ownerRef := range freight.OwnerReferences {
+		if ownerRef.APIVersion != kargoapi.GroupVersion.String() ||
+			ownerRef.Kind != "Warehouse" {
+			continue
+		}
+		uid, ok := warehouseUIDs[ownerRef.Name]
+		if !ok {
+			warehouse := &kargoapi.Warehouse{}
+			if err := s.client.Get(
+				ctx,
+				types.NamespacedName{Namespace: freight.Namespace, Name: ownerRef.Name},
+				warehouse,
+			); err != nil {
+				return "", errors.Wrapf(err, "get Warehouse %q", ownerRef.Name)
+			}
+			uid = warehouse.UID
+		}
+		freight.OwnerReferences[i].UID = uid
+	}
+
+	status := freight.Status
+	action := ImportActionUnchanged
+	existing := &kargoapi.Freight{}
+	err := s.client.Get(ctx, client.ObjectKeyFromObject(freight), existing)
+	switch {
+	case kubeerr.IsNotFound(err):
+		freight.Status = kargoapi.FreightStatus{}
+		if err = s.client.Create(ctx, freight); err != nil {
+			return "", errors.Wrap(err, "create")
+		}
+		existing = freight
+		action = ImportActionCreated
+	case err != nil:
+		return "", errors.Wrap(err, "get")
+	}
+
+	if !mergeFreightStatus(&existing.Status, status) {
+		return action, nil
+	}
+	if err = s.client.Status().Update(ctx, existing); err != nil {
+		return "", errors.Wrap(err, "update status")
+	}
+	if action == ImportActionUnchanged {
+		action = ImportActionUpdated
+	}
+	return action, nil
+}
+
+// mergeFreightStatus adds the verifications and approvals recorded in the
+// imported status to the existing status. Anything already recorded in the
+// existing status takes precedence. It returns true if anything changed.
+func mergeFreightStatus(existing *kargoapi.FreightStatus, imported kargoapi.FreightStatus) bool {
+	changed := false
+	for stage, verified := range imported.VerifiedIn {
+		if _, ok := existing.VerifiedIn[stage]; ok {
+			continue
+		}
+		if existing.VerifiedIn == nil {
+			existing.VerifiedIn = map[string]kargoapi.VerifiedStage{}
+		}
+		existing.VerifiedIn[stage] = verified
+		changed = true
+	}
+	for stage, approved := range imported.ApprovedFor {
+		if _, ok := existing.ApprovedFor[stage]; ok {
+			continue
+		}
+		if existing.ApprovedFor == nil {
+			existing.ApprovedFor = map[string]kargoapi.ApprovedStage{}
+		}
+		existing.ApprovedFor[stage] = approved
+		delete(existing.PendingApprovalFor, stage)
+		changed = true
+	}
+	for stage, pending := range imported.PendingApprovalFor {
+		if _, ok := existing.ApprovedFor[stage]; ok {
+			continue
+		}
+		if _, ok := existing.PendingApprovalFor[stage]; ok {
+			continue
+		}
+		if existing.PendingApprovalFor == nil {
+			existing.PendingApprovalFor = map[string]kargoapi.PendingApproval{}
+		}
+		existing.PendingApprovalFor[stage] = pending
+		changed = true
+	}
+	return changed
+}
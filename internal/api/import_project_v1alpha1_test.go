@@ -0,0 +1,303 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const testProjectManifest = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Project
+metadata:
+  name: kargo-demo
+spec:
+  promotionPolicies:
+  - stage: test
+    autoPromotionEnabled: true
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: repo
+  namespace: kargo-demo
+  labels:
+    kargo.akuity.io/secret-type: repository
+data:
+  password: ""
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: images
+  namespace: kargo-demo
+spec:
+  subscriptions:
+  - image:
+      repoURL: nginx
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: uat
+  namespace: kargo-demo
+spec:
+  subscriptions:
+    upstreamStages:
+    - name: test
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+  namespace: kargo-demo
+spec:
+  subscriptions:
+    warehouse: images
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Freight
+metadata:
+  name: abc123
+  namespace: kargo-demo
+  ownerReferences:
+  - apiVersion: kargo.akuity.io/v1alpha1
+    kind: Warehouse
+    name: images
+    uid: ""
+id: abc123
+images:
+- repoURL: nginx
+  tag: 1.25.3
+status:
+  verifiedIn:
+    test: {}
+  approvedFor:
+    uat: {}
+`
+
+func TestParseProjectBundle(t *testing.T) {
+	testCases := []struct {
+		name       string
+		manifest   string
+		target     string
+		assertions func(*testing.T, *projectBundle, error)
+	}{
+		{
+			name:     "no project",
+			manifest: "apiVersion: v1\nkind: Secret\nmetadata:\n  name: repo\n",
+			assertions: func(t *testing.T, _ *projectBundle, err error) {
+				require.ErrorContains(t, err, "exactly one Project; found 0")
+			},
+		},
+		{
+			name: "resource from another namespace",
+			manifest: testProjectManifest + `---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: prod
+  namespace: elsewhere
+`,
+			assertions: func(t *testing.T, _ *projectBundle, err error) {
+				require.ErrorContains(t, err, `belongs to namespace "elsewhere"`)
+			},
+		},
+		{
+			name: "unsupported kind",
+			manifest: testProjectManifest + `---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Promotion
+metadata:
+  name: test.abc123
+  namespace: kargo-demo
+`,
+			assertions: func(t *testing.T, _ *projectBundle, err error) {
+				require.ErrorContains(t, err, `Promotion "test.abc123" cannot be imported`)
+			},
+		},
+		{
+			name:     "success",
+			manifest: testProjectManifest,
+			assertions: func(t *testing.T, bundle *projectBundle, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "kargo-demo", bundle.project.Name)
+				require.Len(t, bundle.secrets, 1)
+				require.Len(t, bundle.warehouses, 1)
+				require.Len(t, bundle.stages, 2)
+				// Upstream Stages come first
+				require.Equal(t, "test", bundle.stages[0].Name)
+				require.Equal(t, "uat", bundle.stages[1].Name)
+				require.Len(t, bundle.freight, 1)
+				require.Len(t, bundle.freight[0].OwnerReferences, 1)
+			},
+		},
+		{
+			name:     "success with rename",
+			manifest: testProjectManifest,
+			target:   "kargo-copy",
+			assertions: func(t *testing.T, bundle *projectBundle, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "kargo-copy", bundle.project.Name)
+				require.Equal(t, "kargo-copy", bundle.secrets[0].Namespace)
+				require.Equal(t, "kargo-copy", bundle.warehouses[0].Namespace)
+				for _, stage := range bundle.stages {
+					require.Equal(t, "kargo-copy", stage.Namespace)
+				}
+				require.Equal(t, "kargo-copy", bundle.freight[0].Namespace)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bundle, err := parseProjectBundle([]byte(testCase.manifest), testCase.target)
+			testCase.assertions(t, bundle, err)
+		})
+	}
+}
+
+func TestImportProject(t *testing.T) {
+	// Simulate an admin user to prevent any authz issues with the authorizing
+	// client.
+	ctx := user.ContextWithInfo(
+		context.Background(),
+		user.Info{
+			IsAdmin: true,
+		},
+	)
+	var internalClient client.Client
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				internalClient = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						// Normally created by the Project webhook
+						&corev1.Namespace{
+							ObjectMeta: metav1.ObjectMeta{Name: "kargo-copy"},
+						},
+						// An existing Secret must be left alone
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "kargo-copy",
+								Name:      "repo",
+							},
+							Data: map[string][]byte{"password": []byte("secret")},
+						},
+					).
+					WithInterceptorFuncs(interceptor.Funcs{
+						// The fake client does not assign UIDs the way the API server does
+						Create: func(
+							ctx context.Context,
+							c client.WithWatch,
+							obj client.Object,
+							opts ...client.CreateOption,
+						) error {
+							obj.SetUID(types.UID("uid-" + obj.GetName()))
+							return c.Create(ctx, obj, opts...)
+						},
+					}).
+					WithStatusSubresource(
+						&kargoapi.Project{},
+						&kargoapi.Warehouse{},
+						&kargoapi.Stage{},
+						&kargoapi.Freight{},
+					).
+					Build()
+				return internalClient, nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	svr := &server{client: kubeClient}
+
+	importProject := func() *svcv1alpha1.ImportProjectResponse {
+		res, err := svr.ImportProject(ctx, connect.NewRequest(&svcv1alpha1.ImportProjectRequest{
+			Manifest: []byte(testProjectManifest),
+			Project:  "kargo-copy",
+		}))
+		require.NoError(t, err)
+		return res.Msg
+	}
+	actions := func(res *svcv1alpha1.ImportProjectResponse) []string {
+		var actions []string
+		for _, r := range res.GetResources() {
+			actions = append(actions, r.GetKind()+" "+r.GetName()+" "+r.GetAction())
+		}
+		return actions
+	}
+
+	res := importProject()
+	require.Equal(t, "kargo-copy", res.GetProject())
+	require.Equal(
+		t,
+		[]string{
+			"Project kargo-copy Created",
+			"Secret repo Unchanged",
+			"Warehouse images Created",
+			"Stage test Created",
+			"Stage uat Created",
+			"Freight abc123 Created",
+		},
+		actions(res),
+	)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, internalClient.Get(
+		ctx,
+		types.NamespacedName{Namespace: "kargo-copy", Name: "repo"},
+		secret,
+	))
+	require.Equal(t, []byte("secret"), secret.Data["password"])
+
+	warehouse := &kargoapi.Warehouse{}
+	require.NoError(t, internalClient.Get(
+		ctx,
+		types.NamespacedName{Namespace: "kargo-copy", Name: "images"},
+		warehouse,
+	))
+	freight := &kargoapi.Freight{}
+	require.NoError(t, internalClient.Get(
+		ctx,
+		types.NamespacedName{Namespace: "kargo-copy", Name: "abc123"},
+		freight,
+	))
+	require.Equal(t, "images", freight.GetWarehouse())
+	require.Equal(t, types.UID("uid-images"), freight.OwnerReferences[0].UID)
+	require.Contains(t, freight.Status.VerifiedIn, "test")
+	require.Contains(t, freight.Status.ApprovedFor, "uat")
+
+	// Importing again changes nothing
+	require.Equal(
+		t,
+		[]string{
+			"Project kargo-copy Unchanged",
+			"Secret repo Unchanged",
+			"Warehouse images Unchanged",
+			"Stage test Unchanged",
+			"Stage uat Unchanged",
+			"Freight abc123 Unchanged",
+		},
+		actions(importProject()),
+	)
+}
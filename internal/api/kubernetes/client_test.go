@@ -167,6 +167,19 @@ func TestAllClientOperations(t *testing.T) {
 		return err
 	}
 
+	authorizeOp := func(client *client) error {
+		return client.Authorize(
+			context.Background(),
+			"create",
+			corev1.SchemeGroupVersion.WithResource("serviceaccounts"),
+			"token",
+			libClient.ObjectKey{
+				Namespace: "test-namespace",
+				Name:      "test-name",
+			},
+		)
+	}
+
 	testCases := []struct {
 		name       string
 		op         func(client *client) error
@@ -358,6 +371,24 @@ func TestAllClientOperations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			name: "authorize unauthorized",
+			op:   authorizeOp,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
+
+		{
+			name:    "authorize authorized",
+			op:      authorizeOp,
+			allowed: true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
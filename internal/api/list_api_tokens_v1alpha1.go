@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ListAPITokens lists the unexpired API tokens issued to robot accounts in a
+// Project, optionally limited to a single robot account. The tokens
+// themselves are never returned. The caller must be permitted to create tokens
+// for the robot account or, if none is specified, for every ServiceAccount in
+// the Project.
+func (s *server) ListAPITokens(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListAPITokensRequest],
+) (*connect.Response[svcv1alpha1.ListAPITokensResponse], error) {
+	if err := s.validateAPITokensEnabled(); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	saKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetServiceAccount(),
+	}
+	if err := s.authorizeAPITokens(ctx, saKey); err != nil {
+		return nil, err
+	}
+
+	var serviceAccounts []corev1.ServiceAccount
+	if saKey.Name != "" {
+		sa, err := s.getRobotAccount(ctx, saKey)
+		if err != nil {
+			return nil, err // This already returns a connect.Error
+		}
+		serviceAccounts = []corev1.ServiceAccount{*sa}
+	} else {
+		saList := &corev1.ServiceAccountList{}
+		if err := s.internalClient.List(
+			ctx,
+			saList,
+			client.InNamespace(req.Msg.GetProject()),
+		); err != nil {
+			return nil, errors.Wrap(err, "list service accounts")
+		}
+		serviceAccounts = saList.Items
+	}
+
+	now := time.Now()
+	var tokens []*svcv1alpha1.APIToken
+	for i := range serviceAccounts {
+		sa := &serviceAccounts[i]
+		records, err := apitoken.GetRecords(sa)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, record := range apitoken.RemoveExpired(records, now) {
+			tokens = append(
+				tokens,
+				apiTokenRecordToProto(client.ObjectKeyFromObject(sa), record),
+			)
+		}
+	}
+	sort.SliceStable(tokens, func(i, j int) bool {
+		if tokens[i].ServiceAccount != tokens[j].ServiceAccount {
+			return tokens[i].ServiceAccount < tokens[j].ServiceAccount
+		}
+		return tokens[i].CreateTime.AsTime().Before(tokens[j].CreateTime.AsTime())
+	})
+
+	return connect.NewResponse(&svcv1alpha1.ListAPITokensResponse{
+		ApiTokens: tokens,
+	}), nil
+}
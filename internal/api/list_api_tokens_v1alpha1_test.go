@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestListAPITokens(t *testing.T) {
+	testSets := map[string]struct {
+		cfg          *config.APITokenConfig
+		tokenCreator bool
+		req          *svcv1alpha1.ListAPITokensRequest
+		errExpected  bool
+		expectedCode connect.Code
+		expectedIDs  []string
+	}{
+		"API tokens not enabled": {
+			req: &svcv1alpha1.ListAPITokensRequest{
+				Project: "kargo-demo",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		"empty project": {
+			cfg:          &config.APITokenConfig{},
+			req:          &svcv1alpha1.ListAPITokensRequest{},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing ServiceAccount": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.ListAPITokensRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"success for Project": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.ListAPITokensRequest{
+				Project: "kargo-demo",
+			},
+			expectedIDs: []string{"token-1", "token-2"},
+		},
+		"success for ServiceAccount": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.ListAPITokensRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "other-robot",
+			},
+			expectedIDs: []string{"token-2"},
+		},
+		"success as non-admin permitted only to create tokens": {
+			cfg:          &config.APITokenConfig{},
+			tokenCreator: true,
+			req: &svcv1alpha1.ListAPITokensRequest{
+				Project: "kargo-demo",
+			},
+			expectedIDs: []string{"token-1", "token-2"},
+		},
+	}
+	for name, ts := range testSets {
+		ts := ts
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			newRobot := func(name string, records ...apitoken.Record) *corev1.ServiceAccount {
+				sa := &corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      name,
+					},
+				}
+				require.NoError(t, apitoken.SetRecords(sa, records))
+				return sa
+			}
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+					newRobot(
+						"robot",
+						apitoken.Record{
+							ID:        "token-1",
+							ExpiresAt: time.Now().Add(time.Hour),
+						},
+						apitoken.Record{
+							ID:        "expired",
+							ExpiresAt: time.Now().Add(-time.Hour),
+						},
+					),
+					newRobot(
+						"other-robot",
+						apitoken.Record{
+							ID:        "token-2",
+							ExpiresAt: time.Now().Add(time.Hour),
+						},
+					),
+				).
+				Build()
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:         client,
+				internalClient: internalClient,
+				cfg: config.ServerConfig{
+					APITokenConfig: ts.cfg,
+				},
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectFn = svr.validateProject
+			if ts.tokenCreator {
+				svr.client = newTokenCreatorClient(internalClient.Scheme())
+				ctx = user.ContextWithInfo(
+					context.Background(),
+					user.Info{
+						Subject: "fake-subject",
+					},
+				)
+				svr.validateProjectFn = func(context.Context, string) error {
+					return nil
+				}
+			}
+			res, err := svr.ListAPITokens(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, len(res.Msg.GetApiTokens()))
+			for i, token := range res.Msg.GetApiTokens() {
+				ids[i] = token.GetId()
+			}
+			require.ElementsMatch(t, ts.expectedIDs, ids)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
@@ -14,17 +15,38 @@ import (
 
 func (s *server) ListProjects(
 	ctx context.Context,
-	_ *connect.Request[svcv1alpha1.ListProjectsRequest],
+	req *connect.Request[svcv1alpha1.ListProjectsRequest],
 ) (*connect.Response[svcv1alpha1.ListProjectsResponse], error) {
+	selector, err := parseLabelSelector(req.Msg.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
 	projects := &kargoapi.ProjectList{}
-	if err := s.client.List(ctx, projects); err != nil {
+	if err = s.client.List(
+		ctx,
+		projects,
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
 		return nil, errors.Wrap(err, "error listing Projects")
 	}
-	projectProtos := make([]*v1alpha1.Project, len(projects.Items))
-	for i, project := range projects.Items {
+
+	items, nextPageToken, err := paginate(
+		projects.Items,
+		func(project kargoapi.Project) string { return project.Name },
+		req.Msg.GetPageSize(),
+		req.Msg.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	projectProtos := make([]*v1alpha1.Project, len(items))
+	for i, project := range items {
 		projectProtos[i] = typesv1alpha1.ToProjectProto(project)
 	}
 	return connect.NewResponse(&svcv1alpha1.ListProjectsResponse{
-		Projects: projectProtos,
+		Projects:      projectProtos,
+		NextPageToken: nextPageToken,
 	}), nil
 }
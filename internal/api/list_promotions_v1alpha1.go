@@ -26,23 +26,71 @@ func (s *server) ListPromotions(
 		return nil, err
 	}
 
+	selector, err := parseLabelSelector(req.Msg.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	phases := make(map[kargoapi.PromotionPhase]struct{}, len(req.Msg.GetPhases()))
+	for _, phase := range req.Msg.GetPhases() {
+		if err = validatePromotionPhase(phase); err != nil {
+			return nil, err
+		}
+		phases[kargoapi.PromotionPhase(phase)] = struct{}{}
+	}
+
 	var list kargoapi.PromotionList
 	opts := []client.ListOption{
 		client.InNamespace(req.Msg.GetProject()),
+		client.MatchingLabelsSelector{Selector: selector},
 	}
 	if req.Msg.GetStage() != "" {
 		opts = append(opts,
 			client.MatchingFields{kubeclient.PromotionsByStageIndexField: req.Msg.GetStage()},
 		)
 	}
-	if err := s.client.List(ctx, &list, opts...); err != nil {
+	if err = s.client.List(ctx, &list, opts...); err != nil {
 		return nil, errors.Wrap(err, "list promotions")
 	}
-	promotions := make([]*v1alpha1.Promotion, len(list.Items))
-	for idx, promotion := range list.Items {
+
+	filtered := list.Items
+	if len(phases) > 0 {
+		filtered = make([]kargoapi.Promotion, 0, len(list.Items))
+		for _, promotion := range list.Items {
+			if _, ok := phases[promotion.Status.Phase]; ok {
+				filtered = append(filtered, promotion)
+			}
+		}
+	}
+
+	items, nextPageToken, err := paginate(
+		filtered,
+		func(promotion kargoapi.Promotion) string { return promotion.Name },
+		req.Msg.GetPageSize(),
+		req.Msg.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions := make([]*v1alpha1.Promotion, len(items))
+	for idx, promotion := range items {
 		promotions[idx] = typesv1alpha1.ToPromotionProto(promotion)
 	}
 	return connect.NewResponse(&svcv1alpha1.ListPromotionsResponse{
-		Promotions: promotions,
+		Promotions:    promotions,
+		NextPageToken: nextPageToken,
 	}), nil
 }
+
+func validatePromotionPhase(phase string) error {
+	switch kargoapi.PromotionPhase(phase) {
+	case kargoapi.PromotionPhasePending, kargoapi.PromotionPhaseRunning,
+		kargoapi.PromotionPhaseSucceeded, kargoapi.PromotionPhaseFailed,
+		kargoapi.PromotionPhaseErrored, kargoapi.PromotionPhaseAborted:
+		return nil
+	}
+	return connect.NewError(
+		connect.CodeInvalidArgument,
+		errors.Errorf("unknown Promotion phase %q", phase),
+	)
+}
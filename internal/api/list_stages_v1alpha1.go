@@ -24,16 +24,37 @@ func (s *server) ListStages(
 		return nil, err
 	}
 
+	selector, err := parseLabelSelector(req.Msg.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
 	var list kargoapi.StageList
-	if err := s.client.List(ctx, &list, client.InNamespace(req.Msg.GetProject())); err != nil {
+	if err = s.client.List(
+		ctx,
+		&list,
+		client.InNamespace(req.Msg.GetProject()),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
 		return nil, errors.Wrap(err, "list stages")
 	}
 
-	stages := make([]*v1alpha1.Stage, len(list.Items))
-	for idx := range list.Items {
-		stages[idx] = typesv1alpha1.ToStageProto(list.Items[idx])
+	items, nextPageToken, err := paginate(
+		list.Items,
+		func(stage kargoapi.Stage) string { return stage.Name },
+		req.Msg.GetPageSize(),
+		req.Msg.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make([]*v1alpha1.Stage, len(items))
+	for idx := range items {
+		stages[idx] = typesv1alpha1.ToStageProto(items[idx])
 	}
 	return connect.NewResponse(&svcv1alpha1.ListStagesResponse{
-		Stages: stages,
+		Stages:        stages,
+		NextPageToken: nextPageToken,
 	}), nil
 }
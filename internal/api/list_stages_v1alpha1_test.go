@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,9 +22,11 @@ import (
 
 func TestListStages(t *testing.T) {
 	testSets := map[string]struct {
-		req          *svcv1alpha1.ListStagesRequest
-		errExpected  bool
-		expectedCode connect.Code
+		req                   *svcv1alpha1.ListStagesRequest
+		errExpected           bool
+		expectedCode          connect.Code
+		expectedStages        []string
+		expectedNextPageToken bool
 	}{
 		"empty project": {
 			req: &svcv1alpha1.ListStagesRequest{
@@ -36,6 +39,38 @@ func TestListStages(t *testing.T) {
 			req: &svcv1alpha1.ListStagesRequest{
 				Project: "kargo-demo",
 			},
+			expectedStages: []string{"test", "test-2"},
+		},
+		"label selector": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:       "kargo-demo",
+				LabelSelector: "tier=prod",
+			},
+			expectedStages: []string{"test-2"},
+		},
+		"invalid label selector": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:       "kargo-demo",
+				LabelSelector: "tier in (prod",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"first page": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:  "kargo-demo",
+				PageSize: 1,
+			},
+			expectedStages:        []string{"test"},
+			expectedNextPageToken: true,
+		},
+		"negative page size": {
+			req: &svcv1alpha1.ListStagesRequest{
+				Project:  "kargo-demo",
+				PageSize: -1,
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
 		},
 		"non-existing project": {
 			req: &svcv1alpha1.ListStagesRequest{
@@ -76,6 +111,20 @@ func TestListStages(t *testing.T) {
 							WithLists(&kargoapi.StageList{
 								Items: []kargoapi.Stage{
 									*mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+									{
+										ObjectMeta: metav1.ObjectMeta{
+											Namespace: "kargo-demo",
+											Name:      "test-2",
+											Labels: map[string]string{
+												"tier": "prod",
+											},
+										},
+										Spec: &kargoapi.StageSpec{
+											Subscriptions: &kargoapi.Subscriptions{
+												Warehouse: "test",
+											},
+										},
+									},
 								},
 							}).
 							Build(), nil
@@ -94,7 +143,17 @@ func TestListStages(t *testing.T) {
 				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
 				return
 			}
-			require.Len(t, res.Msg.GetStages(), 1)
+			require.NoError(t, err)
+			names := make([]string, len(res.Msg.GetStages()))
+			for i, stage := range res.Msg.GetStages() {
+				names[i] = stage.GetMetadata().GetName()
+			}
+			require.Equal(t, ts.expectedStages, names)
+			require.Equal(
+				t,
+				ts.expectedNextPageToken,
+				res.Msg.GetNextPageToken() != "",
+			)
 		})
 	}
 }
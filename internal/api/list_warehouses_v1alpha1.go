@@ -24,16 +24,37 @@ func (s *server) ListWarehouses(
 		return nil, err
 	}
 
+	selector, err := parseLabelSelector(req.Msg.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
 	var list kargoapi.WarehouseList
-	if err := s.client.List(ctx, &list, client.InNamespace(req.Msg.GetProject())); err != nil {
+	if err = s.client.List(
+		ctx,
+		&list,
+		client.InNamespace(req.Msg.GetProject()),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
 		return nil, errors.Wrap(err, "list warehouses")
 	}
 
-	warehouses := make([]*v1alpha1.Warehouse, len(list.Items))
-	for idx := range list.Items {
-		warehouses[idx] = typesv1alpha1.ToWarehouseProto(list.Items[idx])
+	items, nextPageToken, err := paginate(
+		list.Items,
+		func(warehouse kargoapi.Warehouse) string { return warehouse.Name },
+		req.Msg.GetPageSize(),
+		req.Msg.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouses := make([]*v1alpha1.Warehouse, len(items))
+	for idx := range items {
+		warehouses[idx] = typesv1alpha1.ToWarehouseProto(items[idx])
 	}
 	return connect.NewResponse(&svcv1alpha1.ListWarehousesResponse{
-		Warehouses: warehouses,
+		Warehouses:    warehouses,
+		NextPageToken: nextPageToken,
 	}), nil
 }
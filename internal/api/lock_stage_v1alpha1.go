@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) LockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.LockStageRequest],
+) (*connect.Response[svcv1alpha1.LockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+	if err := s.validateProject(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var until *metav1.Time
+	if req.Msg.GetUntil() != nil {
+		until = &metav1.Time{Time: req.Msg.GetUntil().AsTime()}
+		if !until.After(now) {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("until should be in the future"),
+			)
+		}
+	}
+
+	var stage kargoapi.Stage
+	key := client.ObjectKey{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	if err := s.client.Get(ctx, key, &stage); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound,
+				fmt.Errorf("stage %q not found", key.String()))
+		}
+		return nil, errors.Wrap(err, "get stage")
+	}
+
+	u, _ := user.InfoFromContext(ctx)
+	stage.Spec.Lock = &kargoapi.StageLock{
+		LockedBy: getUserIdentity(u),
+		LockedAt: &metav1.Time{Time: now},
+		Reason:   req.Msg.GetReason(),
+		Until:    until,
+	}
+	if err := s.client.Update(ctx, &stage); err != nil {
+		return nil, errors.Wrap(err, "update stage")
+	}
+	return connect.NewResponse(&svcv1alpha1.LockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(stage),
+	}), nil
+}
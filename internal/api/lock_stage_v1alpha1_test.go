@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestLockStage(t *testing.T) {
+	testSets := map[string]struct {
+		req          *svcv1alpha1.LockStageRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"empty project": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "",
+				Name:    "",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"empty name": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "kargo-demo",
+				Name:    "",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "kargo-x",
+				Name:    "test",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"non-existing Stage": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"until in the past": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "kargo-demo",
+				Name:    "test",
+				Until:   timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"existing Stage": {
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "kargo-demo",
+				Name:    "test",
+				Reason:  "incident",
+				Until:   timestamppb.New(time.Now().Add(time.Hour)),
+			},
+		},
+	}
+	for name, ts := range testSets {
+		ts := ts
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: client,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			res, err := svr.LockStage(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res.Msg.GetStage().GetSpec().GetLock())
+
+			stage, err := kargoapi.GetStage(ctx, client, types.NamespacedName{
+				Namespace: ts.req.GetProject(),
+				Name:      ts.req.GetName(),
+			})
+			require.NoError(t, err)
+			require.NotNil(t, stage.Spec.Lock)
+			require.Equal(t, "admin", stage.Spec.Lock.LockedBy)
+			require.Equal(t, ts.req.GetReason(), stage.Spec.Lock.Reason)
+			require.NotNil(t, stage.Spec.Lock.LockedAt)
+			require.NotNil(t, stage.Spec.Lock.Until)
+		})
+	}
+}
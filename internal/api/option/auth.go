@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -17,10 +18,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	libClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/apitoken"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/user"
 	"github.com/akuity/kargo/internal/kubeclient"
@@ -47,7 +50,11 @@ type authInterceptor struct {
 		claims jwt.Claims,
 	) (*jwt.Token, []string, error)
 	verifyKargoIssuedTokenFn func(rawToken string) bool
-	verifyIDPIssuedTokenFn   func(
+	verifyAPITokenFn         func(
+		ctx context.Context,
+		rawToken string,
+	) (apitoken.Claims, types.NamespacedName, error)
+	verifyIDPIssuedTokenFn func(
 		ctx context.Context,
 		rawToken string,
 	) (claims, bool)
@@ -82,6 +89,7 @@ func newAuthInterceptor(
 	a.parseUnverifiedJWTFn =
 		jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified
 	a.verifyKargoIssuedTokenFn = a.verifyKargoIssuedToken
+	a.verifyAPITokenFn = a.verifyAPIToken
 	a.verifyIDPIssuedTokenFn = a.verifyIDPIssuedToken
 	a.oidcExtractClaimsFn = oidcExtractClaims
 	a.listServiceAccountsFn = a.listServiceAccounts
@@ -363,7 +371,8 @@ func (a *authInterceptor) authenticate(
 
 	// If we get to here, we're dealing with a JWT. It could have been issued:
 	//
-	//   1. Directly by the Kargo API server (in the case of admin)
+	//   1. Directly by the Kargo API server (in the case of admin or of a robot
+	//      account)
 	//   2. By Kargo's OpenID Connect identity provider
 	//   3. By the Kubernetes cluster's identity provider
 	//   4. By Kubernetes itself (a service account token, perhaps)
@@ -382,6 +391,30 @@ func (a *authInterceptor) authenticate(
 		return ctx, errors.New("invalid token")
 	}
 
+	if a.cfg.APITokenConfig != nil &&
+		untrustedClaims.Issuer == a.cfg.APITokenConfig.TokenIssuer {
+		// Case 1 (continued): This token was allegedly issued directly by the Kargo
+		// API server to a robot account.
+		c, sa, err := a.verifyAPITokenFn(ctx, rawToken)
+		if err != nil {
+			return ctx, err
+		}
+		if method := path.Base(procedure); !c.Allows(method) {
+			return ctx, errors.Errorf("token is not permitted to invoke %s", method)
+		}
+		return user.ContextWithInfo(
+			ctx,
+			user.Info{
+				Subject: c.Subject,
+				ServiceAccountsByNamespace: map[string]map[types.NamespacedName]struct{}{
+					sa.Namespace: {
+						sa: {},
+					},
+				},
+			},
+		), nil
+	}
+
 	if a.cfg.OIDCConfig != nil &&
 		untrustedClaims.Issuer == a.cfg.OIDCConfig.IssuerURL {
 		// Case 2: This token was allegedly issued by Kargo's OpenID Connect
@@ -458,6 +491,57 @@ func (a *authInterceptor) verifyKargoIssuedToken(rawToken string) bool {
 	return err == nil
 }
 
+// verifyAPIToken attempts to verify that the provided raw token is an API token
+// issued directly by the Kargo API server to a robot account and that the token
+// has not been revoked. On success, the token's claims are returned along with
+// the ServiceAccount backing the robot account.
+func (a *authInterceptor) verifyAPIToken(
+	ctx context.Context,
+	rawToken string,
+) (apitoken.Claims, types.NamespacedName, error) {
+	c := apitoken.Claims{}
+	if a.cfg.APITokenConfig == nil {
+		return c, types.NamespacedName{}, errors.New("invalid token")
+	}
+	if _, err := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(a.cfg.APITokenConfig.TokenIssuer),
+		jwt.WithAudience(a.cfg.APITokenConfig.TokenAudience),
+		jwt.WithExpirationRequired(),
+	).ParseWithClaims(
+		rawToken,
+		&c,
+		func(*jwt.Token) (any, error) {
+			return a.cfg.APITokenConfig.TokenSigningKey, nil
+		},
+	); err != nil {
+		return c, types.NamespacedName{}, errors.New("invalid token")
+	}
+	saKey, err := apitoken.ServiceAccountFromSubject(c.Subject)
+	if err != nil {
+		return c, types.NamespacedName{}, errors.New("invalid token")
+	}
+	// A token remains valid only for as long as a record of it remains on the
+	// ServiceAccount it was issued to.
+	sa := &corev1.ServiceAccount{}
+	if err = a.internalClient.Get(ctx, saKey, sa); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return c, types.NamespacedName{}, errors.New("token has been revoked")
+		}
+		return c, types.NamespacedName{}, errors.Wrap(err, "get service account")
+	}
+	records, err := apitoken.GetRecords(sa)
+	if err != nil {
+		return c, types.NamespacedName{}, err
+	}
+	for _, record := range records {
+		if record.ID == c.ID {
+			return c, saKey, nil
+		}
+	}
+	return c, types.NamespacedName{}, errors.New("token has been revoked")
+}
+
 type claims struct {
 	Subject string   `json:"sub"`
 	Email   string   `json:"email"`
@@ -13,8 +13,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/akuity/kargo/internal/api/apitoken"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/dex"
 	libOIDC "github.com/akuity/kargo/internal/api/oidc"
@@ -52,6 +56,7 @@ func TestNewAuthInterceptor(t *testing.T) {
 	require.NotNil(t, a)
 	require.NotNil(t, a.parseUnverifiedJWTFn)
 	require.NotNil(t, a.verifyKargoIssuedTokenFn)
+	require.NotNil(t, a.verifyAPITokenFn)
 	require.NotNil(t, a.verifyIDPIssuedTokenFn)
 	require.NotNil(t, a.oidcExtractClaimsFn)
 	require.NotNil(t, a.listServiceAccountsFn)
@@ -160,8 +165,13 @@ func TestAuthenticate(t *testing.T) {
 		testProcedure   = "akuity.io.kargo.service.v1alpha1.KargoService/ListProjects"
 		testIDPIssuer   = "fake-idp-issuer"
 		testKargoIssuer = "fake-kargo-issuer"
+		testAPIIssuer   = "fake-api-token-issuer"
 		testToken       = "some-token"
 	)
+	testRobotAccount := types.NamespacedName{
+		Namespace: "fake-project",
+		Name:      "fake-robot",
+	}
 	testSets := map[string]struct {
 		procedure       string
 		authInterceptor *authInterceptor
@@ -271,6 +281,116 @@ func TestAuthenticate(t *testing.T) {
 				require.Empty(t, u.BearerToken)
 			},
 		},
+		"failure verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					APITokenConfig: &config.APITokenConfig{
+						TokenIssuer: testAPIIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*jwt.RegisteredClaims)
+					require.True(t, ok)
+					rc.Issuer = testAPIIssuer
+					return nil, nil, nil
+				},
+				verifyAPITokenFn: func(
+					context.Context,
+					string,
+				) (apitoken.Claims, types.NamespacedName, error) {
+					return apitoken.Claims{}, types.NamespacedName{},
+						errors.New("token has been revoked")
+				},
+			},
+			token: testToken,
+			assertions: func(ctx context.Context, err error) {
+				require.EqualError(t, err, "token has been revoked")
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		"API token not scoped for procedure": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					APITokenConfig: &config.APITokenConfig{
+						TokenIssuer: testAPIIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*jwt.RegisteredClaims)
+					require.True(t, ok)
+					rc.Issuer = testAPIIssuer
+					return nil, nil, nil
+				},
+				verifyAPITokenFn: func(
+					context.Context,
+					string,
+				) (apitoken.Claims, types.NamespacedName, error) {
+					return apitoken.Claims{
+						Scopes: []string{"PromoteStage"},
+					}, testRobotAccount, nil
+				},
+			},
+			token: testToken,
+			assertions: func(ctx context.Context, err error) {
+				require.EqualError(
+					t,
+					err,
+					"token is not permitted to invoke ListProjects",
+				)
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		"success verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					APITokenConfig: &config.APITokenConfig{
+						TokenIssuer: testAPIIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*jwt.RegisteredClaims)
+					require.True(t, ok)
+					rc.Issuer = testAPIIssuer
+					return nil, nil, nil
+				},
+				verifyAPITokenFn: func(
+					context.Context,
+					string,
+				) (apitoken.Claims, types.NamespacedName, error) {
+					return apitoken.Claims{
+						RegisteredClaims: jwt.RegisteredClaims{
+							Subject: apitoken.Subject(testRobotAccount),
+						},
+						Scopes: []string{"ListProjects"},
+					}, testRobotAccount, nil
+				},
+			},
+			token: testToken,
+			// If this is successful, we expect that user info for the robot account
+			// is bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.Equal(t, apitoken.Subject(testRobotAccount), u.Subject)
+				require.Equal(
+					t,
+					map[string]map[types.NamespacedName]struct{}{
+						testRobotAccount.Namespace: {
+							testRobotAccount: {},
+						},
+					},
+					u.ServiceAccountsByNamespace,
+				)
+				require.Empty(t, u.BearerToken)
+			},
+		},
 		"failure verifying IDP-issued token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
@@ -579,3 +699,140 @@ func TestVerifyKargoIssuedToken(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyAPIToken(t *testing.T) {
+	const testNonJWTToken = "some-token"
+	testCfg := &config.APITokenConfig{
+		TokenIssuer:     "fake-api-token-issuer",
+		TokenAudience:   "fake-audience",
+		TokenSigningKey: []byte("iwishtowashmyirishwristwatch"),
+	}
+	testRobotAccount := types.NamespacedName{
+		Namespace: "fake-project",
+		Name:      "fake-robot",
+	}
+	newToken := func(id string, key []byte, ttl time.Duration) string {
+		token, err := jwt.NewWithClaims(
+			jwt.SigningMethodHS256,
+			apitoken.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    testCfg.TokenIssuer,
+					Audience:  []string{testCfg.TokenAudience},
+					Subject:   apitoken.Subject(testRobotAccount),
+					ID:        id,
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+				},
+			},
+		).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+	testSA := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testRobotAccount.Namespace,
+			Name:      testRobotAccount.Name,
+		},
+	}
+	require.NoError(t, apitoken.SetRecords(testSA, []apitoken.Record{
+		{
+			ID:        "fake-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	}))
+	testCases := []struct {
+		name            string
+		token           string
+		authInterceptor *authInterceptor
+		assertions      func(apitoken.Claims, types.NamespacedName, error)
+	}{
+		{
+			name:            "API tokens not enabled",
+			token:           testNonJWTToken,
+			authInterceptor: &authInterceptor{},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "invalid token")
+			},
+		},
+		{
+			name:  "token is not a JWT",
+			token: testNonJWTToken,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+			},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "invalid token")
+			},
+		},
+		{
+			name:  "token was not signed by Kargo",
+			token: newToken("fake-id", []byte("wrong key"), time.Hour),
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+			},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "invalid token")
+			},
+		},
+		{
+			name:  "token is expired",
+			token: newToken("fake-id", testCfg.TokenSigningKey, -time.Hour),
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+			},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "invalid token")
+			},
+		},
+		{
+			name:  "ServiceAccount no longer exists",
+			token: newToken("fake-id", testCfg.TokenSigningKey, time.Hour),
+			authInterceptor: &authInterceptor{
+				cfg:            config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().Build(),
+			},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "token has been revoked")
+			},
+		},
+		{
+			name:  "token has been revoked",
+			token: newToken("revoked-id", testCfg.TokenSigningKey, time.Hour),
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().
+					WithObjects(testSA.DeepCopy()).Build(),
+			},
+			assertions: func(_ apitoken.Claims, _ types.NamespacedName, err error) {
+				require.EqualError(t, err, "token has been revoked")
+			},
+		},
+		{
+			name:  "success",
+			token: newToken("fake-id", testCfg.TokenSigningKey, time.Hour),
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().
+					WithObjects(testSA.DeepCopy()).Build(),
+			},
+			assertions: func(
+				c apitoken.Claims,
+				sa types.NamespacedName,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-id", c.ID)
+				require.Equal(t, testRobotAccount, sa)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.authInterceptor.verifyAPIToken(
+					context.Background(),
+					testCase.token,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// parseLabelSelector parses a label selector using the same syntax as
+// Kubernetes. An empty selector matches everything.
+func parseLabelSelector(selector string) (labels.Selector, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Wrapf(err, "error parsing label selector %q", selector),
+		)
+	}
+	return sel, nil
+}
+
+// validatePageSize returns an error if the specified page size is negative. A
+// page size of zero means results should not be paginated.
+func validatePageSize(pageSize int32) error {
+	if pageSize < 0 {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("page size should not be negative"),
+		)
+	}
+	return nil
+}
+
+// paginate sorts the specified items by the key returned by keyFn and returns
+// the page that follows the specified page token, along with the token for the
+// next page. The next page token is empty if there are no further pages. If
+// pageSize is zero, all items that follow the page token are returned.
+//
+// Results of List calls are served from the API server's informer cache, which
+// does not support Kubernetes continue tokens. Page tokens are instead opaque
+// cursors that encode the key of the last item of the previous page. This keeps
+// pagination stable when items are added or removed between calls.
+func paginate[T any](
+	items []T,
+	keyFn func(T) string,
+	pageSize int32,
+	pageToken string,
+) ([]T, string, error) {
+	if err := validatePageSize(pageSize); err != nil {
+		return nil, "", err
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return keyFn(items[i]) < keyFn(items[j])
+	})
+	if pageToken != "" {
+		lastKey, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || len(lastKey) == 0 {
+			return nil, "", connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("invalid page token %q", pageToken),
+			)
+		}
+		start := sort.Search(len(items), func(i int) bool {
+			return keyFn(items[i]) > string(lastKey)
+		})
+		items = items[start:]
+	}
+	if pageSize == 0 || len(items) <= int(pageSize) {
+		return items, "", nil
+	}
+	items = items[:pageSize]
+	return items,
+		base64.RawURLEncoding.EncodeToString([]byte(keyFn(items[len(items)-1]))),
+		nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	sel, err := parseLabelSelector("")
+	require.NoError(t, err)
+	require.True(t, sel.Empty())
+
+	sel, err = parseLabelSelector("tier=frontend,env!=prod")
+	require.NoError(t, err)
+	require.Equal(t, "env!=prod,tier=frontend", sel.String())
+
+	_, err = parseLabelSelector("tier in (frontend")
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestPaginate(t *testing.T) {
+	identity := func(s string) string { return s }
+	testCases := []struct {
+		name       string
+		items      []string
+		pageSize   int32
+		pageToken  string
+		assertions func([]string, string, error)
+	}{
+		{
+			name:     "negative page size",
+			pageSize: -1,
+			assertions: func(_ []string, _ string, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:      "invalid page token",
+			pageToken: "!!!",
+			assertions: func(_ []string, _ string, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:  "no page size",
+			items: []string{"c", "a", "b"},
+			assertions: func(items []string, next string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "b", "c"}, items)
+				require.Empty(t, next)
+			},
+		},
+		{
+			name:     "first page",
+			items:    []string{"c", "a", "b"},
+			pageSize: 2,
+			assertions: func(items []string, next string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "b"}, items)
+				require.NotEmpty(t, next)
+			},
+		},
+		{
+			name:      "last page",
+			items:     []string{"c", "a", "b"},
+			pageSize:  2,
+			pageToken: "Yg", // "b"
+			assertions: func(items []string, next string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"c"}, items)
+				require.Empty(t, next)
+			},
+		},
+		{
+			name:      "item after page token was removed",
+			items:     []string{"d", "a"},
+			pageSize:  2,
+			pageToken: "Yg", // "b"
+			assertions: func(items []string, next string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"d"}, items)
+				require.Empty(t, next)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				paginate(
+					testCase.items,
+					identity,
+					testCase.pageSize,
+					testCase.pageToken,
+				),
+			)
+		})
+	}
+}
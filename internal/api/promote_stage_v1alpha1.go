@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -45,6 +47,15 @@ func (s *server) PromoteStage(
 			),
 		)
 	}
+	if stage.Spec.Lock.IsActive(time.Now()) && !req.Msg.GetForce() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Stage %q is locked; promotion must be forced",
+				req.Msg.GetName(),
+			),
+		)
+	}
 
 	freight, err := s.getFreightFn(
 		ctx,
@@ -67,11 +78,12 @@ func (s *server) PromoteStage(
 			),
 		)
 	}
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	if !s.isFreightAvailableFn(freight, stage.Name, upstreamStages) {
+	if !s.isFreightAvailableFn(
+		freight,
+		stage.Name,
+		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
+	) {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
 			errors.Errorf(
@@ -83,6 +95,11 @@ func (s *server) PromoteStage(
 	}
 
 	promotion := kargo.NewPromotion(*stage, req.Msg.GetFreight())
+	if req.Msg.GetForce() {
+		promotion.Annotations = map[string]string{
+			kargoapi.AnnotationKeyForce: "true",
+		}
+	}
 	if err := s.createPromotionFn(ctx, &promotion); err != nil {
 		return nil, errors.Wrap(err, "create promotion")
 	}
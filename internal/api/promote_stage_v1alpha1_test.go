@@ -113,6 +113,42 @@ func TestPromoteStage(t *testing.T) {
 				require.Contains(t, connErr.Message(), "not found in namespace")
 			},
 		},
+		{
+			name: "Stage is locked",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Lock: &kargoapi.StageLock{
+								Reason: "incident",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "is locked")
+			},
+		},
 		{
 			name: "error getting Freight",
 			req: &svcv1alpha1.PromoteStageRequest{
@@ -240,7 +276,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return false
 				},
 			},
@@ -291,7 +332,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				createPromotionFn: func(
@@ -345,7 +391,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				createPromotionFn: func(
@@ -4,6 +4,7 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -19,7 +20,9 @@ import (
 
 // PromoteSubscribers creates a Promotion resources to transition all Stages
 // immediately downstream from the specified Stage into the state represented by
-// the specified Freight.
+// the specified Freight. Subscribers that are locked, or to which the Freight
+// is not available, are skipped. Locked subscribers are reported using
+// FailedPrecondition.
 func (s *server) PromoteSubscribers(
 	ctx context.Context,
 	req *connect.Request[svcv1alpha1.PromoteSubscribersRequest],
@@ -85,8 +88,9 @@ func (s *server) PromoteSubscribers(
 	}
 	if !s.isFreightAvailableFn(
 		freight,
-		"",                           // approved for not considered
-		[]string{req.Msg.GetStage()}, // verified in
+		"", // approved for not considered
+		[]kargoapi.StageSubscription{{Name: req.Msg.GetStage()}}, // verified in
+		kargoapi.UpstreamStagesModeAny,
 	) {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
@@ -106,9 +110,32 @@ func (s *server) PromoteSubscribers(
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("stage %q has no subscribers", req.Msg.GetStage()))
 	}
 
+	now := time.Now()
+	lockedErrs := make([]error, 0, len(subscribers))
 	promoteErrs := make([]error, 0, len(subscribers))
 	createdPromos := make([]*v1alpha1.Promotion, 0, len(subscribers))
 	for _, subscriber := range subscribers {
+		// Promotions to a locked Stage would be rejected, so locked subscribers
+		// are skipped and reported.
+		if subscriber.Spec.Lock.IsActive(now) {
+			lockedErrs = append(
+				lockedErrs,
+				errors.Errorf("Stage %q is locked", subscriber.Name),
+			)
+			continue
+		}
+		// Freight verified in this Stage may still not be available to a
+		// subscriber that has requirements of its own, e.g. other upstream Stages
+		// it must also have been verified in or a minimum amount of time it must
+		// have been verified in this Stage for. Such subscribers are skipped.
+		if !s.isFreightAvailableFn(
+			freight,
+			subscriber.Name,
+			subscriber.Spec.Subscriptions.UpstreamStages,
+			subscriber.Spec.Subscriptions.UpstreamStagesMode,
+		) {
+			continue
+		}
 		newPromo := kargo.NewPromotion(subscriber, req.Msg.GetFreight())
 		if err := s.createPromotionFn(ctx, &newPromo); err != nil {
 			promoteErrs = append(promoteErrs, err)
@@ -117,6 +144,17 @@ func (s *server) PromoteSubscribers(
 		createdPromos = append(createdPromos, typesv1alpha1.ToPromotionProto(newPromo))
 	}
 
+	if len(createdPromos) == 0 && len(promoteErrs) == 0 && len(lockedErrs) == 0 {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Freight %q is not available to any subscriber of Stage %q",
+				req.Msg.GetFreight(),
+				req.Msg.GetStage(),
+			),
+		)
+	}
+
 	res := connect.NewResponse(&svcv1alpha1.PromoteSubscribersResponse{
 		Promotions: createdPromos,
 	})
@@ -125,6 +163,10 @@ func (s *server) PromoteSubscribers(
 		return res,
 			connect.NewError(connect.CodeInternal, goerrors.Join(promoteErrs...))
 	}
+	if len(lockedErrs) > 0 {
+		return res,
+			connect.NewError(connect.CodeFailedPrecondition, goerrors.Join(lockedErrs...))
+	}
 
 	return res, nil
 }
@@ -3,10 +3,12 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -242,7 +244,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return false
 				},
 			},
@@ -293,7 +300,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
@@ -346,7 +358,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
@@ -403,14 +420,34 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
 				) ([]kargoapi.Stage, error) {
-					return []kargoapi.Stage{{}}, nil
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+										},
+									},
+								},
+							},
+						},
+					}, nil
 				},
 				createPromotionFn: func(
 					context.Context,
@@ -431,6 +468,254 @@ func TestPromoteSubscribers(t *testing.T) {
 				require.Contains(t, connErr.Message(), "something went wrong")
 			},
 		},
+		{
+			name: "Freight not available to any subscriber",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(
+					_ *kargoapi.Freight,
+					stage string,
+					_ []kargoapi.StageSubscription,
+					_ kargoapi.UpstreamStagesMode,
+				) bool {
+					// Verified in the Stage, but not available to its subscriber
+					return stage != "fake-subscriber"
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+										},
+										{
+											Name: "fake-other-stage",
+										},
+									},
+									UpstreamStagesMode: kargoapi.UpstreamStagesModeAll,
+								},
+							},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.Fail(t, "no Promotion should have been created")
+					return nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "not available to any subscriber")
+			},
+		},
+		{
+			name: "Freight has not soaked for long enough",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {
+									VerifiedAt: &metav1.Time{
+										Time: time.Now().Add(-10 * time.Minute),
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				isFreightAvailableFn: kargoapi.IsFreightAvailable,
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+											MinSoakDuration: &metav1.Duration{
+												Duration: time.Hour,
+											},
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.Fail(t, "no Promotion should have been created")
+					return nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "subscriber is locked",
+			req: &svcv1alpha1.PromoteSubscribersRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
+					return true
+				},
+				findStageSubscribersFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Stage, error) {
+					subs := &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{
+								Name: "fake-stage",
+							},
+						},
+					}
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-locked-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: subs,
+								Lock: &kargoapi.StageLock{
+									Reason: "incident",
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: subs,
+							},
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.Equal(t, "fake-subscriber", promo.Spec.Stage)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteSubscribersResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), `Stage "fake-locked-subscriber" is locked`)
+				require.NotNil(t, res)
+				require.Len(t, res.Msg.GetPromotions(), 1)
+			},
+		},
 		{
 			name: "success",
 			req: &svcv1alpha1.PromoteSubscribersRequest{
@@ -466,14 +751,34 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
 					context.Context,
 					*kargoapi.Stage,
 				) ([]kargoapi.Stage, error) {
-					return []kargoapi.Stage{{}}, nil
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-subscriber",
+							},
+							Spec: &kargoapi.StageSpec{
+								Subscriptions: &kargoapi.Subscriptions{
+									UpstreamStages: []kargoapi.StageSubscription{
+										{
+											Name: "fake-stage",
+										},
+									},
+								},
+							},
+						},
+					}, nil
 				},
 				createPromotionFn: func(
 					context.Context,
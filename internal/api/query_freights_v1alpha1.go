@@ -4,11 +4,13 @@ import (
 	"context"
 	"path"
 	"sort"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -45,6 +47,10 @@ func (s *server) QueryFreight(
 	if err := validateGroupByOrderBy(req.Msg.GetGroup(), req.Msg.GetGroupBy(), req.Msg.GetOrderBy()); err != nil {
 		return nil, err // This already returns a connect.Error
 	}
+	selector, err := parseLabelSelector(req.Msg.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
 
 	var freight []kargoapi.Freight
 	if req.Msg.GetStage() != "" {
@@ -90,6 +96,16 @@ func (s *server) QueryFreight(
 		freight = freightList.Items
 	}
 
+	if !selector.Empty() {
+		matching := make([]kargoapi.Freight, 0, len(freight))
+		for _, f := range freight {
+			if selector.Matches(labels.Set(f.Labels)) {
+				matching = append(matching, f)
+			}
+		}
+		freight = matching
+	}
+
 	// Split the Freight into groups
 	var freightGroups map[string]*svcv1alpha1.FreightList
 	switch req.Msg.GetGroupBy() {
@@ -113,8 +129,9 @@ func (s *server) QueryFreight(
 // getAvailableFreightForStage gets all Freight available to the specified Stage
 // for any reason. This includes:
 //
-// 1. Any Freight from a Warehouse that the Stage subscribes to directly
-// 2. Any Freight that is verified in any upstream Stages
+// 1. Any Freight from any Warehouse that the Stage subscribes to directly
+// 2. Any Freight that is verified in any upstream Stages (or in all upstream
+// Stages, if the Stage's subscriptions require it)
 // 3. Any Freight that is approved for the Stage
 func (s *server) getAvailableFreightForStage(
 	ctx context.Context,
@@ -122,13 +139,23 @@ func (s *server) getAvailableFreightForStage(
 	stage string,
 	subs kargoapi.Subscriptions,
 ) ([]kargoapi.Freight, error) {
-	if subs.Warehouse != "" {
-		return s.getFreightFromWarehouseFn(ctx, project, subs.Warehouse)
+	if warehouses := subs.GetWarehouses(); len(warehouses) > 0 {
+		var freight []kargoapi.Freight
+		for _, warehouse := range warehouses {
+			warehouseFreight, err :=
+				s.getFreightFromWarehouseFn(ctx, project, warehouse)
+			if err != nil {
+				return nil, err
+			}
+			freight = append(freight, warehouseFreight...)
+		}
+		return freight, nil
 	}
 	verifiedFreight, err := s.getVerifiedFreightFn(
 		ctx,
 		project,
 		subs.UpstreamStages,
+		subs.UpstreamStagesMode,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(
@@ -212,10 +239,12 @@ func (s *server) getVerifiedFreight(
 	ctx context.Context,
 	project string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) ([]kargoapi.Freight, error) {
 	// Start by building a de-duped map of Freight verified in any upstream
 	// Stage(s)
 	verifiedFreight := map[string]kargoapi.Freight{}
+	now := time.Now()
 	for _, stageSub := range stageSubs {
 		var freight kargoapi.FreightList
 		if err := s.listFreightFn(
@@ -237,6 +266,17 @@ func (s *server) getVerifiedFreight(
 			)
 		}
 		for _, freight := range freight.Items {
+			if mode == kargoapi.UpstreamStagesModeAll {
+				// The Stage requires Freight to have been verified (and soaked) in
+				// all upstream Stages. Exclude any that has not been.
+				if !kargoapi.IsFreightVerifiedUpstream(&freight, stageSubs, mode, now) {
+					continue
+				}
+			} else if kargoapi.GetFreightSoakTimeRemaining(&freight, stageSub, now) > 0 {
+				// Exclude Freight that has not yet soaked in this upstream Stage for
+				// long enough.
+				continue
+			}
 			verifiedFreight[freight.Name] = freight
 		}
 	}
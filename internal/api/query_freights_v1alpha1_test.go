@@ -416,6 +416,33 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "success getting Freight from multiple Warehouses",
+			subs: kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			server: &server{
+				getFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) ([]kargoapi.Freight, error) {
+					return []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: warehouse + "-freight",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 2)
+				require.Equal(t, "fake-warehouse-freight", freight[0].Name)
+				require.Equal(t, "another-fake-warehouse-freight", freight[1].Name)
+			},
+		},
 		{
 			name: "error getting Freight verified in upstream Stages",
 			subs: kargoapi.Subscriptions{
@@ -430,6 +457,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -458,6 +486,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -493,6 +522,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return []kargoapi.Freight{
 						{
@@ -610,6 +640,7 @@ func TestGetVerifiedFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
 		server     *server
+		mode       kargoapi.UpstreamStagesMode
 		assertions func([]kargoapi.Freight, error)
 	}{
 		{
@@ -664,6 +695,49 @@ func TestGetVerifiedFreight(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "success with all upstream Stages required",
+			server: &server{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage":         {},
+									"another-fake-stage": {},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "another fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			mode: kargoapi.UpstreamStagesModeAll,
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -679,6 +753,7 @@ func TestGetVerifiedFreight(t *testing.T) {
 							Name: "another-fake-stage",
 						},
 					},
+					testCase.mode,
 				),
 			)
 		})
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RevokeAPIToken revokes an API token issued to a robot account by removing
+// the record of it from the robot account's ServiceAccount. Requests bearing a
+// token that has been revoked are no longer authenticated. The caller must be
+// permitted to create tokens for the ServiceAccount.
+func (s *server) RevokeAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RevokeAPITokenRequest],
+) (*connect.Response[svcv1alpha1.RevokeAPITokenResponse], error) {
+	if err := s.validateAPITokensEnabled(); err != nil {
+		return nil, err
+	}
+
+	if err := validateProjectAndServiceAccountNonEmpty(
+		req.Msg.GetProject(),
+		req.Msg.GetServiceAccount(),
+	); err != nil {
+		return nil, err
+	}
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("id should not be empty"),
+		)
+	}
+
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	saKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetServiceAccount(),
+	}
+	if err := s.authorizeAPITokens(ctx, saKey); err != nil {
+		return nil, err
+	}
+	sa, err := s.getRobotAccount(ctx, saKey)
+	if err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	records, err := apitoken.GetRecords(sa)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	remaining := make([]apitoken.Record, 0, len(records))
+	for _, record := range records {
+		if record.ID != req.Msg.GetId() {
+			remaining = append(remaining, record)
+		}
+	}
+	if len(remaining) == len(records) {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"API token %q not found for ServiceAccount %q in namespace %q",
+				req.Msg.GetId(),
+				saKey.Name,
+				saKey.Namespace,
+			),
+		)
+	}
+	if err = apitoken.SetRecords(sa, remaining); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err = s.internalClient.Update(ctx, sa); err != nil {
+		return nil, updateRobotAccountError(err, saKey)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.RevokeAPITokenResponse{}), nil
+}
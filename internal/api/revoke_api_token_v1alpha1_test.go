@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRevokeAPIToken(t *testing.T) {
+	testSets := map[string]struct {
+		cfg          *config.APITokenConfig
+		tokenCreator bool
+		req          *svcv1alpha1.RevokeAPITokenRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"API tokens not enabled": {
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Id:             "token-1",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		"empty project": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				ServiceAccount: "robot",
+				Id:             "token-1",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"empty id": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing ServiceAccount": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "non-existing",
+				Id:             "token-1",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"non-existing token": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Id:             "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"success as non-admin permitted only to create tokens": {
+			cfg:          &config.APITokenConfig{},
+			tokenCreator: true,
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Id:             "token-1",
+			},
+		},
+		"success": {
+			cfg: &config.APITokenConfig{},
+			req: &svcv1alpha1.RevokeAPITokenRequest{
+				Project:        "kargo-demo",
+				ServiceAccount: "robot",
+				Id:             "token-1",
+			},
+		},
+	}
+	for name, ts := range testSets {
+		ts := ts
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Simulate an admin user to prevent any authz issues with the authorizing
+			// client.
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					IsAdmin: true,
+				},
+			)
+			sa := &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kargo-demo",
+					Name:      "robot",
+				},
+			}
+			require.NoError(t, apitoken.SetRecords(sa, []apitoken.Record{
+				{
+					ID:        "token-1",
+					ExpiresAt: time.Now().Add(time.Hour),
+				},
+				{
+					ID:        "token-2",
+					ExpiresAt: time.Now().Add(time.Hour),
+				},
+			}))
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(
+					mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+					sa,
+				).
+				Build()
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:         client,
+				internalClient: internalClient,
+				cfg: config.ServerConfig{
+					APITokenConfig: ts.cfg,
+				},
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectFn = svr.validateProject
+			if ts.tokenCreator {
+				svr.client = newTokenCreatorClient(internalClient.Scheme())
+				ctx = user.ContextWithInfo(
+					context.Background(),
+					user.Info{
+						Subject: "fake-subject",
+					},
+				)
+				svr.validateProjectFn = func(context.Context, string) error {
+					return nil
+				}
+			}
+			_, err = svr.RevokeAPIToken(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+
+			sa = &corev1.ServiceAccount{}
+			require.NoError(t, internalClient.Get(
+				ctx,
+				types.NamespacedName{
+					Namespace: ts.req.GetProject(),
+					Name:      ts.req.GetServiceAccount(),
+				},
+				sa,
+			))
+			records, err := apitoken.GetRecords(sa)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			require.Equal(t, "token-2", records[0].ID)
+		})
+	}
+}
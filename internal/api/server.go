@@ -22,6 +22,8 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
 	httputil "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
@@ -35,6 +37,7 @@ type server struct {
 	cfg            config.ServerConfig
 	client         kubernetes.Client
 	internalClient client.Client
+	credentialsDB  credentials.Database
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -64,7 +67,8 @@ type server struct {
 	isFreightAvailableFn func(
 		freight *kargoapi.Freight,
 		stage string,
-		upstreamStages []string,
+		upstreamStages []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) bool
 
 	// Common Promotions:
@@ -98,6 +102,7 @@ type server struct {
 		ctx context.Context,
 		project string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) ([]kargoapi.Freight, error)
 
 	// Freight aliasing:
@@ -106,6 +111,15 @@ type server struct {
 		freight *kargoapi.Freight,
 		alias string,
 	) error
+
+	// DiffFreight API:
+	getCommitLogFn func(
+		ctx context.Context,
+		project string,
+		repoURL string,
+		fromID string,
+		toID string,
+	) ([]git.CommitLogEntry, error)
 }
 
 type Server interface {
@@ -116,11 +130,13 @@ func NewServer(
 	cfg config.ServerConfig,
 	kubeClient kubernetes.Client,
 	internalClient client.Client,
+	credentialsDB credentials.Database,
 ) Server {
 	s := &server{
 		cfg:            cfg,
 		client:         kubeClient,
 		internalClient: internalClient,
+		credentialsDB:  credentialsDB,
 	}
 	s.validateProjectFn = s.validateProject
 	s.externalValidateProjectFn = validation.ValidateProject
@@ -135,6 +151,7 @@ func NewServer(
 	s.getVerifiedFreightFn =
 		s.getVerifiedFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
+	s.getCommitLogFn = s.getCommitLog
 	return s
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/credentials"
 )
 
 func TestNewServer(t *testing.T) {
@@ -30,7 +31,12 @@ func TestNewServer(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
-	s, ok := NewServer(testServerConfig, testClient, fake.NewClientBuilder().Build()).(*server)
+	s, ok := NewServer(
+		testServerConfig,
+		testClient,
+		fake.NewClientBuilder().Build(),
+		&credentials.FakeDB{},
+	).(*server)
 	require.True(t, ok)
 	require.NotNil(t, s)
 	require.Same(t, testClient, s.client)
@@ -46,4 +52,5 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getAvailableFreightForStageFn)
 	require.NotNil(t, s.getFreightFromWarehouseFn)
 	require.NotNil(t, s.getVerifiedFreightFn)
+	require.NotNil(t, s.getCommitLogFn)
 }
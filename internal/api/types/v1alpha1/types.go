@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -74,9 +75,120 @@ func FromStageSpecProto(s *v1alpha1.StageSpec) *kargoapi.StageSpec {
 		Subscriptions:       FromSubscriptionsProto(s.GetSubscriptions()),
 		PromotionMechanisms: FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
 		Verification:        FromVerificationProto(s.GetVerification()),
+		PromotionPolicy:     FromStagePromotionPolicyProto(s.GetPromotionPolicy()),
+		ApprovalPolicy:      FromApprovalPolicyProto(s.GetApprovalPolicy()),
+		Lock:                FromStageLockProto(s.GetLock()),
+		HealthChecks:        FromHealthChecksProto(s.GetHealthChecks()),
+		Hooks:               FromPromotionHooksProto(s.GetHooks()),
 	}
 }
 
+func FromPromotionHooksProto(h *v1alpha1.PromotionHooks) *kargoapi.PromotionHooks {
+	if h == nil {
+		return nil
+	}
+	return &kargoapi.PromotionHooks{
+		PrePromotion:  fromPromotionHookProtos(h.GetPrePromotion()),
+		PostPromotion: fromPromotionHookProtos(h.GetPostPromotion()),
+	}
+}
+
+func fromPromotionHookProtos(hooks []*v1alpha1.PromotionHook) []kargoapi.PromotionHook {
+	if len(hooks) == 0 {
+		return nil
+	}
+	res := make([]kargoapi.PromotionHook, len(hooks))
+	for i, h := range hooks {
+		res[i] = kargoapi.PromotionHook{
+			Name: h.GetName(),
+		}
+		// The Job template is carried as JSON. Anything that cannot be
+		// unmarshaled is left as an empty template.
+		_ = json.Unmarshal(h.GetJobTemplate(), &res[i].JobTemplate)
+	}
+	return res
+}
+
+func FromHealthChecksProto(h *v1alpha1.HealthChecks) *kargoapi.HealthChecks {
+	if h == nil {
+		return nil
+	}
+	workloads := make([]kargoapi.WorkloadHealthCheck, len(h.GetWorkloads()))
+	for i, w := range h.GetWorkloads() {
+		workloads[i] = kargoapi.WorkloadHealthCheck{
+			Kind:      kargoapi.WorkloadKind(w.GetKind()),
+			Namespace: w.GetNamespace(),
+			Name:      w.GetName(),
+			Selector:  w.GetSelector(),
+		}
+	}
+	return &kargoapi.HealthChecks{
+		Workloads: workloads,
+	}
+}
+
+func FromStageLockProto(l *v1alpha1.StageLock) *kargoapi.StageLock {
+	if l == nil {
+		return nil
+	}
+	var lockedAt *kubemetav1.Time
+	if l.GetLockedAt() != nil {
+		lockedAt = &kubemetav1.Time{Time: l.GetLockedAt().AsTime()}
+	}
+	var until *kubemetav1.Time
+	if l.GetUntil() != nil {
+		until = &kubemetav1.Time{Time: l.GetUntil().AsTime()}
+	}
+	return &kargoapi.StageLock{
+		LockedBy: l.GetLockedBy(),
+		LockedAt: lockedAt,
+		Reason:   l.GetReason(),
+		Until:    until,
+	}
+}
+
+func FromApprovalPolicyProto(p *v1alpha1.ApprovalPolicy) *kargoapi.ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.ApprovalPolicy{
+		RequiredApprovals: p.GetRequiredApprovals(),
+		AllowedGroups:     p.GetAllowedGroups(),
+		AllowedEmails:     p.GetAllowedEmails(),
+	}
+}
+
+func FromStagePromotionPolicyProto(p *v1alpha1.StagePromotionPolicy) *kargoapi.StagePromotionPolicy {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.StagePromotionPolicy{
+		Timeout: fromDurationProto(p.Timeout),
+		Retry:   FromPromotionRetryPolicyProto(p.GetRetry()),
+	}
+}
+
+func FromPromotionRetryPolicyProto(p *v1alpha1.PromotionRetryPolicy) *kargoapi.PromotionRetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.PromotionRetryPolicy{
+		Limit:   p.GetLimit(),
+		Backoff: fromDurationProto(p.Backoff),
+	}
+}
+
+func fromDurationProto(d *string) *kubemetav1.Duration {
+	if d == nil {
+		return nil
+	}
+	duration, err := time.ParseDuration(*d)
+	if err != nil {
+		return nil
+	}
+	return &kubemetav1.Duration{Duration: duration}
+}
+
 func FromStageStatusProto(s *v1alpha1.StageStatus) *kargoapi.StageStatus {
 	if s == nil {
 		return nil
@@ -116,13 +228,23 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 	}
 	verifiedIn :=
 		make(map[string]kargoapi.VerifiedStage, len(f.Status.VerifiedIn))
-	for stage := range f.Status.VerifiedIn {
-		verifiedIn[stage] = kargoapi.VerifiedStage{}
+	for stage, verified := range f.Status.VerifiedIn {
+		verifiedIn[stage] = FromVerifiedStageProto(verified)
 	}
 	approvedFor :=
 		make(map[string]kargoapi.ApprovedStage, len(f.Status.ApprovedFor))
-	for stage := range f.Status.ApprovedFor {
-		approvedFor[stage] = kargoapi.ApprovedStage{}
+	for stage, approved := range f.Status.ApprovedFor {
+		approvedFor[stage] = FromApprovedStageProto(approved)
+	}
+	var pendingApprovalFor map[string]kargoapi.PendingApproval
+	if len(f.Status.PendingApprovalFor) > 0 {
+		pendingApprovalFor =
+			make(map[string]kargoapi.PendingApproval, len(f.Status.PendingApprovalFor))
+		for stage, pending := range f.Status.PendingApprovalFor {
+			pendingApprovalFor[stage] = kargoapi.PendingApproval{
+				Approvals: FromApprovalsProto(pending.GetApprovals()),
+			}
+		}
 	}
 	return &kargoapi.Freight{
 		TypeMeta: kubemetav1.TypeMeta{
@@ -135,8 +257,9 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 		Images:     images,
 		Charts:     charts,
 		Status: kargoapi.FreightStatus{
-			VerifiedIn:  verifiedIn,
-			ApprovedFor: approvedFor,
+			VerifiedIn:         verifiedIn,
+			ApprovedFor:        approvedFor,
+			PendingApprovalFor: pendingApprovalFor,
 		},
 	}
 }
@@ -157,12 +280,30 @@ func FromFreightReferenceProto(s *v1alpha1.FreightReference) *kargoapi.FreightRe
 	for idx, chart := range s.GetCharts() {
 		charts[idx] = *FromChartProto(chart)
 	}
+	var constituents []kargoapi.FreightConstituent
+	if len(s.GetConstituents()) > 0 {
+		constituents = make([]kargoapi.FreightConstituent, len(s.GetConstituents()))
+		for idx, constituent := range s.GetConstituents() {
+			constituents[idx] = *FromFreightConstituentProto(constituent)
+		}
+	}
 	return &kargoapi.FreightReference{
 		ID:               s.GetId(),
 		Commits:          commits,
 		Images:           images,
 		Charts:           charts,
 		VerificationInfo: FromVerificationInfoProto(s.VerificationInfo),
+		Constituents:     constituents,
+	}
+}
+
+func FromFreightConstituentProto(c *v1alpha1.FreightConstituent) *kargoapi.FreightConstituent {
+	if c == nil {
+		return nil
+	}
+	return &kargoapi.FreightConstituent{
+		ID:        c.GetId(),
+		Warehouse: c.GetWarehouse(),
 	}
 }
 
@@ -255,10 +396,33 @@ func FromHealthProto(h *v1alpha1.Health) *kargoapi.Health {
 	for i, argocdAppState := range h.GetArgocdApps() {
 		argocdAppStates[i] = FromArgoCDAppStateProto(argocdAppState)
 	}
+	workloads := make([]kargoapi.WorkloadStatus, len(h.GetWorkloads()))
+	for i, w := range h.GetWorkloads() {
+		workloads[i] = kargoapi.WorkloadStatus{
+			Kind:      kargoapi.WorkloadKind(w.GetKind()),
+			Namespace: w.GetNamespace(),
+			Name:      w.GetName(),
+			Status:    kargoapi.HealthState(w.GetStatus()),
+			Message:   w.GetMessage(),
+		}
+	}
+	fluxResources := make([]kargoapi.FluxResourceStatus, len(h.GetFluxResources()))
+	for i, f := range h.GetFluxResources() {
+		fluxResources[i] = kargoapi.FluxResourceStatus{
+			Kind:      kargoapi.FluxResourceKind(f.GetKind()),
+			Namespace: f.GetNamespace(),
+			Name:      f.GetName(),
+			Status:    kargoapi.HealthState(f.GetStatus()),
+			Revision:  f.GetRevision(),
+			Message:   f.GetMessage(),
+		}
+	}
 	return &kargoapi.Health{
-		Status:     kargoapi.HealthState(h.GetStatus()),
-		Issues:     h.GetIssues(),
-		ArgoCDApps: argocdAppStates,
+		Status:        kargoapi.HealthState(h.GetStatus()),
+		Issues:        h.GetIssues(),
+		ArgoCDApps:    argocdAppStates,
+		Workloads:     workloads,
+		FluxResources: fluxResources,
 	}
 }
 
@@ -301,8 +465,10 @@ func FromSubscriptionsProto(s *v1alpha1.Subscriptions) *kargoapi.Subscriptions {
 		upstreamStages[idx] = *FromStageSubscriptionProto(stage)
 	}
 	return &kargoapi.Subscriptions{
-		Warehouse:      s.GetWarehouse(),
-		UpstreamStages: upstreamStages,
+		Warehouse:          s.GetWarehouse(),
+		Warehouses:         s.GetWarehouses(),
+		UpstreamStages:     upstreamStages,
+		UpstreamStagesMode: kargoapi.UpstreamStagesMode(s.GetUpstreamStagesMode()),
 	}
 }
 
@@ -368,9 +534,65 @@ func FromPromotionMechanismsProto(m *v1alpha1.PromotionMechanisms) *kargoapi.Pro
 	for idx, argo := range m.GetArgocdAppUpdates() {
 		argoUpdates[idx] = *FromArgoCDAppUpdatesProto(argo)
 	}
+	fluxUpdates := make([]kargoapi.FluxUpdate, len(m.GetFluxUpdates()))
+	for idx, flux := range m.GetFluxUpdates() {
+		fluxUpdates[idx] = *FromFluxUpdateProto(flux)
+	}
 	return &kargoapi.PromotionMechanisms{
 		GitRepoUpdates:   gitUpdates,
 		ArgoCDAppUpdates: argoUpdates,
+		FluxUpdates:      fluxUpdates,
+	}
+}
+
+func FromFluxUpdateProto(u *v1alpha1.FluxUpdate) *kargoapi.FluxUpdate {
+	if u == nil {
+		return nil
+	}
+	var helmRelease *kargoapi.FluxHelmReleaseUpdate
+	if hr := u.GetHelmRelease(); hr != nil {
+		helmRelease = &kargoapi.FluxHelmReleaseUpdate{
+			Images: make([]kargoapi.FluxHelmImageUpdate, len(hr.GetImages())),
+		}
+		if chart := hr.GetChart(); chart != nil {
+			helmRelease.Chart = &kargoapi.FluxHelmChartUpdate{
+				RepoURL: chart.GetRepoUrl(),
+				Name:    chart.GetName(),
+			}
+		}
+		for i, image := range hr.GetImages() {
+			helmRelease.Images[i] = kargoapi.FluxHelmImageUpdate{
+				Image: image.GetImage(),
+				Key:   image.GetKey(),
+				Value: kargoapi.ImageUpdateValueType(image.GetValue()),
+			}
+		}
+	}
+	var ociRepository *kargoapi.FluxOCIRepositoryUpdate
+	if oci := u.GetOciRepository(); oci != nil {
+		ociRepository = &kargoapi.FluxOCIRepositoryUpdate{
+			UseDigest: oci.GetUseDigest(),
+		}
+	}
+	var kustomization *kargoapi.FluxKustomizationUpdate
+	if k := u.GetKustomization(); k != nil {
+		kustomization = &kargoapi.FluxKustomizationUpdate{
+			Images: make([]kargoapi.FluxKustomizeImageUpdate, len(k.GetImages())),
+		}
+		for i, image := range k.GetImages() {
+			kustomization.Images[i] = kargoapi.FluxKustomizeImageUpdate{
+				Image:     image.GetImage(),
+				UseDigest: image.GetUseDigest(),
+			}
+		}
+	}
+	return &kargoapi.FluxUpdate{
+		Kind:          kargoapi.FluxResourceKind(u.GetKind()),
+		Namespace:     u.GetNamespace(),
+		Name:          u.GetName(),
+		HelmRelease:   helmRelease,
+		OCIRepository: ociRepository,
+		Kustomization: kustomization,
 	}
 }
 
@@ -494,6 +716,31 @@ func FromArgoCDAppUpdatesProto(u *v1alpha1.ArgoCDAppUpdate) *kargoapi.ArgoCDAppU
 		AppName:       u.GetAppName(),
 		AppNamespace:  u.GetAppNamespace(),
 		SourceUpdates: sourceUpdates,
+		SyncOperation: FromArgoCDSyncOperationProto(u.GetSyncOperation()),
+	}
+}
+
+func FromArgoCDSyncOperationProto(o *v1alpha1.ArgoCDSyncOperation) *kargoapi.ArgoCDSyncOperation {
+	if o == nil {
+		return nil
+	}
+	var resources []kargoapi.ArgoCDSyncResource
+	if len(o.GetResources()) > 0 {
+		resources = make([]kargoapi.ArgoCDSyncResource, len(o.GetResources()))
+		for idx, resource := range o.GetResources() {
+			resources[idx] = kargoapi.ArgoCDSyncResource{
+				Group:     resource.GetGroup(),
+				Kind:      resource.GetKind(),
+				Name:      resource.GetName(),
+				Namespace: resource.GetNamespace(),
+			}
+		}
+	}
+	return &kargoapi.ArgoCDSyncOperation{
+		Prune:       o.GetPrune(),
+		Force:       o.GetForce(),
+		Resources:   resources,
+		SyncOptions: o.GetSyncOptions(),
 	}
 }
 
@@ -562,7 +809,43 @@ func FromStageSubscriptionProto(s *v1alpha1.StageSubscription) *kargoapi.StageSu
 		return nil
 	}
 	return &kargoapi.StageSubscription{
-		Name: s.GetName(),
+		Name:            s.GetName(),
+		MinSoakDuration: fromDurationProto(s.MinSoakDuration),
+	}
+}
+
+func FromApprovedStageProto(a *v1alpha1.ApprovedStage) kargoapi.ApprovedStage {
+	var approvedAt *kubemetav1.Time
+	if a.GetApprovedAt() != nil {
+		approvedAt = &kubemetav1.Time{Time: a.GetApprovedAt().AsTime()}
+	}
+	return kargoapi.ApprovedStage{
+		ApprovedAt: approvedAt,
+		Approvals:  FromApprovalsProto(a.GetApprovals()),
+	}
+}
+
+func FromApprovalsProto(a []*v1alpha1.Approval) []kargoapi.Approval {
+	if len(a) == 0 {
+		return nil
+	}
+	approvals := make([]kargoapi.Approval, len(a))
+	for i, approval := range a {
+		approvals[i] = kargoapi.Approval{
+			Approver:   approval.GetApprover(),
+			ApprovedAt: kubemetav1.Time{Time: approval.GetApprovedAt().AsTime()},
+		}
+	}
+	return approvals
+}
+
+func FromVerifiedStageProto(v *v1alpha1.VerifiedStage) kargoapi.VerifiedStage {
+	var verifiedAt *kubemetav1.Time
+	if v.GetVerifiedAt() != nil {
+		verifiedAt = &kubemetav1.Time{Time: v.GetVerifiedAt().AsTime()}
+	}
+	return kargoapi.VerifiedStage{
+		VerifiedAt: verifiedAt,
 	}
 }
 
@@ -603,10 +886,40 @@ func FromPromotionStatusProto(s *v1alpha1.PromotionStatus) *kargoapi.PromotionSt
 	if s == nil {
 		return nil
 	}
+	var startedAt *kubemetav1.Time
+	if s.GetStartedAt() != nil {
+		startedAt = &kubemetav1.Time{Time: s.GetStartedAt().AsTime()}
+	}
+	var attempts []kargoapi.PromotionAttempt
+	if len(s.GetAttempts()) > 0 {
+		attempts = make([]kargoapi.PromotionAttempt, len(s.GetAttempts()))
+		for i, attempt := range s.GetAttempts() {
+			attempts[i] = kargoapi.PromotionAttempt{
+				FinishedAt: kubemetav1.Time{Time: attempt.GetFinishedAt().AsTime()},
+				Message:    attempt.GetMessage(),
+			}
+		}
+	}
+	var hooks []kargoapi.PromotionHookStatus
+	if len(s.GetHooks()) > 0 {
+		hooks = make([]kargoapi.PromotionHookStatus, len(s.GetHooks()))
+		for i, hook := range s.GetHooks() {
+			hooks[i] = kargoapi.PromotionHookStatus{
+				Name:    hook.GetName(),
+				Type:    kargoapi.PromotionHookType(hook.GetType()),
+				JobName: hook.GetJobName(),
+				Phase:   kargoapi.PromotionHookPhase(hook.GetPhase()),
+				Message: hook.GetMessage(),
+			}
+		}
+	}
 	return &kargoapi.PromotionStatus{
-		Phase:    kargoapi.PromotionPhase(s.GetPhase()),
-		Message:  s.GetMessage(),
-		Metadata: s.GetMetadata(),
+		Phase:     kargoapi.PromotionPhase(s.GetPhase()),
+		Message:   s.GetMessage(),
+		Metadata:  s.GetMetadata(),
+		StartedAt: startedAt,
+		Attempts:  attempts,
+		Hooks:     hooks,
 	}
 }
 
@@ -753,6 +1066,11 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 			Subscriptions:       ToSubscriptionsProto(*e.Spec.Subscriptions),
 			PromotionMechanisms: promotionMechanisms,
 			Verification:        ToVerificationProto(e.Spec.Verification),
+			PromotionPolicy:     ToStagePromotionPolicyProto(e.Spec.PromotionPolicy),
+			ApprovalPolicy:      ToApprovalPolicyProto(e.Spec.ApprovalPolicy),
+			Lock:                ToStageLockProto(e.Spec.Lock),
+			HealthChecks:        ToHealthChecksProto(e.Spec.HealthChecks),
+			Hooks:               ToPromotionHooksProto(e.Spec.Hooks),
 		},
 		Status: &v1alpha1.StageStatus{
 			Phase:            string(e.Status.Phase),
@@ -791,8 +1109,10 @@ func ToSubscriptionsProto(s kargoapi.Subscriptions) *v1alpha1.Subscriptions {
 		upstreamStages[idx] = ToStageSubscriptionProto(s.UpstreamStages[idx])
 	}
 	return &v1alpha1.Subscriptions{
-		Warehouse:      s.Warehouse,
-		UpstreamStages: upstreamStages,
+		Warehouse:          s.Warehouse,
+		Warehouses:         s.Warehouses,
+		UpstreamStages:     upstreamStages,
+		UpstreamStagesMode: string(s.UpstreamStagesMode),
 	}
 }
 
@@ -828,7 +1148,40 @@ func ToChartSubscriptionProto(c kargoapi.ChartSubscription) *v1alpha1.ChartSubsc
 
 func ToStageSubscriptionProto(e kargoapi.StageSubscription) *v1alpha1.StageSubscription {
 	return &v1alpha1.StageSubscription{
-		Name: e.Name,
+		Name:            e.Name,
+		MinSoakDuration: toDurationProto(e.MinSoakDuration),
+	}
+}
+
+func ToApprovedStageProto(a kargoapi.ApprovedStage) *v1alpha1.ApprovedStage {
+	var approvedAt *timestamppb.Timestamp
+	if a.ApprovedAt != nil {
+		approvedAt = timestamppb.New(a.ApprovedAt.Time)
+	}
+	return &v1alpha1.ApprovedStage{
+		ApprovedAt: approvedAt,
+		Approvals:  ToApprovalsProto(a.Approvals),
+	}
+}
+
+func ToApprovalsProto(a []kargoapi.Approval) []*v1alpha1.Approval {
+	approvals := make([]*v1alpha1.Approval, len(a))
+	for i, approval := range a {
+		approvals[i] = &v1alpha1.Approval{
+			Approver:   approval.Approver,
+			ApprovedAt: timestamppb.New(approval.ApprovedAt.Time),
+		}
+	}
+	return approvals
+}
+
+func ToVerifiedStageProto(v kargoapi.VerifiedStage) *v1alpha1.VerifiedStage {
+	var verifiedAt *timestamppb.Timestamp
+	if v.VerifiedAt != nil {
+		verifiedAt = timestamppb.New(v.VerifiedAt.Time)
+	}
+	return &v1alpha1.VerifiedStage{
+		VerifiedAt: verifiedAt,
 	}
 }
 
@@ -841,9 +1194,62 @@ func ToPromotionMechanismsProto(p kargoapi.PromotionMechanisms) *v1alpha1.Promot
 	for idx := range p.ArgoCDAppUpdates {
 		argoCDAppUpdates[idx] = ToArgoCDAppUpdateProto(p.ArgoCDAppUpdates[idx])
 	}
+	fluxUpdates := make([]*v1alpha1.FluxUpdate, len(p.FluxUpdates))
+	for idx := range p.FluxUpdates {
+		fluxUpdates[idx] = ToFluxUpdateProto(p.FluxUpdates[idx])
+	}
 	return &v1alpha1.PromotionMechanisms{
 		GitRepoUpdates:   gitRepoUpdates,
 		ArgocdAppUpdates: argoCDAppUpdates,
+		FluxUpdates:      fluxUpdates,
+	}
+}
+
+func ToFluxUpdateProto(u kargoapi.FluxUpdate) *v1alpha1.FluxUpdate {
+	var helmRelease *v1alpha1.FluxHelmReleaseUpdate
+	if hr := u.HelmRelease; hr != nil {
+		helmRelease = &v1alpha1.FluxHelmReleaseUpdate{
+			Images: make([]*v1alpha1.FluxHelmImageUpdate, len(hr.Images)),
+		}
+		if hr.Chart != nil {
+			helmRelease.Chart = &v1alpha1.FluxHelmChartUpdate{
+				RepoUrl: hr.Chart.RepoURL,
+				Name:    hr.Chart.Name,
+			}
+		}
+		for i, image := range hr.Images {
+			helmRelease.Images[i] = &v1alpha1.FluxHelmImageUpdate{
+				Image: image.Image,
+				Key:   image.Key,
+				Value: string(image.Value),
+			}
+		}
+	}
+	var ociRepository *v1alpha1.FluxOCIRepositoryUpdate
+	if u.OCIRepository != nil {
+		ociRepository = &v1alpha1.FluxOCIRepositoryUpdate{
+			UseDigest: u.OCIRepository.UseDigest,
+		}
+	}
+	var kustomization *v1alpha1.FluxKustomizationUpdate
+	if k := u.Kustomization; k != nil {
+		kustomization = &v1alpha1.FluxKustomizationUpdate{
+			Images: make([]*v1alpha1.FluxKustomizeImageUpdate, len(k.Images)),
+		}
+		for i, image := range k.Images {
+			kustomization.Images[i] = &v1alpha1.FluxKustomizeImageUpdate{
+				Image:     image.Image,
+				UseDigest: image.UseDigest,
+			}
+		}
+	}
+	return &v1alpha1.FluxUpdate{
+		Kind:          string(u.Kind),
+		Namespace:     u.Namespace,
+		Name:          u.Name,
+		HelmRelease:   helmRelease,
+		OciRepository: ociRepository,
+		Kustomization: kustomization,
 	}
 }
 
@@ -934,10 +1340,33 @@ func ToArgoCDAppUpdateProto(h kargoapi.ArgoCDAppUpdate) *v1alpha1.ArgoCDAppUpdat
 	for idx := range h.SourceUpdates {
 		sourceUpdates[idx] = ToArgoCDSourceUpdateProto(h.SourceUpdates[idx])
 	}
+	var syncOperation *v1alpha1.ArgoCDSyncOperation
+	if h.SyncOperation != nil {
+		syncOperation = ToArgoCDSyncOperationProto(*h.SyncOperation)
+	}
 	return &v1alpha1.ArgoCDAppUpdate{
 		AppName:       h.AppName,
 		AppNamespace:  proto.String(h.AppNamespace),
 		SourceUpdates: sourceUpdates,
+		SyncOperation: syncOperation,
+	}
+}
+
+func ToArgoCDSyncOperationProto(o kargoapi.ArgoCDSyncOperation) *v1alpha1.ArgoCDSyncOperation {
+	resources := make([]*v1alpha1.ArgoCDSyncResource, len(o.Resources))
+	for idx, resource := range o.Resources {
+		resources[idx] = &v1alpha1.ArgoCDSyncResource{
+			Group:     proto.String(resource.Group),
+			Kind:      resource.Kind,
+			Name:      resource.Name,
+			Namespace: proto.String(resource.Namespace),
+		}
+	}
+	return &v1alpha1.ArgoCDSyncOperation{
+		Prune:       o.Prune,
+		Force:       o.Force,
+		Resources:   resources,
+		SyncOptions: o.SyncOptions,
 	}
 }
 
@@ -1024,13 +1453,20 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 	}
 	verifiedIn :=
 		make(map[string]*v1alpha1.VerifiedStage, len(f.Status.VerifiedIn))
-	for stage := range f.Status.VerifiedIn {
-		verifiedIn[stage] = &v1alpha1.VerifiedStage{}
+	for stage, verified := range f.Status.VerifiedIn {
+		verifiedIn[stage] = ToVerifiedStageProto(verified)
 	}
 	approvedFor :=
 		make(map[string]*v1alpha1.ApprovedStage, len(f.Status.ApprovedFor))
-	for stage := range f.Status.ApprovedFor {
-		approvedFor[stage] = &v1alpha1.ApprovedStage{}
+	for stage, approved := range f.Status.ApprovedFor {
+		approvedFor[stage] = ToApprovedStageProto(approved)
+	}
+	pendingApprovalFor :=
+		make(map[string]*v1alpha1.PendingApproval, len(f.Status.PendingApprovalFor))
+	for stage, pending := range f.Status.PendingApprovalFor {
+		pendingApprovalFor[stage] = &v1alpha1.PendingApproval{
+			Approvals: ToApprovalsProto(pending.Approvals),
+		}
 	}
 	return &v1alpha1.Freight{
 		ApiVersion: f.APIVersion,
@@ -1041,8 +1477,9 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 		Commits:    commits,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Status: &v1alpha1.FreightStatus{
-			VerifiedIn:  verifiedIn,
-			ApprovedFor: approvedFor,
+			VerifiedIn:         verifiedIn,
+			ApprovedFor:        approvedFor,
+			PendingApprovalFor: pendingApprovalFor,
 		},
 	}
 }
@@ -1064,6 +1501,10 @@ func ToFreightReferenceProto(s kargoapi.FreightReference, firstSeen *time.Time)
 	for idx := range s.Charts {
 		charts[idx] = ToChartProto(s.Charts[idx])
 	}
+	constituents := make([]*v1alpha1.FreightConstituent, len(s.Constituents))
+	for idx := range s.Constituents {
+		constituents[idx] = ToFreightConstituentProto(s.Constituents[idx])
+	}
 	return &v1alpha1.FreightReference{
 		Id:               s.ID,
 		FirstSeen:        firstSeenProto,
@@ -1071,6 +1512,14 @@ func ToFreightReferenceProto(s kargoapi.FreightReference, firstSeen *time.Time)
 		Images:           images,
 		Charts:           charts,
 		VerificationInfo: ToVerificationInfoProto(s.VerificationInfo),
+		Constituents:     constituents,
+	}
+}
+
+func ToFreightConstituentProto(c kargoapi.FreightConstituent) *v1alpha1.FreightConstituent {
+	return &v1alpha1.FreightConstituent{
+		Id:        c.ID,
+		Warehouse: c.Warehouse,
 	}
 }
 
@@ -1130,10 +1579,33 @@ func ToHealthProto(h kargoapi.Health) *v1alpha1.Health {
 	for i, argocdAppState := range h.ArgoCDApps {
 		argocdAppStates[i] = ToArgoCDAppStateProto(argocdAppState)
 	}
+	workloads := make([]*v1alpha1.WorkloadStatus, len(h.Workloads))
+	for i, w := range h.Workloads {
+		workloads[i] = &v1alpha1.WorkloadStatus{
+			Kind:      string(w.Kind),
+			Namespace: w.Namespace,
+			Name:      w.Name,
+			Status:    string(w.Status),
+			Message:   w.Message,
+		}
+	}
+	fluxResources := make([]*v1alpha1.FluxResourceStatus, len(h.FluxResources))
+	for i, f := range h.FluxResources {
+		fluxResources[i] = &v1alpha1.FluxResourceStatus{
+			Kind:      string(f.Kind),
+			Namespace: f.Namespace,
+			Name:      f.Name,
+			Status:    string(f.Status),
+			Revision:  f.Revision,
+			Message:   f.Message,
+		}
+	}
 	return &v1alpha1.Health{
-		Status:     string(h.Status),
-		Issues:     h.Issues,
-		ArgocdApps: argocdAppStates,
+		Status:        string(h.Status),
+		Issues:        h.Issues,
+		ArgocdApps:    argocdAppStates,
+		Workloads:     workloads,
+		FluxResources: fluxResources,
 	}
 }
 
@@ -1179,11 +1651,39 @@ func ToPromotionProto(p kargoapi.Promotion) *v1alpha1.Promotion {
 			Stage:   p.Spec.Stage,
 			Freight: p.Spec.Freight,
 		},
-		Status: &v1alpha1.PromotionStatus{
-			Phase:    string(p.Status.Phase),
-			Message:  p.Status.Message,
-			Metadata: p.Status.Metadata,
-		},
+		Status: ToPromotionStatusProto(p.Status),
+	}
+}
+
+func ToPromotionStatusProto(s kargoapi.PromotionStatus) *v1alpha1.PromotionStatus {
+	var startedAt *timestamppb.Timestamp
+	if s.StartedAt != nil {
+		startedAt = timestamppb.New(s.StartedAt.Time)
+	}
+	attempts := make([]*v1alpha1.PromotionAttempt, len(s.Attempts))
+	for i, attempt := range s.Attempts {
+		attempts[i] = &v1alpha1.PromotionAttempt{
+			FinishedAt: timestamppb.New(attempt.FinishedAt.Time),
+			Message:    attempt.Message,
+		}
+	}
+	hooks := make([]*v1alpha1.PromotionHookStatus, len(s.Hooks))
+	for i, hook := range s.Hooks {
+		hooks[i] = &v1alpha1.PromotionHookStatus{
+			Name:    hook.Name,
+			Type:    string(hook.Type),
+			JobName: hook.JobName,
+			Phase:   string(hook.Phase),
+			Message: hook.Message,
+		}
+	}
+	return &v1alpha1.PromotionStatus{
+		Phase:     string(s.Phase),
+		Message:   s.Message,
+		Metadata:  s.Metadata,
+		StartedAt: startedAt,
+		Attempts:  attempts,
+		Hooks:     hooks,
 	}
 }
 
@@ -1194,6 +1694,105 @@ func ToPromotionPolicyProto(p kargoapi.PromotionPolicy) *v1alpha1.PromotionPolic
 	}
 }
 
+func ToHealthChecksProto(h *kargoapi.HealthChecks) *v1alpha1.HealthChecks {
+	if h == nil {
+		return nil
+	}
+	workloads := make([]*v1alpha1.WorkloadHealthCheck, len(h.Workloads))
+	for i, w := range h.Workloads {
+		workloads[i] = &v1alpha1.WorkloadHealthCheck{
+			Kind:      string(w.Kind),
+			Namespace: w.Namespace,
+			Name:      w.Name,
+			Selector:  w.Selector,
+		}
+	}
+	return &v1alpha1.HealthChecks{
+		Workloads: workloads,
+	}
+}
+
+func ToPromotionHooksProto(h *kargoapi.PromotionHooks) *v1alpha1.PromotionHooks {
+	if h == nil {
+		return nil
+	}
+	return &v1alpha1.PromotionHooks{
+		PrePromotion:  toPromotionHookProtos(h.PrePromotion),
+		PostPromotion: toPromotionHookProtos(h.PostPromotion),
+	}
+}
+
+func toPromotionHookProtos(hooks []kargoapi.PromotionHook) []*v1alpha1.PromotionHook {
+	res := make([]*v1alpha1.PromotionHook, len(hooks))
+	for i, h := range hooks {
+		// Marshaling a JobTemplateSpec cannot fail
+		jobTemplate, _ := json.Marshal(h.JobTemplate)
+		res[i] = &v1alpha1.PromotionHook{
+			Name:        h.Name,
+			JobTemplate: jobTemplate,
+		}
+	}
+	return res
+}
+
+func ToStageLockProto(l *kargoapi.StageLock) *v1alpha1.StageLock {
+	if l == nil {
+		return nil
+	}
+	var lockedAt *timestamppb.Timestamp
+	if l.LockedAt != nil {
+		lockedAt = timestamppb.New(l.LockedAt.Time)
+	}
+	var until *timestamppb.Timestamp
+	if l.Until != nil {
+		until = timestamppb.New(l.Until.Time)
+	}
+	return &v1alpha1.StageLock{
+		LockedBy: l.LockedBy,
+		LockedAt: lockedAt,
+		Reason:   l.Reason,
+		Until:    until,
+	}
+}
+
+func ToApprovalPolicyProto(p *kargoapi.ApprovalPolicy) *v1alpha1.ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &v1alpha1.ApprovalPolicy{
+		RequiredApprovals: p.RequiredApprovals,
+		AllowedGroups:     p.AllowedGroups,
+		AllowedEmails:     p.AllowedEmails,
+	}
+}
+
+func ToStagePromotionPolicyProto(p *kargoapi.StagePromotionPolicy) *v1alpha1.StagePromotionPolicy {
+	if p == nil {
+		return nil
+	}
+	return &v1alpha1.StagePromotionPolicy{
+		Timeout: toDurationProto(p.Timeout),
+		Retry:   ToPromotionRetryPolicyProto(p.Retry),
+	}
+}
+
+func ToPromotionRetryPolicyProto(p *kargoapi.PromotionRetryPolicy) *v1alpha1.PromotionRetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &v1alpha1.PromotionRetryPolicy{
+		Limit:   p.Limit,
+		Backoff: toDurationProto(p.Backoff),
+	}
+}
+
+func toDurationProto(d *kubemetav1.Duration) *string {
+	if d == nil {
+		return nil
+	}
+	return proto.String(d.Duration.String())
+}
+
 func ToVersionProto(v version.Version) *svcv1alpha1.VersionInfo {
 	return &svcv1alpha1.VersionInfo{
 		Version:      v.Version,
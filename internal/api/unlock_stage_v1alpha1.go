@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) UnlockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UnlockStageRequest],
+) (*connect.Response[svcv1alpha1.UnlockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+	if err := s.validateProject(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err
+	}
+
+	var stage kargoapi.Stage
+	key := client.ObjectKey{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	if err := s.client.Get(ctx, key, &stage); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound,
+				fmt.Errorf("stage %q not found", key.String()))
+		}
+		return nil, errors.Wrap(err, "get stage")
+	}
+
+	if stage.Spec.Lock != nil {
+		stage.Spec.Lock = nil
+		if err := s.client.Update(ctx, &stage); err != nil {
+			return nil, errors.Wrap(err, "update stage")
+		}
+	}
+	return connect.NewResponse(&svcv1alpha1.UnlockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(stage),
+	}), nil
+}
@@ -47,6 +47,13 @@ func (s *server) updateResource(
 	}
 
 	obj.SetResourceVersion(currentObj.GetResourceVersion())
+	if err := setStageLockedBy(ctx, obj, currentObj); err != nil {
+		return &svcv1alpha1.UpdateResourceResult{
+			Result: &svcv1alpha1.UpdateResourceResult_Error{
+				Error: err.Error(),
+			},
+		}
+	}
 	if err := s.client.Update(ctx, obj); err != nil {
 		return &svcv1alpha1.UpdateResourceResult{
 			Result: &svcv1alpha1.UpdateResourceResult_Error{
@@ -54,6 +54,9 @@ func (s *server) UpdateStage(
 		return nil, errors.Wrap(err, "get stage")
 	}
 	stage.SetResourceVersion(existingStage.GetResourceVersion())
+	if err := setStageLockedBy(ctx, &stage, &existingStage); err != nil {
+		return nil, err
+	}
 	if err := s.client.Update(ctx, &stage); err != nil {
 		return nil, errors.Wrap(err, "update stage")
 	}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// WatchFreight streams add, modify and delete events for Freight in a Project.
+// Events may optionally be limited to Freight from a single Warehouse and/or
+// to Freight that is available to a single Stage. When limited to a Stage,
+// Freight that becomes available to it, including because its minimum soak
+// duration in an upstream Stage has elapsed, is reported as added and Freight
+// that stops being available to it is reported as deleted.
+func (s *server) WatchFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.WatchFreightRequest],
+	stream *connect.ServerStream[svcv1alpha1.WatchFreightResponse],
+) error {
+	if req.Msg.GetProject() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	if err := s.validateProject(ctx, req.Msg.GetProject()); err != nil {
+		return err
+	}
+
+	if req.Msg.GetWarehouse() != "" {
+		if err := s.client.Get(ctx, client.ObjectKey{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetWarehouse(),
+		}, &kargoapi.Warehouse{}); err != nil {
+			if kubeerr.IsNotFound(err) {
+				return connect.NewError(connect.CodeNotFound, err)
+			}
+			return errors.Wrap(err, "get warehouse")
+		}
+	}
+
+	// The Stage's subscriptions are captured once, when the watch begins. If
+	// they change, clients are expected to re-establish the watch.
+	var filter *stageFreightFilter
+	if req.Msg.GetStage() != "" {
+		stage := &kargoapi.Stage{}
+		if err := s.client.Get(ctx, client.ObjectKey{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetStage(),
+		}, stage); err != nil {
+			if kubeerr.IsNotFound(err) {
+				return connect.NewError(connect.CodeNotFound, err)
+			}
+			return errors.Wrap(err, "get stage")
+		}
+		filter = newStageFreightFilter(stage)
+	}
+
+	w, err := s.client.Watch(ctx, &kargoapi.Freight{}, req.Msg.GetProject(), metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "watch freight")
+	}
+	defer w.Stop()
+
+	send := func(eventType watch.EventType, freight *kargoapi.Freight) error {
+		return errors.Wrap(
+			stream.Send(&svcv1alpha1.WatchFreightResponse{
+				Freight: typesv1alpha1.ToFreightProto(*freight),
+				Type:    string(eventType),
+			}),
+			"send response",
+		)
+	}
+
+	// Freight becoming available because a minimum soak duration has elapsed
+	// isn't accompanied by any change to the Freight, so a timer is kept for
+	// the next time that happens.
+	var soakTimer *time.Timer
+	defer func() {
+		if soakTimer != nil {
+			soakTimer.Stop()
+		}
+	}()
+	for {
+		var soakTimerC <-chan time.Time
+		if soakTimer != nil {
+			soakTimer.Stop()
+			soakTimer = nil
+		}
+		if filter != nil {
+			if d, ok := filter.nextSoakCheck(time.Now()); ok {
+				soakTimer = time.NewTimer(d)
+				soakTimerC = soakTimer.C
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-soakTimerC:
+			for _, freight := range filter.soaked(time.Now()) {
+				if err := send(watch.Added, freight); err != nil {
+					return err
+				}
+			}
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			u, ok := e.Object.(*unstructured.Unstructured)
+			if !ok {
+				return errors.Errorf("unexpected object type %T", e.Object)
+			}
+			var freight *kargoapi.Freight
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &freight); err != nil {
+				return errors.Wrap(err, "from unstructured")
+			}
+			// The dynamic client can't filter on indexed fields, so Freight is
+			// filtered manually here.
+			if req.Msg.GetWarehouse() != "" &&
+				freight.GetWarehouse() != req.Msg.GetWarehouse() {
+				continue
+			}
+			eventType := e.Type
+			if filter != nil {
+				if eventType, ok = filter.filter(e.Type, freight, time.Now()); !ok {
+					continue
+				}
+			}
+			if err := send(eventType, freight); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// stageFreightFilter tracks which Freight is available to a Stage so that
+// Freight entering or leaving that set can be reported as added or deleted.
+type stageFreightFilter struct {
+	stage *kargoapi.Stage
+	// available holds the names of all Freight known to be available to the
+	// Stage.
+	available map[string]struct{}
+	// soaking holds the latest copy of all Freight that is not yet available to
+	// the Stage, but will be once its minimum soak duration in an upstream Stage
+	// has elapsed.
+	soaking map[string]*kargoapi.Freight
+}
+
+func newStageFreightFilter(stage *kargoapi.Stage) *stageFreightFilter {
+	return &stageFreightFilter{
+		stage:     stage,
+		available: map[string]struct{}{},
+		soaking:   map[string]*kargoapi.Freight{},
+	}
+}
+
+// filter records the effect of the specified watch event on the set of Freight
+// available to the Stage and returns the type of event that should be reported
+// for it. If no event should be reported, false is returned.
+func (f *stageFreightFilter) filter(
+	eventType watch.EventType,
+	freight *kargoapi.Freight,
+	now time.Time,
+) (watch.EventType, bool) {
+	_, wasAvailable := f.available[freight.Name]
+	delete(f.soaking, freight.Name)
+	if eventType != watch.Deleted {
+		if isFreightAvailableToStage(freight, f.stage, now) {
+			f.available[freight.Name] = struct{}{}
+			if !wasAvailable {
+				return watch.Added, true
+			}
+			return eventType, true
+		}
+		if getSoakTimeRemainingForStage(freight, f.stage, now) > 0 {
+			f.soaking[freight.Name] = freight
+		}
+	}
+	delete(f.available, freight.Name)
+	return watch.Deleted, wasAvailable
+}
+
+// nextSoakCheck returns how long it will be, as of the specified time, until
+// the first soaking Freight's minimum soak duration elapses. If no Freight is
+// soaking, false is returned.
+func (f *stageFreightFilter) nextSoakCheck(now time.Time) (time.Duration, bool) {
+	var next time.Duration
+	var found bool
+	for _, freight := range f.soaking {
+		remaining := getSoakTimeRemainingForStage(freight, f.stage, now)
+		if !found || remaining < next {
+			next = remaining
+			found = true
+		}
+	}
+	return next, found
+}
+
+// soaked returns all soaking Freight that has become available to the Stage as
+// of the specified time and records it as available.
+func (f *stageFreightFilter) soaked(now time.Time) []*kargoapi.Freight {
+	var soaked []*kargoapi.Freight
+	for name, freight := range f.soaking {
+		if getSoakTimeRemainingForStage(freight, f.stage, now) > 0 {
+			continue
+		}
+		delete(f.soaking, name)
+		if isFreightAvailableToStage(freight, f.stage, now) {
+			f.available[name] = struct{}{}
+			soaked = append(soaked, freight)
+		}
+	}
+	return soaked
+}
+
+// getSoakTimeRemainingForStage returns how much longer, as of the specified
+// time, the specified Freight must remain verified in any upstream Stage of the
+// specified Stage in which it is still soaking. Zero is returned if it is not
+// soaking in any of them.
+func getSoakTimeRemainingForStage(
+	freight *kargoapi.Freight,
+	stage *kargoapi.Stage,
+	now time.Time,
+) time.Duration {
+	if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return 0
+	}
+	var remaining time.Duration
+	for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+		r := kargoapi.GetFreightSoakTimeRemaining(freight, upstream, now)
+		if r > 0 && (remaining == 0 || r < remaining) {
+			remaining = r
+		}
+	}
+	return remaining
+}
+
+// isFreightAvailableToStage returns true if, as of the specified time, the
+// specified Freight is available to the specified Stage for any of the reasons
+// enumerated by getAvailableFreightForStage.
+func isFreightAvailableToStage(
+	freight *kargoapi.Freight,
+	stage *kargoapi.Stage,
+	now time.Time,
+) bool {
+	if stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return false
+	}
+	subs := stage.Spec.Subscriptions
+	if warehouses := subs.GetWarehouses(); len(warehouses) > 0 {
+		for _, warehouse := range warehouses {
+			if freight.GetWarehouse() == warehouse {
+				return true
+			}
+		}
+		return false
+	}
+	if len(subs.UpstreamStages) == 0 {
+		return false
+	}
+	// Takes manual approvals and upstream soak durations into account
+	if _, ok := freight.Status.ApprovedFor[stage.Name]; ok {
+		return true
+	}
+	return kargoapi.IsFreightVerifiedUpstream(
+		freight,
+		subs.UpstreamStages,
+		subs.UpstreamStagesMode,
+		now,
+	)
+}
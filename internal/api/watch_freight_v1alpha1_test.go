@@ -0,0 +1,237 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestIsFreightAvailableToStage(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kargoapi.GroupVersion.String(),
+					Kind:       "Warehouse",
+					Name:       "fake-warehouse",
+				},
+			},
+		},
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"fake-upstream": {},
+				"soaking-upstream": {
+					VerifiedAt: &metav1.Time{Time: time.Now()},
+				},
+			},
+			ApprovedFor: map[string]kargoapi.ApprovedStage{
+				"fake-approved-stage": {},
+			},
+		},
+	}
+	testCases := []struct {
+		name      string
+		stage     *kargoapi.Stage
+		available bool
+	}{
+		{
+			name:  "no subscriptions",
+			stage: &kargoapi.Stage{},
+		},
+		{
+			name: "subscribed to the Freight's Warehouse",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			available: true,
+		},
+		{
+			name: "subscribed to another Warehouse",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouses: []string{"other-warehouse"},
+					},
+				},
+			},
+		},
+		{
+			name: "verified in any upstream Stage",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "other-upstream"},
+							{Name: "fake-upstream"},
+						},
+					},
+				},
+			},
+			available: true,
+		},
+		{
+			name: "not verified in all upstream Stages",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "other-upstream"},
+							{Name: "fake-upstream"},
+						},
+						UpstreamStagesMode: kargoapi.UpstreamStagesModeAll,
+					},
+				},
+			},
+		},
+		{
+			name: "verified in all upstream Stages",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "fake-upstream"},
+						},
+						UpstreamStagesMode: kargoapi.UpstreamStagesModeAll,
+					},
+				},
+			},
+			available: true,
+		},
+		{
+			name: "still soaking in upstream Stage",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{
+								Name:            "soaking-upstream",
+								MinSoakDuration: &metav1.Duration{Duration: time.Hour},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "approved for the Stage",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-approved-stage",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "other-upstream"},
+						},
+					},
+				},
+			},
+			available: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.available,
+				isFreightAvailableToStage(testFreight, testCase.stage, time.Now()),
+			)
+		})
+	}
+}
+
+func TestStageFreightFilter(t *testing.T) {
+	now := time.Now()
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{
+						Name:            "fake-upstream",
+						MinSoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		},
+	}
+	newFreight := func(verifiedAt time.Time, approved bool) *kargoapi.Freight {
+		freight := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-freight",
+			},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"fake-upstream": {
+						VerifiedAt: &metav1.Time{Time: verifiedAt},
+					},
+				},
+			},
+		}
+		if approved {
+			freight.Status.ApprovedFor = map[string]kargoapi.ApprovedStage{
+				"fake-stage": {},
+			}
+		}
+		return freight
+	}
+
+	f := newStageFreightFilter(stage)
+
+	// Freight that is still soaking is not reported, but is tracked
+	eventType, ok :=
+		f.filter(watch.Added, newFreight(now.Add(-30*time.Minute), false), now)
+	require.False(t, ok)
+	d, ok := f.nextSoakCheck(now)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Minute, d)
+	require.Empty(t, f.soaked(now))
+
+	// Freight that has finished soaking is reported as added
+	soaked := f.soaked(now.Add(30 * time.Minute))
+	require.Len(t, soaked, 1)
+	require.Equal(t, "fake-freight", soaked[0].Name)
+	_, ok = f.nextSoakCheck(now)
+	require.False(t, ok)
+
+	// Changes to available Freight are reported as is
+	eventType, ok =
+		f.filter(watch.Modified, newFreight(now.Add(-2*time.Hour), false), now)
+	require.True(t, ok)
+	require.Equal(t, watch.Modified, eventType)
+
+	// Freight that stops being available is reported as deleted
+	eventType, ok =
+		f.filter(watch.Modified, newFreight(now.Add(-time.Minute), false), now)
+	require.True(t, ok)
+	require.Equal(t, watch.Deleted, eventType)
+
+	// Deletion of Freight that is not available is not reported
+	_, ok = f.filter(watch.Deleted, newFreight(now.Add(-time.Minute), false), now)
+	require.False(t, ok)
+	_, ok = f.nextSoakCheck(now)
+	require.False(t, ok)
+
+	// Freight that becomes available is reported as added
+	eventType, ok =
+		f.filter(watch.Modified, newFreight(now.Add(-time.Minute), true), now)
+	require.True(t, ok)
+	require.Equal(t, watch.Added, eventType)
+
+	// Deletion of available Freight is reported
+	eventType, ok =
+		f.filter(watch.Deleted, newFreight(now.Add(-time.Minute), true), now)
+	require.True(t, ok)
+	require.Equal(t, watch.Deleted, eventType)
+}
@@ -14,9 +14,11 @@ import (
 )
 
 // GetClientFromConfig returns a new client for the Kargo API server located at
-// the address specified in local configuration, using credentials also
-// specified in local configuration UNLESS the specified options indicates that
-// the local server should be used instead.
+// the address specified by the selected context in local configuration, using
+// credentials also specified by that context UNLESS the specified options
+// indicates that the local server should be used instead. The selected context
+// is the one named by the specified options or, if none is named, the current
+// context.
 func GetClientFromConfig(
 	ctx context.Context,
 	cfg config.CLIConfig,
@@ -28,17 +30,21 @@ func GetClientFromConfig(
 	if opt.UseLocalServer {
 		return GetClient(opt.LocalServerAddress, "", opt.InsecureTLS), nil
 	}
-	if cfg.APIAddress == "" || cfg.BearerToken == "" {
+	kargoCtx, err := cfg.GetContext(opt.Context)
+	if err != nil {
+		return nil, err
+	}
+	if kargoCtx.APIAddress == "" || kargoCtx.BearerToken == "" {
 		return nil, errors.New(
 			"seems like you are not logged in; please use `kargo login` to authenticate",
 		)
 	}
-	skipTLSVerify := opt.InsecureTLS || cfg.InsecureSkipTLSVerify
-	cfg, err := newTokenRefresher().refreshToken(ctx, cfg, skipTLSVerify)
-	if err != nil {
+	skipTLSVerify := opt.InsecureTLS || kargoCtx.InsecureSkipTLSVerify
+	if kargoCtx, err =
+		newTokenRefresher().refreshToken(ctx, cfg, kargoCtx, skipTLSVerify); err != nil {
 		return nil, errors.Wrap(err, "error refreshing token")
 	}
-	return GetClient(cfg.APIAddress, cfg.BearerToken, skipTLSVerify), nil
+	return GetClient(kargoCtx.APIAddress, kargoCtx.BearerToken, skipTLSVerify), nil
 }
 
 // GetClient returns a new client for the Kargo API server located at the
@@ -36,26 +36,28 @@ func newTokenRefresher() *tokenRefresher {
 	}
 }
 
-// refreshToken checks the token and refresh token in the provided config. If
+// refreshToken checks the token and refresh token in the provided context. If
 // the token is not parsable as a JWT, it will assume the token is not something
-// refreshable and return the provided config unmodified. If the token is
-// parsable as a JWT and is not expired, it will return the provided config
+// refreshable and return the provided context unmodified. If the token is
+// parsable as a JWT and is not expired, it will return the provided context
 // unmodified. If the token is expired and no refresh token is available OR TLS
 // cert verification is disabled, an error is returned indicating that the user
 // must re-authenticate. If a refresh token is available, it will attempt to
-// redeem that token and return updated config.
+// redeem that token, persist the updated context as part of the provided
+// config, and return the updated context.
 func (t *tokenRefresher) refreshToken(
 	ctx context.Context,
 	cfg config.CLIConfig,
+	kargoCtx config.Context,
 	insecureTLS bool,
-) (config.CLIConfig, error) {
+) (config.Context, error) {
 	jwtParser := jwt.NewParser(jwt.WithoutClaimsValidation())
 	var untrustedClaims jwt.RegisteredClaims
 	if _, _, err :=
-		jwtParser.ParseUnverified(cfg.BearerToken, &untrustedClaims); err != nil {
+		jwtParser.ParseUnverified(kargoCtx.BearerToken, &untrustedClaims); err != nil {
 		// This token isn't a JWT. So it's probably a bearer token for the
 		// Kubernetes API server. Just return. There's nothing further to do.
-		return cfg, nil
+		return kargoCtx, nil
 	}
 
 	// If we get to here, we're dealing with a JWT. It could have been issued:
@@ -68,33 +70,34 @@ func (t *tokenRefresher) refreshToken(
 	if untrustedClaims.ExpiresAt == nil || time.Now().Before(untrustedClaims.ExpiresAt.Time) {
 		// Token doesn't expire (possible for case 4) or hasn't yet. There's nothing
 		// further to do.
-		return cfg, nil
+		return kargoCtx, nil
 	}
 
 	// If we get to here, the token is expired.
 
-	if cfg.InsecureSkipTLSVerify || cfg.RefreshToken == "" {
+	if kargoCtx.InsecureSkipTLSVerify || kargoCtx.RefreshToken == "" {
 		// We don't have a refresh token OR TLS cert verification is disabled. We'll
 		// prompt the user to re-authenticate.
-		return cfg, errors.New(
+		return kargoCtx, errors.New(
 			"your token is expired; please use `kargo login` to re-authenticate",
 		)
 	}
 
 	var err error
-	if cfg.BearerToken, cfg.RefreshToken, err = t.redeemRefreshTokenFn(
+	if kargoCtx.BearerToken, kargoCtx.RefreshToken, err = t.redeemRefreshTokenFn(
 		ctx,
-		cfg.APIAddress,
-		cfg.RefreshToken,
+		kargoCtx.APIAddress,
+		kargoCtx.RefreshToken,
 		insecureTLS,
 	); err != nil {
-		return cfg, errors.New(
+		return kargoCtx, errors.New(
 			"error refreshing token; please use `kargo login` to re-authenticate",
 		)
 	}
 
-	// Save and return the updated config
-	return cfg, t.saveCLIConfigFn(cfg)
+	// Save the updated context and return it
+	cfg.SetContext(kargoCtx)
+	return kargoCtx, t.saveCLIConfigFn(cfg)
 }
 
 // redeemRefreshToken redeems the provided refresh token for a new ID token and
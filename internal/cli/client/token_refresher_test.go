@@ -21,7 +21,7 @@ func TestNewTokenRefresher(t *testing.T) {
 func TestRefreshToken(t *testing.T) {
 	testCases := []struct {
 		name                 string
-		setup                func() config.CLIConfig
+		setup                func() config.Context
 		redeemRefreshTokenFn func(
 			ctx context.Context,
 			serverAddress string,
@@ -30,58 +30,57 @@ func TestRefreshToken(t *testing.T) {
 		) (string, string, error)
 		saveCLIConfigFn func(config.CLIConfig) error
 		assertions      func(
-			originalCfg config.CLIConfig,
-			updatedCfg config.CLIConfig,
+			originalCtx config.Context,
+			updatedCtx config.Context,
 			err error,
 		)
 	}{
 		{
 			name: "token is not a JWT",
-			setup: func() config.CLIConfig {
-				return config.CLIConfig{
+			setup: func() config.Context {
+				return config.Context{
 					BearerToken: "not a JWT",
 				}
 			},
-			assertions: func(originalCfg, updatedCfg config.CLIConfig, err error) {
+			assertions: func(originalCtx, updatedCtx config.Context, err error) {
 				require.NoError(t, err)
-				require.Equal(t, originalCfg, updatedCfg)
+				require.Equal(t, originalCtx, updatedCtx)
 			},
 		},
 		{
 			name: "token is a non-expired JWT",
-			setup: func() config.CLIConfig {
-				cfg := config.CLIConfig{}
+			setup: func() config.Context {
+				kargoCtx := config.Context{}
 				var err error
-				cfg.BearerToken, err = jwt.NewWithClaims(
+				kargoCtx.BearerToken, err = jwt.NewWithClaims(
 					jwt.SigningMethodHS256,
 					jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
 					},
 				).SignedString([]byte("signing key"))
 				require.NoError(t, err)
-				return cfg
+				return kargoCtx
 			},
-			assertions: func(originalCfg, updatedCfg config.CLIConfig, err error,
-			) {
+			assertions: func(originalCtx, updatedCtx config.Context, err error) {
 				require.NoError(t, err)
-				require.Equal(t, originalCfg, updatedCfg)
+				require.Equal(t, originalCtx, updatedCtx)
 			},
 		},
 		{
 			name: "token is an expired JWT; no refresh token present",
-			setup: func() config.CLIConfig {
-				cfg := config.CLIConfig{}
+			setup: func() config.Context {
+				kargoCtx := config.Context{}
 				var err error
-				cfg.BearerToken, err = jwt.NewWithClaims(
+				kargoCtx.BearerToken, err = jwt.NewWithClaims(
 					jwt.SigningMethodHS256,
 					jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(-1 * time.Hour)),
 					},
 				).SignedString([]byte("signing key"))
 				require.NoError(t, err)
-				return cfg
+				return kargoCtx
 			},
-			assertions: func(_, _ config.CLIConfig, err error) {
+			assertions: func(_, _ config.Context, err error) {
 				require.Error(t, err)
 				require.Equal(
 					t,
@@ -93,22 +92,22 @@ func TestRefreshToken(t *testing.T) {
 		{
 			name: "token is an expired JWT; refresh token present; tls warnings " +
 				"ignored",
-			setup: func() config.CLIConfig {
-				cfg := config.CLIConfig{
+			setup: func() config.Context {
+				kargoCtx := config.Context{
 					RefreshToken:          "refresh-token",
 					InsecureSkipTLSVerify: true,
 				}
 				var err error
-				cfg.BearerToken, err = jwt.NewWithClaims(
+				kargoCtx.BearerToken, err = jwt.NewWithClaims(
 					jwt.SigningMethodHS256,
 					jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(-1 * time.Hour)),
 					},
 				).SignedString([]byte("signing key"))
 				require.NoError(t, err)
-				return cfg
+				return kargoCtx
 			},
-			assertions: func(_, _ config.CLIConfig, err error) {
+			assertions: func(_, _ config.Context, err error) {
 				require.Error(t, err)
 				require.Equal(
 					t,
@@ -119,19 +118,19 @@ func TestRefreshToken(t *testing.T) {
 		},
 		{
 			name: "token is an expired JWT; error redeeming refresh token",
-			setup: func() config.CLIConfig {
-				cfg := config.CLIConfig{
+			setup: func() config.Context {
+				kargoCtx := config.Context{
 					RefreshToken: "refresh-token",
 				}
 				var err error
-				cfg.BearerToken, err = jwt.NewWithClaims(
+				kargoCtx.BearerToken, err = jwt.NewWithClaims(
 					jwt.SigningMethodHS256,
 					jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(-1 * time.Hour)),
 					},
 				).SignedString([]byte("signing key"))
 				require.NoError(t, err)
-				return cfg
+				return kargoCtx
 			},
 			redeemRefreshTokenFn: func(
 				context.Context,
@@ -141,7 +140,7 @@ func TestRefreshToken(t *testing.T) {
 			) (string, string, error) {
 				return "", "", errors.New("something went wrong")
 			},
-			assertions: func(_, _ config.CLIConfig, err error) {
+			assertions: func(_, _ config.Context, err error) {
 				require.Error(t, err)
 				require.Equal(
 					t,
@@ -152,19 +151,20 @@ func TestRefreshToken(t *testing.T) {
 		},
 		{
 			name: "token is an expired JWT; success redeeming refresh token",
-			setup: func() config.CLIConfig {
-				cfg := config.CLIConfig{
+			setup: func() config.Context {
+				kargoCtx := config.Context{
+					Name:         "fake-context",
 					RefreshToken: "refresh-token",
 				}
 				var err error
-				cfg.BearerToken, err = jwt.NewWithClaims(
+				kargoCtx.BearerToken, err = jwt.NewWithClaims(
 					jwt.SigningMethodHS256,
 					jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(-1 * time.Hour)),
 					},
 				).SignedString([]byte("signing key"))
 				require.NoError(t, err)
-				return cfg
+				return kargoCtx
 			},
 			redeemRefreshTokenFn: func(
 				context.Context,
@@ -175,12 +175,16 @@ func TestRefreshToken(t *testing.T) {
 				return "new-token", "new-refresh-token", nil
 			},
 			saveCLIConfigFn: func(cfg config.CLIConfig) error {
+				savedCtx, err := cfg.GetContext("fake-context")
+				require.NoError(t, err)
+				require.Equal(t, "new-token", savedCtx.BearerToken)
+				require.Equal(t, "new-refresh-token", savedCtx.RefreshToken)
 				return nil
 			},
-			assertions: func(_, newConfig config.CLIConfig, err error) {
+			assertions: func(_, newCtx config.Context, err error) {
 				require.NoError(t, err)
-				require.Equal(t, "new-token", newConfig.BearerToken)
-				require.Equal(t, "new-refresh-token", newConfig.RefreshToken)
+				require.Equal(t, "new-token", newCtx.BearerToken)
+				require.Equal(t, "new-refresh-token", newCtx.RefreshToken)
 			},
 		},
 	}
@@ -190,10 +194,14 @@ func TestRefreshToken(t *testing.T) {
 				redeemRefreshTokenFn: testCase.redeemRefreshTokenFn,
 				saveCLIConfigFn:      testCase.saveCLIConfigFn,
 			}
-			cfg := testCase.setup()
-			newCfg, err :=
-				tf.refreshToken(context.Background(), testCase.setup(), false)
-			testCase.assertions(cfg, newCfg, err)
+			kargoCtx := testCase.setup()
+			newCtx, err := tf.refreshToken(
+				context.Background(),
+				config.CLIConfig{},
+				kargoCtx,
+				false,
+			)
+			testCase.assertions(kargoCtx, newCtx, err)
 		})
 	}
 }
@@ -1,19 +1,40 @@
 package config
 
 import (
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
 )
 
-func NewCommand(cfg config.CLIConfig) *cobra.Command {
+func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
 		Short: "Manage Kargo CLI configuration",
 	}
 
 	// Subcommands
-	cmd.AddCommand(newSetCommand(cfg))
-	cmd.AddCommand(newUnsetCommand(cfg))
+	cmd.AddCommand(newGetContextsCommand(cfg, opt))
+	cmd.AddCommand(newRenameContextCommand(cfg))
+	cmd.AddCommand(newSetCommand(cfg, opt))
+	cmd.AddCommand(newUnsetCommand(cfg, opt))
+	cmd.AddCommand(newUseContextCommand(cfg))
 	return cmd
 }
+
+// getContext returns the context selected by the specified options or, if
+// none is selected, the current context. An error is returned if there is no
+// such context.
+func getContext(cfg config.CLIConfig, opt *option.Option) (config.Context, error) {
+	kargoCtx, err := cfg.GetContext(opt.Context)
+	if err != nil {
+		return kargoCtx, err
+	}
+	if kargoCtx.Name == "" {
+		return kargoCtx, errors.New(
+			"no current context; please use `kargo login` to create one",
+		)
+	}
+	return kargoCtx, nil
+}
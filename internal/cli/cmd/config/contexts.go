@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func newGetContextsCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	return &cobra.Command{
+		Use:     "get-contexts",
+		Short:   "List all contexts",
+		Args:    option.ExactArgs(0),
+		Example: "kargo config get-contexts",
+		RunE: func(*cobra.Command, []string) error {
+			w := tabwriter.NewWriter(opt.IOStreams.Out, 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tPROJECT")
+			for _, kargoCtx := range cfg.Contexts {
+				var current string
+				if kargoCtx.Name == cfg.CurrentContext {
+					current = "*"
+				}
+				_, _ = fmt.Fprintf(
+					w,
+					"%s\t%s\t%s\t%s\n",
+					current,
+					kargoCtx.Name,
+					kargoCtx.APIAddress,
+					kargoCtx.Project,
+				)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newUseContextCommand(cfg config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:     "use-context NAME",
+		Short:   "Set the current context",
+		Args:    option.ExactArgs(1),
+		Example: "kargo config use-context staging",
+		RunE: func(_ *cobra.Command, args []string) error {
+			if _, err := cfg.GetContext(args[0]); err != nil {
+				return err
+			}
+			cfg.CurrentContext = args[0]
+			if err := config.SaveCLIConfig(cfg); err != nil {
+				return errors.Wrap(err, "save cli config")
+			}
+			return nil
+		},
+	}
+}
+
+func newRenameContextCommand(cfg config.CLIConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:     "rename-context OLD_NAME NEW_NAME",
+		Short:   "Rename a context",
+		Args:    option.ExactArgs(2),
+		Example: "kargo config rename-context kargo.example.com prod",
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := cfg.RenameContext(args[0], args[1]); err != nil {
+				return err
+			}
+			if err := config.SaveCLIConfig(cfg); err != nil {
+				return errors.Wrap(err, "save cli config")
+			}
+			return nil
+		},
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/option"
 )
 
-func newSetCommand(cfg config.CLIConfig) *cobra.Command {
+func newSetCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set",
 		Short: "Set an individual value",
@@ -20,14 +20,20 @@ func newSetCommand(cfg config.CLIConfig) *cobra.Command {
 kargo config set project my-project
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			kargoCtx, err := getContext(cfg, opt)
+			if err != nil {
+				return err
+			}
+
 			key := strings.ToLower(args[0])
 			switch key {
 			case "project":
-				cfg.Project = args[1]
+				kargoCtx.Project = args[1]
 			default:
 				return errors.Errorf("unknown key %q", key)
 			}
 
+			cfg.SetContext(kargoCtx)
 			if err := config.SaveCLIConfig(cfg); err != nil {
 				return errors.Wrap(err, "save cli config")
 			}
@@ -0,0 +1,92 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newAPITokenCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	var serviceAccount, ttl string
+	var scopes []string
+	cmd := &cobra.Command{
+		Use: "api-token --project=project --service-account=service-account " +
+			"[--scope=method]... [--ttl=duration] (NAME)",
+		Short: "Create an API token for a robot account",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Create an API token for the ci robot account
+kargo create api-token --project=my-project --service-account=ci my-token
+
+# Create an API token that may only be used to promote Stages, valid for a day
+kargo create api-token --project=my-project --service-account=ci \
+  --scope=PromoteStage --ttl=24h promoter
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+			if serviceAccount == "" {
+				return errors.New("service account is required")
+			}
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.CreateAPIToken(ctx,
+				connect.NewRequest(&kargosvcapi.CreateAPITokenRequest{
+					Project:        project,
+					ServiceAccount: serviceAccount,
+					Name:           name,
+					Scopes:         scopes,
+					Ttl:            ttl,
+				}))
+			if err != nil {
+				return errors.Wrap(err, "create API token")
+			}
+
+			apiToken := resp.Msg.GetApiToken()
+			_, _ = fmt.Fprintf(
+				opt.IOStreams.ErrOut,
+				"API Token Created: %q (expires %s)\n"+
+					"This token will not be shown again.\n",
+				apiToken.GetId(),
+				apiToken.GetExpiryTime().AsTime().Local().Format("2006-01-02 15:04:05"),
+			)
+			_, _ = fmt.Fprintln(opt.IOStreams.Out, resp.Msg.GetToken())
+			return nil
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	option.ServiceAccount(cmd.Flags(), &serviceAccount)
+	cmd.Flags().StringSliceVar(
+		&scopes,
+		"scope",
+		nil,
+		"Name of an API method the token may be used to invoke; may be repeated "+
+			"(default: all methods)",
+	)
+	cmd.Flags().StringVar(
+		&ttl,
+		"ttl",
+		"",
+		"How long the token remains valid (default: the server's default)",
+	)
+	return cmd
+}
@@ -98,6 +98,7 @@ kargo create project my-project
 	option.LocalServer(cmd.PersistentFlags(), opt)
 
 	// Subcommands
+	cmd.AddCommand(newAPITokenCommand(cfg, opt))
 	cmd.AddCommand(newProjectCommand(cfg, opt))
 	return cmd
 }
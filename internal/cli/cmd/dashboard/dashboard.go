@@ -6,22 +6,27 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
 )
 
-func NewCommand(cfg config.CLIConfig) *cobra.Command {
+func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	return &cobra.Command{
 		Use:     "dashboard",
 		Short:   "Open the Kargo Dashboard in your default browser.",
 		Example: "kargo logout",
 		RunE: func(*cobra.Command, []string) error {
-			if cfg.APIAddress == "" {
+			kargoCtx, err := cfg.GetContext(opt.Context)
+			if err != nil {
+				return err
+			}
+			if kargoCtx.APIAddress == "" {
 				return errors.New(
 					"seems like you are not logged in; please use `kargo login` to authenticate",
 				)
 			}
 
 			return errors.Wrap(
-				browser.Open(cfg.APIAddress),
+				browser.Open(kargoCtx.APIAddress),
 				"error opening dashboard in default browser",
 			)
 		},
@@ -0,0 +1,61 @@
+package delete
+
+import (
+	goerrors "errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newAPITokenCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	var serviceAccount string
+	cmd := &cobra.Command{
+		Use:   "api-token --service-account=service-account [ID]...",
+		Short: "Revoke API tokens issued to a robot account",
+		Args:  option.MinimumNArgs(1),
+		Example: `
+# Revoke an API token issued to the ci robot account
+kargo delete api-token --project=my-project --service-account=ci 0b6e7a8c-4f2d-4f6b-9d8e-2c1a3b4d5e6f
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+			if serviceAccount == "" {
+				return errors.New("service account is required")
+			}
+
+			var resErr error
+			for _, id := range slices.Compact(args) {
+				if _, err := kargoSvcCli.RevokeAPIToken(ctx, connect.NewRequest(&v1alpha1.RevokeAPITokenRequest{
+					Project:        project,
+					ServiceAccount: serviceAccount,
+					Id:             id,
+				})); err != nil {
+					resErr = goerrors.Join(resErr, errors.Wrap(err, "Error"))
+					continue
+				}
+				_, _ = fmt.Fprintf(opt.IOStreams.Out, "API Token Revoked: %q\n", id)
+			}
+			return resErr
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	option.ServiceAccount(cmd.Flags(), &serviceAccount)
+	return cmd
+}
@@ -88,6 +88,7 @@ kargo delete -f stage.yaml
 	option.LocalServer(cmd.PersistentFlags(), opt)
 
 	// Subcommands
+	cmd.AddCommand(newAPITokenCommand(cfg, opt))
 	cmd.AddCommand(newProjectCommand(cfg, opt))
 	cmd.AddCommand(newStageCommand(cfg, opt))
 	cmd.AddCommand(newWarehouseCommand(cfg, opt))
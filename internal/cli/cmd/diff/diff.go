@@ -0,0 +1,20 @@
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show differences between resources",
+	}
+	option.InsecureTLS(cmd.PersistentFlags(), opt)
+	option.LocalServer(cmd.PersistentFlags(), opt)
+
+	cmd.AddCommand(newDiffFreightCommand(cfg, opt))
+	return cmd
+}
@@ -0,0 +1,169 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newDiffFreightCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freight --project=project FROM TO",
+		Args:  option.ExactArgs(2),
+		Short: "Show differences between two pieces of freight",
+		Example: `
+# Show what changed between two pieces of freight, by name or alias
+kargo diff freight --project=my-project wonky-wombat frozen-fox
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+
+			resp, err := kargoSvcCli.DiffFreight(
+				ctx,
+				connect.NewRequest(
+					&v1alpha1.DiffFreightRequest{
+						Project: project,
+						From:    args[0],
+						To:      args[1],
+					},
+				),
+			)
+			if err != nil {
+				return errors.Wrap(err, "diff freight")
+			}
+
+			printFreightDiff(opt.IOStreams.Out, resp.Msg)
+			return nil
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
+
+func printFreightDiff(out io.Writer, diff *v1alpha1.DiffFreightResponse) {
+	if len(diff.GetImages()) == 0 &&
+		len(diff.GetCharts()) == 0 &&
+		len(diff.GetCommits()) == 0 {
+		_, _ = fmt.Fprintln(out, "No differences")
+		return
+	}
+	if len(diff.GetImages()) > 0 {
+		_, _ = fmt.Fprintln(out, "Images:")
+		for _, img := range diff.GetImages() {
+			_, _ = fmt.Fprintf(
+				out,
+				"  %s %s: %s\n",
+				changeSymbol(img.GetChange()),
+				img.GetRepoUrl(),
+				describeChange(
+					imageRef(img.GetFromTag(), img.GetFromDigest()),
+					imageRef(img.GetToTag(), img.GetToDigest()),
+				),
+			)
+			if img.GetSourceDiffUrl() != "" {
+				_, _ = fmt.Fprintf(out, "      source: %s\n", img.GetSourceDiffUrl())
+			} else if img.GetToGitRepoUrl() != "" {
+				_, _ = fmt.Fprintf(out, "      source: %s\n", img.GetToGitRepoUrl())
+			}
+		}
+	}
+	if len(diff.GetCharts()) > 0 {
+		_, _ = fmt.Fprintln(out, "Charts:")
+		for _, chart := range diff.GetCharts() {
+			_, _ = fmt.Fprintf(
+				out,
+				"  %s %s/%s: %s\n",
+				changeSymbol(chart.GetChange()),
+				strings.TrimSuffix(chart.GetRepoUrl(), "/"),
+				chart.GetName(),
+				describeChange(chart.GetFromVersion(), chart.GetToVersion()),
+			)
+		}
+	}
+	if len(diff.GetCommits()) > 0 {
+		_, _ = fmt.Fprintln(out, "Commits:")
+		for _, commit := range diff.GetCommits() {
+			_, _ = fmt.Fprintf(
+				out,
+				"  %s %s: %s\n",
+				changeSymbol(commit.GetChange()),
+				commit.GetRepoUrl(),
+				describeChange(commit.GetFromId(), commit.GetToId()),
+			)
+			for _, entry := range commit.GetLog() {
+				line := fmt.Sprintf(
+					"      %s %s (%s)",
+					shortID(entry.GetId()),
+					entry.GetMessage(),
+					entry.GetAuthor(),
+				)
+				if len(entry.GetIssueKeys()) > 0 {
+					line += " [" + strings.Join(entry.GetIssueKeys(), ", ") + "]"
+				}
+				_, _ = fmt.Fprintln(out, line)
+			}
+		}
+	}
+}
+
+func changeSymbol(change string) string {
+	switch change {
+	case "Added":
+		return "+"
+	case "Removed":
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+func describeChange(from, to string) string {
+	switch {
+	case from == "":
+		return to
+	case to == "":
+		return from
+	default:
+		return from + " -> " + to
+	}
+}
+
+func imageRef(tag, digest string) string {
+	switch {
+	case tag == "":
+		return digest
+	case digest == "":
+		return tag
+	default:
+		return tag + "@" + digest
+	}
+}
+
+func shortID(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+	return id
+}
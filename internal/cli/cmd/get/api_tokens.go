@@ -0,0 +1,81 @@
+package get
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newGetAPITokensCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	var serviceAccount string
+	cmd := &cobra.Command{
+		Use:     "api-tokens --project=project [--service-account=service-account]",
+		Aliases: []string{"api-token"},
+		Short:   "Display the API tokens issued to robot accounts",
+		Args:    option.ExactArgs(0),
+		Example: `
+# List all API tokens issued to robot accounts in the project
+kargo get api-tokens --project=my-project
+
+# List all API tokens issued to the ci robot account
+kargo get api-tokens --project=my-project --service-account=ci
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.ListAPITokens(ctx, connect.NewRequest(&v1alpha1.ListAPITokensRequest{
+				Project:        project,
+				ServiceAccount: serviceAccount,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "list API tokens")
+			}
+
+			w := tabwriter.NewWriter(opt.IOStreams.Out, 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "ID\tNAME\tSERVICE ACCOUNT\tSCOPES\tAGE\tEXPIRES IN")
+			for _, t := range resp.Msg.GetApiTokens() {
+				scopes := strings.Join(t.GetScopes(), ",")
+				if scopes == "" {
+					scopes = "*"
+				}
+				_, _ = fmt.Fprintf(
+					w,
+					"%s\t%s\t%s\t%s\t%s\t%s\n",
+					t.GetId(),
+					t.GetName(),
+					t.GetServiceAccount(),
+					scopes,
+					duration.HumanDuration(time.Since(t.GetCreateTime().AsTime())),
+					duration.HumanDuration(time.Until(t.GetExpiryTime().AsTime())),
+				)
+			}
+			return w.Flush()
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	option.ServiceAccount(cmd.Flags(), &serviceAccount)
+	return cmd
+}
@@ -23,8 +23,9 @@ func newGetFreightCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
+	var selector string
 	cmd := &cobra.Command{
-		Use:   "freight --project=project [NAME...]",
+		Use:   "freight --project=project [--selector=selector] [NAME...]",
 		Short: "Display one or many pieces of freight",
 		Example: `
 # List all freight in the project
@@ -49,7 +50,8 @@ kargo get freight --project=my-project my-freight
 				return errors.Wrap(err, "get client from config")
 			}
 			resp, err := kargoSvcCli.QueryFreight(ctx, connect.NewRequest(&v1alpha1.QueryFreightRequest{
-				Project: project,
+				Project:       project,
+				LabelSelector: selector,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "query freight")
@@ -99,6 +101,7 @@ kargo get freight --project=my-project my-freight
 		},
 	}
 	option.Project(cmd.Flags(), opt, opt.Project)
+	option.Selector(cmd.Flags(), &selector)
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
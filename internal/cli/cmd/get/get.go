@@ -32,7 +32,9 @@ kargo get promotions --project=my-project --stage=my-stage
 	option.LocalServer(cmd.PersistentFlags(), opt)
 
 	// Subcommands
+	cmd.AddCommand(newGetAPITokensCommand(cfg, opt))
 	cmd.AddCommand(newGetFreightCommand(cfg, opt))
+	cmd.AddCommand(newGetPipelineCommand(cfg, opt))
 	cmd.AddCommand(newGetProjectsCommand(cfg, opt))
 	cmd.AddCommand(newGetPromotionsCommand(cfg, opt))
 	cmd.AddCommand(newGetStagesCommand(cfg, opt))
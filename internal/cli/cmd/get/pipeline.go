@@ -0,0 +1,262 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	pipelineFormatTree    = "tree"
+	pipelineFormatDOT     = "dot"
+	pipelineFormatMermaid = "mermaid"
+)
+
+func newGetPipelineCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "pipeline --project=project [--format=tree|dot|mermaid]",
+		Short: "Display the pipeline of warehouses and stages in a project",
+		Args:  option.ExactArgs(0),
+		Example: `
+# Display the pipeline of the project as a tree
+kargo get pipeline --project=my-project
+
+# Render the pipeline of the project with Graphviz
+kargo get pipeline --project=my-project --format=dot | dot -Tsvg > pipeline.svg
+
+# Display the pipeline of the project as a Mermaid flowchart
+kargo get pipeline --project=my-project --format=mermaid
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			var render func(io.Writer, []*v1alpha1.PipelineNode, []*v1alpha1.PipelineEdge) error
+			switch format {
+			case pipelineFormatTree:
+				render = renderPipelineTree
+			case pipelineFormatDOT:
+				render = renderPipelineDOT
+			case pipelineFormatMermaid:
+				render = renderPipelineMermaid
+			default:
+				return errors.Errorf(
+					"unsupported format %q; must be one of %q, %q or %q",
+					format,
+					pipelineFormatTree,
+					pipelineFormatDOT,
+					pipelineFormatMermaid,
+				)
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.GetPipeline(ctx, connect.NewRequest(&v1alpha1.GetPipelineRequest{
+				Project: project,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "get pipeline")
+			}
+			return render(opt.IOStreams.Out, resp.Msg.GetNodes(), resp.Msg.GetEdges())
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	cmd.Flags().StringVar(
+		&format,
+		"format",
+		pipelineFormatTree,
+		fmt.Sprintf(
+			"Output format. One of: %s|%s|%s",
+			pipelineFormatTree,
+			pipelineFormatDOT,
+			pipelineFormatMermaid,
+		),
+	)
+	return cmd
+}
+
+func pipelineNodeKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// pipelineNodeLabel returns a one-line description of the specified node.
+func pipelineNodeLabel(node *v1alpha1.PipelineNode) string {
+	var details []string
+	if freight := node.GetCurrentFreightAlias(); freight != "" {
+		details = append(details, "freight: "+freight)
+	} else if freight = node.GetCurrentFreight(); freight != "" {
+		details = append(details, "freight: "+freight)
+	}
+	if health := node.GetHealth(); health != "" {
+		details = append(details, "health: "+health)
+	}
+	if phase := node.GetPhase(); phase != "" {
+		details = append(details, "phase: "+phase)
+	}
+	label := fmt.Sprintf("%s %s", node.GetKind(), node.GetName())
+	if len(details) > 0 {
+		label = fmt.Sprintf("%s (%s)", label, strings.Join(details, ", "))
+	}
+	return label
+}
+
+// renderPipelineTree writes the pipeline as an ASCII tree rooted at every node
+// without incoming edges. Since Stages may subscribe to more than one
+// Warehouse or upstream Stage, a node can appear more than once in the tree.
+// Its descendants are only expanded the first time it appears. Nodes that are
+// not reachable from any such root (i.e. nodes that only appear in cycles) are
+// rendered as additional roots so that nothing is silently omitted.
+func renderPipelineTree(
+	w io.Writer,
+	nodes []*v1alpha1.PipelineNode,
+	edges []*v1alpha1.PipelineEdge,
+) error {
+	nodesByKey := make(map[string]*v1alpha1.PipelineNode, len(nodes))
+	for _, node := range nodes {
+		nodesByKey[pipelineNodeKey(node.GetKind(), node.GetName())] = node
+	}
+	children := make(map[string][]string, len(nodes))
+	hasParent := make(map[string]bool, len(nodes))
+	for _, edge := range edges {
+		from := pipelineNodeKey(edge.GetFromKind(), edge.GetFromName())
+		to := pipelineNodeKey(edge.GetToKind(), edge.GetToName())
+		children[from] = append(children[from], to)
+		hasParent[to] = true
+	}
+
+	expanded := make(map[string]bool, len(nodes))
+	var sb strings.Builder
+	var walk func(key, prefix string, last, root bool)
+	walk = func(key, prefix string, last, root bool) {
+		node, ok := nodesByKey[key]
+		if !ok {
+			return
+		}
+		childPrefix := prefix
+		if root {
+			sb.WriteString(pipelineNodeLabel(node))
+		} else {
+			branch := "├── "
+			childPrefix += "│   "
+			if last {
+				branch = "└── "
+				childPrefix = prefix + "    "
+			}
+			sb.WriteString(prefix + branch + pipelineNodeLabel(node))
+		}
+		if expanded[key] {
+			if len(children[key]) > 0 {
+				sb.WriteString(" ...")
+			}
+			sb.WriteString("\n")
+			return
+		}
+		sb.WriteString("\n")
+		expanded[key] = true
+		for i, child := range children[key] {
+			walk(child, childPrefix, i == len(children[key])-1, false)
+		}
+	}
+	for _, node := range nodes {
+		key := pipelineNodeKey(node.GetKind(), node.GetName())
+		if !hasParent[key] {
+			walk(key, "", true, true)
+		}
+	}
+	for _, node := range nodes {
+		key := pipelineNodeKey(node.GetKind(), node.GetName())
+		if !expanded[key] {
+			walk(key, "", true, true)
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// renderPipelineDOT writes the pipeline as a Graphviz DOT digraph.
+func renderPipelineDOT(
+	w io.Writer,
+	nodes []*v1alpha1.PipelineNode,
+	edges []*v1alpha1.PipelineEdge,
+) error {
+	var sb strings.Builder
+	sb.WriteString("digraph pipeline {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range nodes {
+		shape := "box"
+		if node.GetKind() == "Warehouse" {
+			shape = "cylinder"
+		}
+		sb.WriteString(fmt.Sprintf(
+			"  %q [label=%q, shape=%s];\n",
+			pipelineNodeKey(node.GetKind(), node.GetName()),
+			pipelineNodeLabel(node),
+			shape,
+		))
+	}
+	for _, edge := range edges {
+		sb.WriteString(fmt.Sprintf(
+			"  %q -> %q;\n",
+			pipelineNodeKey(edge.GetFromKind(), edge.GetFromName()),
+			pipelineNodeKey(edge.GetToKind(), edge.GetToName()),
+		))
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// renderPipelineMermaid writes the pipeline as a Mermaid flowchart. Mermaid
+// node IDs may not contain every character a Kubernetes object name can, so
+// nodes are identified by their position and named only in their labels.
+func renderPipelineMermaid(
+	w io.Writer,
+	nodes []*v1alpha1.PipelineNode,
+	edges []*v1alpha1.PipelineEdge,
+) error {
+	ids := make(map[string]string, len(nodes))
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for i, node := range nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[pipelineNodeKey(node.GetKind(), node.GetName())] = id
+		label := strings.ReplaceAll(pipelineNodeLabel(node), `"`, "#quot;")
+		if node.GetKind() == "Warehouse" {
+			sb.WriteString(fmt.Sprintf("  %s[(\"%s\")]\n", id, label))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", id, label))
+		}
+	}
+	for _, edge := range edges {
+		from, ok := ids[pipelineNodeKey(edge.GetFromKind(), edge.GetFromName())]
+		if !ok {
+			continue
+		}
+		to, ok := ids[pipelineNodeKey(edge.GetToKind(), edge.GetToName())]
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %s --> %s\n", from, to))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
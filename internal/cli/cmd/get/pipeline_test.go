@@ -0,0 +1,134 @@
+package get
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRenderPipeline(t *testing.T) {
+	nodes := []*v1alpha1.PipelineNode{
+		{Kind: "Warehouse", Name: "images"},
+		{
+			Kind:                "Stage",
+			Name:                "test",
+			CurrentFreight:      "abc123",
+			CurrentFreightAlias: "wonky-wombat",
+			Health:              "Healthy",
+			Phase:               "Steady",
+		},
+		{Kind: "Stage", Name: "uat"},
+		{Kind: "Stage", Name: "prod"},
+	}
+	edges := []*v1alpha1.PipelineEdge{
+		{FromKind: "Warehouse", FromName: "images", ToKind: "Stage", ToName: "test"},
+		{FromKind: "Stage", FromName: "test", ToKind: "Stage", ToName: "uat"},
+		{FromKind: "Stage", FromName: "test", ToKind: "Stage", ToName: "prod"},
+	}
+	testCases := []struct {
+		name     string
+		render   func(io.Writer, []*v1alpha1.PipelineNode, []*v1alpha1.PipelineEdge) error
+		expected string
+	}{
+		{
+			name:   "tree",
+			render: renderPipelineTree,
+			expected: `Warehouse images
+└── Stage test (freight: wonky-wombat, health: Healthy, phase: Steady)
+    ├── Stage uat
+    └── Stage prod
+`,
+		},
+		{
+			name:   "dot",
+			render: renderPipelineDOT,
+			expected: `digraph pipeline {
+  rankdir=LR;
+  "Warehouse/images" [label="Warehouse images", shape=cylinder];
+  "Stage/test" [label="Stage test (freight: wonky-wombat, health: Healthy, phase: Steady)", shape=box];
+  "Stage/uat" [label="Stage uat", shape=box];
+  "Stage/prod" [label="Stage prod", shape=box];
+  "Warehouse/images" -> "Stage/test";
+  "Stage/test" -> "Stage/uat";
+  "Stage/test" -> "Stage/prod";
+}
+`,
+		},
+		{
+			name:   "mermaid",
+			render: renderPipelineMermaid,
+			expected: `flowchart LR
+  n0[("Warehouse images")]
+  n1["Stage test (freight: wonky-wombat, health: Healthy, phase: Steady)"]
+  n2["Stage uat"]
+  n3["Stage prod"]
+  n0 --> n1
+  n1 --> n2
+  n1 --> n3
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, testCase.render(&buf, nodes, edges))
+			require.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
+
+func TestRenderPipelineTreeSharedDescendants(t *testing.T) {
+	nodes := []*v1alpha1.PipelineNode{
+		{Kind: "Warehouse", Name: "a"},
+		{Kind: "Warehouse", Name: "b"},
+		{Kind: "Stage", Name: "test"},
+		{Kind: "Stage", Name: "uat"},
+	}
+	edges := []*v1alpha1.PipelineEdge{
+		{FromKind: "Warehouse", FromName: "a", ToKind: "Stage", ToName: "test"},
+		{FromKind: "Warehouse", FromName: "b", ToKind: "Stage", ToName: "test"},
+		{FromKind: "Stage", FromName: "test", ToKind: "Stage", ToName: "uat"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, renderPipelineTree(&buf, nodes, edges))
+	require.Equal(
+		t,
+		`Warehouse a
+└── Stage test
+    └── Stage uat
+Warehouse b
+└── Stage test ...
+`,
+		buf.String(),
+	)
+}
+
+func TestRenderPipelineTreeCycle(t *testing.T) {
+	nodes := []*v1alpha1.PipelineNode{
+		{Kind: "Warehouse", Name: "a"},
+		{Kind: "Stage", Name: "test"},
+		{Kind: "Stage", Name: "uat"},
+		{Kind: "Stage", Name: "prod"},
+	}
+	edges := []*v1alpha1.PipelineEdge{
+		{FromKind: "Warehouse", FromName: "a", ToKind: "Stage", ToName: "test"},
+		{FromKind: "Stage", FromName: "uat", ToKind: "Stage", ToName: "prod"},
+		{FromKind: "Stage", FromName: "prod", ToKind: "Stage", ToName: "uat"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, renderPipelineTree(&buf, nodes, edges))
+	require.Equal(
+		t,
+		`Warehouse a
+└── Stage test
+Stage uat
+└── Stage prod
+    └── Stage uat ...
+`,
+		buf.String(),
+	)
+}
@@ -23,8 +23,10 @@ func newGetProjectsCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
+	var selector string
+	var limit int32
 	cmd := &cobra.Command{
-		Use:     "projects [NAME...]",
+		Use:     "projects [--selector=selector] [--limit=limit] [NAME...]",
 		Aliases: []string{"project"},
 		Short:   "Display one or many projects",
 		Example: `
@@ -33,6 +35,9 @@ kargo get projects
 
 # List all projects in JSON output format
 kargo get projects -o json
+
+# List the first ten projects labeled team=payments
+kargo get projects --selector=team=payments --limit=10
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -41,7 +46,10 @@ kargo get projects -o json
 			if err != nil {
 				return errors.Wrap(err, "get client from config")
 			}
-			resp, err := kargoSvcCli.ListProjects(ctx, connect.NewRequest(&v1alpha1.ListProjectsRequest{}))
+			resp, err := kargoSvcCli.ListProjects(ctx, connect.NewRequest(&v1alpha1.ListProjectsRequest{
+				LabelSelector: selector,
+				PageSize:      limit,
+			}))
 			if err != nil {
 				return errors.Wrap(err, "list projects")
 			}
@@ -72,6 +80,8 @@ kargo get projects -o json
 			return resErr
 		},
 	}
+	option.Selector(cmd.Flags(), &selector)
+	option.Limit(cmd.Flags(), &limit)
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
@@ -24,9 +24,12 @@ func newGetPromotionsCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
-	var stage string
+	var stage, selector string
+	var phases []string
+	var limit int32
 	cmd := &cobra.Command{
-		Use:     "promotions --project=project [--stage=stage] [NAME...]",
+		Use: "promotions --project=project [--stage=stage] [--phase=phase]... " +
+			"[--selector=selector] [--limit=limit] [NAME...]",
 		Aliases: []string{"promotion", "promos", "promo"},
 		Short:   "Display one or many promotions",
 		Example: `
@@ -39,6 +42,9 @@ kargo get promotions --project=my-project -o json
 # List all promotions for the stage
 kargo get promotions --project=my-project --stage=my-stage
 
+# List all running or pending promotions in the project
+kargo get promotions --project=my-project --phase=Running --phase=Pending
+
 # Get a promotion in the project
 kargo get promotions --project=my-project some-promotion
 `,
@@ -50,7 +56,10 @@ kargo get promotions --project=my-project some-promotion
 				return errors.New("project is required")
 			}
 			req := &v1alpha1.ListPromotionsRequest{
-				Project: project,
+				Project:       project,
+				LabelSelector: selector,
+				Phases:        phases,
+				PageSize:      limit,
 			}
 			if stage != "" {
 				req.Stage = proto.String(stage)
@@ -93,6 +102,10 @@ kargo get promotions --project=my-project some-promotion
 	}
 	option.Project(cmd.Flags(), opt, opt.Project)
 	option.Stage(cmd.Flags(), &stage)
+	cmd.Flags().StringSliceVar(&phases, "phase", nil,
+		"Only list promotions in the specified phase; may be repeated")
+	option.Selector(cmd.Flags(), &selector)
+	option.Limit(cmd.Flags(), &limit)
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
@@ -23,8 +23,10 @@ func newGetStagesCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
+	var selector string
+	var limit int32
 	cmd := &cobra.Command{
-		Use:     "stages --project=project [NAME...]",
+		Use:     "stages --project=project [--selector=selector] [--limit=limit] [NAME...]",
 		Aliases: []string{"stage"},
 		Short:   "Display one or many stages",
 		Example: `
@@ -34,6 +36,9 @@ kargo get stages --project=my-project
 # List all stages in JSON output format
 kargo get stages --project=my-project -o json
 
+# List the first ten stages labeled tier=prod in the project
+kargo get stages --project=my-project --selector=tier=prod --limit=10
+
 # Get a stage in the project
 kargo get stages --project=my-project my-stage
 `,
@@ -50,7 +55,9 @@ kargo get stages --project=my-project my-stage
 				return errors.Wrap(err, "get client from config")
 			}
 			resp, err := kargoSvcCli.ListStages(ctx, connect.NewRequest(&v1alpha1.ListStagesRequest{
-				Project: project,
+				Project:       project,
+				LabelSelector: selector,
+				PageSize:      limit,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "list stages")
@@ -83,6 +90,8 @@ kargo get stages --project=my-project my-stage
 		},
 	}
 	option.Project(cmd.Flags(), opt, opt.Project)
+	option.Selector(cmd.Flags(), &selector)
+	option.Limit(cmd.Flags(), &limit)
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
@@ -20,8 +20,10 @@ func newGetWarehousesCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
+	var selector string
+	var limit int32
 	cmd := &cobra.Command{
-		Use:     "warehouses --project=project [NAME...]",
+		Use:     "warehouses --project=project [--selector=selector] [--limit=limit] [NAME...]",
 		Aliases: []string{"warehouse"},
 		Short:   "Display one or many warehouses",
 		Example: `
@@ -50,7 +52,9 @@ kargo get warehouses --project=my-project my-warehouse
 				ctx,
 				connect.NewRequest(
 					&v1alpha1.ListWarehousesRequest{
-						Project: project,
+						Project:       project,
+						LabelSelector: selector,
+						PageSize:      limit,
 					},
 				),
 			)
@@ -88,6 +92,8 @@ kargo get warehouses --project=my-project my-warehouse
 		},
 	}
 	option.Project(cmd.Flags(), opt, opt.Project)
+	option.Selector(cmd.Flags(), &selector)
+	option.Limit(cmd.Flags(), &limit)
 	opt.PrintFlags.AddFlags(cmd)
 	return cmd
 }
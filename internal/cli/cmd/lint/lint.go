@@ -0,0 +1,246 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/lint"
+	versionpkg "github.com/akuity/kargo/internal/version"
+)
+
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+)
+
+func NewCommand(_ config.CLIConfig, opt *option.Option) *cobra.Command {
+	var format string
+	var recursive bool
+	cmd := &cobra.Command{
+		Use:   "lint [--format=text|json|sarif] [--recursive] PATH...",
+		Short: "Validate Kargo manifests without a cluster",
+		Args:  cobra.MinimumNArgs(1),
+		Example: `
+# Lint all Kargo resources in a directory
+kargo lint ./kargo
+
+# Lint all Kargo resources in a directory and its subdirectories
+kargo lint --recursive ./kargo
+
+# Lint Kargo resources and produce a SARIF report for code scanning
+kargo lint --format=sarif ./kargo > kargo.sarif
+`,
+		RunE: func(_ *cobra.Command, args []string) error {
+			var write func(io.Writer, []lint.Finding) error
+			switch format {
+			case formatText:
+				write = writeText
+			case formatJSON:
+				write = writeJSON
+			case formatSARIF:
+				write = writeSARIF
+			default:
+				return errors.Errorf(
+					"unsupported format %q; must be one of %q, %q or %q",
+					format,
+					formatText,
+					formatJSON,
+					formatSARIF,
+				)
+			}
+
+			objects, findings, err := lint.Load(args, recursive)
+			if err != nil {
+				return errors.Wrap(err, "load manifests")
+			}
+			findings = append(findings, lint.Lint(objects)...)
+			lint.SortFindings(findings)
+			if err = write(opt.IOStreams.Out, findings); err != nil {
+				return errors.Wrap(err, "write findings")
+			}
+
+			var errCount int
+			for _, finding := range findings {
+				if finding.Severity == lint.SeverityError {
+					errCount++
+				}
+			}
+			if errCount > 0 {
+				return errors.Errorf("found %d error(s)", errCount)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(
+		&format,
+		"format",
+		formatText,
+		fmt.Sprintf("Output format. One of: %s|%s|%s", formatText, formatJSON, formatSARIF),
+	)
+	cmd.Flags().BoolVarP(
+		&recursive,
+		"recursive",
+		"R",
+		false,
+		"Search directories recursively",
+	)
+	return cmd
+}
+
+func writeText(out io.Writer, findings []lint.Finding) error {
+	for _, f := range findings {
+		if _, err := fmt.Fprintf(
+			out,
+			"%s:%d: %s: %s [%s]\n",
+			f.File,
+			f.Line,
+			f.Severity,
+			describeFinding(f),
+			f.Rule,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describeFinding returns the Finding's message prefixed with the resource and
+// field it pertains to, if any.
+func describeFinding(f lint.Finding) string {
+	message := f.Message
+	if f.Field != "" {
+		message = fmt.Sprintf("%s: %s", f.Field, message)
+	}
+	if f.Name != "" {
+		name := f.Name
+		if f.Namespace != "" {
+			name = f.Namespace + "/" + name
+		}
+		message = fmt.Sprintf("%s %s: %s", f.Kind, name, message)
+	}
+	return message
+}
+
+func writeJSON(out io.Writer, findings []lint.Finding) error {
+	if findings == nil {
+		findings = []lint.Finding{}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Findings []lint.Finding `json:"findings"`
+	}{Findings: findings})
+}
+
+// The following types are the subset of the SARIF 2.1.0 format needed to
+// report Findings. See https://docs.oasis-open.org/sarif/sarif/v2.1.0/.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func writeSARIF(out io.Writer, findings []lint.Finding) error {
+	rules := make([]sarifRule, 0, len(lint.Rules))
+	for id, description := range lint.Rules {
+		rules = append(rules, sarifRule{
+			ID:               string(id),
+			ShortDescription: sarifMessage{Text: description},
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	results := make([]sarifResult, len(findings))
+	for i, f := range findings {
+		results[i] = sarifResult{
+			RuleID:  string(f.Rule),
+			Level:   string(f.Severity),
+			Message: sarifMessage{Text: describeFinding(f)},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{
+						URI: filepath.ToSlash(f.File),
+					},
+					Region: sarifRegion{StartLine: f.Line},
+				},
+			}},
+		}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           "kargo",
+					Version:        versionpkg.GetVersion().Version,
+					InformationURI: "https://kargo.akuity.io",
+					Rules:          rules,
+				},
+			},
+			Results: results,
+		}},
+	})
+}
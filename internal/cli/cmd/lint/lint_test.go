@@ -0,0 +1,71 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/lint"
+)
+
+var testFindings = []lint.Finding{
+	{
+		File:      "kargo/stages.yaml",
+		Line:      3,
+		Kind:      "Stage",
+		Namespace: "demo",
+		Name:      "test",
+		Field:     "spec.subscriptions.upstreamStages[0].name",
+		Rule:      lint.RuleSubscriptionCycle,
+		Severity:  lint.SeverityError,
+		Message:   "would create a cycle",
+	},
+	{
+		File:     "kargo/broken.yaml",
+		Line:     1,
+		Rule:     lint.RuleParse,
+		Severity: lint.SeverityError,
+		Message:  "error parsing Stage",
+	},
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeText(&buf, testFindings))
+	require.Equal(
+		t,
+		"kargo/stages.yaml:3: error: Stage demo/test: "+
+			"spec.subscriptions.upstreamStages[0].name: would create a cycle "+
+			"[subscription-cycle]\n"+
+			"kargo/broken.yaml:1: error: error parsing Stage [parse]\n",
+		buf.String(),
+	)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeJSON(&buf, nil))
+	require.JSONEq(t, `{"findings":[]}`, buf.String())
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeSARIF(&buf, testFindings))
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, len(lint.Rules))
+	require.Len(t, log.Runs[0].Results, 2)
+	result := log.Runs[0].Results[0]
+	require.Equal(t, "subscription-cycle", result.RuleID)
+	require.Equal(t, "error", result.Level)
+	require.Equal(
+		t,
+		"kargo/stages.yaml",
+		result.Locations[0].PhysicalLocation.ArtifactLocation.URI,
+	)
+	require.Equal(t, 3, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
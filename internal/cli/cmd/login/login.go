@@ -8,6 +8,7 @@ import (
 	"embed"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
@@ -36,15 +37,24 @@ const defaultRandStringCharSet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRST
 //go:embed assets
 var assets embed.FS
 
-func NewCommand(opt *option.Option) *cobra.Command {
-	var useAdmin, useKubeconfig, useSSO bool
+func NewCommand(cfg libConfig.CLIConfig, opt *option.Option) *cobra.Command {
+	var useAdmin, useDevice, useKubeconfig, useSSO bool
 	var password string
 	var callbackPort int
 	cmd := &cobra.Command{
-		Use:     "login server-address",
-		Args:    option.ExactArgs(1),
-		Short:   "Log in to a Kargo API server",
-		Example: "kargo login https://kargo.example.com --sso",
+		Use:   "login server-address",
+		Args:  option.ExactArgs(1),
+		Short: "Log in to a Kargo API server",
+		Example: `
+# Log in using the server's configured identity provider
+kargo login https://kargo.example.com --sso
+
+# Log in from a host without a browser, such as over SSH
+kargo login https://kargo.example.com --device
+
+# Log in and save the configuration as a context named "staging"
+kargo login https://kargo-staging.example.com --sso --context=staging
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
@@ -58,9 +68,13 @@ func NewCommand(opt *option.Option) *cobra.Command {
 			if useSSO {
 				flagCount++
 			}
+			if useDevice {
+				flagCount++
+			}
 			if flagCount != 1 {
 				return errors.Errorf(
-					"please specify exactly one of --admin, --kubeconfig, or --sso",
+					"please specify exactly one of --admin, --kubeconfig, --sso, " +
+						"or --device",
 				)
 			}
 
@@ -87,6 +101,15 @@ func NewCommand(opt *option.Option) *cobra.Command {
 				if bearerToken, err = kubeconfigLogin(ctx); err != nil {
 					return err
 				}
+			} else if useDevice {
+				if bearerToken, refreshToken, err = deviceLogin(
+					ctx,
+					serverAddress,
+					opt.InsecureTLS,
+					opt.IOStreams.Out,
+				); err != nil {
+					return err
+				}
 			} else {
 				if bearerToken, refreshToken, err =
 					ssoLogin(ctx, serverAddress, callbackPort, opt.InsecureTLS); err != nil {
@@ -103,14 +126,28 @@ func NewCommand(opt *option.Option) *cobra.Command {
 				refreshToken = ""
 			}
 
-			err = libConfig.SaveCLIConfig(
-				libConfig.CLIConfig{
+			// Unless told otherwise, name the context after the server it is used to
+			// communicate with. If a context with that name already exists, it is
+			// replaced, but its default project is retained.
+			contextName := opt.Context
+			if contextName == "" {
+				contextName = libConfig.ContextNameFromAPIAddress(serverAddress)
+			}
+			// If the context does not exist yet, there is no default project to
+			// retain
+			existingCtx, _ := cfg.GetContext(contextName)
+			cfg.SetContext(
+				libConfig.Context{
+					Name:                  contextName,
 					APIAddress:            serverAddress,
 					BearerToken:           bearerToken,
 					RefreshToken:          refreshToken,
 					InsecureSkipTLSVerify: opt.InsecureTLS,
+					Project:               existingCtx.Project,
 				},
 			)
+			cfg.CurrentContext = contextName
+			err = libConfig.SaveCLIConfig(cfg)
 			return errors.Wrap(err, "error persisting configuration")
 		},
 	}
@@ -119,8 +156,8 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		"admin",
 		"a",
 		false,
-		"Log in as the Kargo admin user; mutually exclusive with --kubeconfig and "+
-			"--sso",
+		"Log in as the Kargo admin user; mutually exclusive with --kubeconfig, "+
+			"--sso, and --device",
 	)
 	cmd.Flags().BoolVarP(
 		&useKubeconfig,
@@ -128,7 +165,7 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		"k",
 		false,
 		"Log in using a token obtained from the local Kubernetes configuration's "+
-			"current context; mutually exclusive with --admin and --sso",
+			"current context; mutually exclusive with --admin, --sso, and --device",
 	)
 	cmd.Flags().StringVarP(
 		&password,
@@ -152,7 +189,17 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		"s",
 		false,
 		"Log in using OpenID Connect and the server's configured identity "+
-			"provider; mutually exclusive with --admin and --kubeconfig",
+			"provider; mutually exclusive with --admin, --kubeconfig, and --device",
+	)
+	cmd.Flags().BoolVarP(
+		&useDevice,
+		"device",
+		"d",
+		false,
+		"Log in using OpenID Connect and the server's configured identity "+
+			"provider, completing authentication in a browser on any device; "+
+			"suitable for hosts without a browser; mutually exclusive with "+
+			"--admin, --kubeconfig, and --sso",
 	)
 	option.InsecureTLS(cmd.PersistentFlags(), opt)
 	return cmd
@@ -217,51 +264,9 @@ func ssoLogin(
 	callbackPort int,
 	insecureTLS bool,
 ) (string, string, error) {
-	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
-
-	res, err := kargoClient.GetPublicConfig(
-		ctx,
-		connect.NewRequest(&v1alpha1.GetPublicConfigRequest{}),
-	)
-	if err != nil {
-		return "", "", errors.Wrap(
-			err,
-			"error retrieving public configuration from server",
-		)
-	}
-
-	if res.Msg.OidcConfig == nil {
-		return "", "", errors.New("server does not support OpenID Connect")
-	}
-
-	scopes := res.Msg.OidcConfig.Scopes
-
-	ctx = oidc.ClientContext(
-		ctx,
-		&http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: insecureTLS, // nolint: gosec
-				},
-			},
-		},
-	)
-	provider, err := oidc.NewProvider(ctx, res.Msg.OidcConfig.IssuerUrl)
+	ctx, cfg, err := getOAuth2Config(ctx, serverAddress, insecureTLS)
 	if err != nil {
-		return "", "", errors.Wrap(err, "error initializing OIDC provider")
-	}
-
-	providerClaims := struct {
-		ScopesSupported []string `json:"scopes_supported"`
-	}{}
-	if err = provider.Claims(&providerClaims); err != nil {
-		return "", "", errors.Wrap(err, "error retrieving provider claims")
-	}
-	const offlineAccessScope = "offline_access"
-	// If the provider supports the "offline_access" scope, request it so that
-	// we can get a refresh token.
-	if slices.Contains(providerClaims.ScopesSupported, offlineAccessScope) {
-		scopes = append(scopes, offlineAccessScope)
+		return "", "", err
 	}
 
 	listener, err := net.Listen(
@@ -272,19 +277,10 @@ func ssoLogin(
 		return "", "", errors.Wrap(err, "error creating callback listener")
 	}
 
-	cfg := oauth2.Config{
-		ClientID: res.Msg.OidcConfig.ClientId,
-		Endpoint: provider.Endpoint(),
-		Scopes:   scopes,
-		RedirectURL: fmt.Sprintf(
-			"http://localhost:%s/auth/callback",
-			strings.Split(listener.Addr().String(), ":")[1],
-		),
-	}
-	if res.Msg.OidcConfig.CliClientId != "" {
-		// There is an OIDC client ID specifically meant for CLI use
-		cfg.ClientID = res.Msg.OidcConfig.CliClientId
-	}
+	cfg.RedirectURL = fmt.Sprintf(
+		"http://localhost:%s/auth/callback",
+		strings.Split(listener.Addr().String(), ":")[1],
+	)
 
 	// Per the spec, this must be guessable with probability <= 2^(-128). The
 	// following call generates one of 52^24 random strings, ~= 2^136
@@ -350,6 +346,157 @@ func ssoLogin(
 	return idToken, token.RefreshToken, nil
 }
 
+// deviceLogin performs a login using OpenID Connect. It first retrieves
+// non-sensitive configuration from the Kargo API server, then uses that
+// configuration to perform a device authorization grant with the identity
+// provider specified by the API server. Because the user completes
+// authentication in a browser that may be running on any device, this works
+// on hosts that have no browser of their own. Upon success, it returns the ID
+// token and refresh token.
+func deviceLogin(
+	ctx context.Context,
+	serverAddress string,
+	insecureTLS bool,
+	out io.Writer,
+) (string, string, error) {
+	ctx, cfg, err := getOAuth2Config(ctx, serverAddress, insecureTLS)
+	if err != nil {
+		return "", "", err
+	}
+	if cfg.Endpoint.DeviceAuthURL == "" {
+		return "", "", errors.New(
+			"identity provider does not support the device authorization grant",
+		)
+	}
+	return deviceAuthorize(ctx, cfg, out)
+}
+
+// deviceAuthorize carries out a device authorization grant using the provided
+// configuration. It instructs the user, via the provided io.Writer, where to
+// go and what code to enter, then polls the identity provider until the user
+// has completed authentication. Upon success, it returns the ID token and
+// refresh token.
+func deviceAuthorize(
+	ctx context.Context,
+	cfg *oauth2.Config,
+	out io.Writer,
+) (string, string, error) {
+	deviceAuth, err := cfg.DeviceAuth(ctx)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error requesting device authorization")
+	}
+
+	if deviceAuth.VerificationURIComplete != "" {
+		_, _ = fmt.Fprintf(
+			out,
+			"To log in, visit:\n\n  %s\n\nand confirm the code %s\n",
+			deviceAuth.VerificationURIComplete,
+			deviceAuth.UserCode,
+		)
+	} else {
+		_, _ = fmt.Fprintf(
+			out,
+			"To log in, visit:\n\n  %s\n\nand enter the code %s\n",
+			deviceAuth.VerificationURI,
+			deviceAuth.UserCode,
+		)
+	}
+
+	if deviceAuth.Expiry.IsZero() {
+		// The identity provider did not say when the device code expires, so we
+		// apply the same limit we use when waiting for the authorization code
+		// flow to complete.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+	}
+
+	token, err := cfg.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return "", "", errors.New(
+				"timed out waiting for user to complete authentication",
+			)
+		}
+		return "", "", errors.Wrap(err, "error retrieving token")
+	}
+
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return "", "", errors.New("no id_token in token response")
+	}
+
+	return idToken, token.RefreshToken, nil
+}
+
+// getOAuth2Config retrieves non-sensitive configuration from the Kargo API
+// server and uses it to discover the identity provider specified by the API
+// server. It returns an OAuth2 configuration for the CLI, along with a context
+// that must be used for all subsequent requests to the identity provider.
+func getOAuth2Config(
+	ctx context.Context,
+	serverAddress string,
+	insecureTLS bool,
+) (context.Context, *oauth2.Config, error) {
+	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
+
+	res, err := kargoClient.GetPublicConfig(
+		ctx,
+		connect.NewRequest(&v1alpha1.GetPublicConfigRequest{}),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(
+			err,
+			"error retrieving public configuration from server",
+		)
+	}
+
+	if res.Msg.OidcConfig == nil {
+		return nil, nil, errors.New("server does not support OpenID Connect")
+	}
+
+	scopes := res.Msg.OidcConfig.Scopes
+
+	ctx = oidc.ClientContext(
+		ctx,
+		&http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: insecureTLS, // nolint: gosec
+				},
+			},
+		},
+	)
+	provider, err := oidc.NewProvider(ctx, res.Msg.OidcConfig.IssuerUrl)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error initializing OIDC provider")
+	}
+
+	providerClaims := struct {
+		ScopesSupported []string `json:"scopes_supported"`
+	}{}
+	if err = provider.Claims(&providerClaims); err != nil {
+		return nil, nil, errors.Wrap(err, "error retrieving provider claims")
+	}
+	const offlineAccessScope = "offline_access"
+	// If the provider supports the "offline_access" scope, request it so that
+	// we can get a refresh token.
+	if slices.Contains(providerClaims.ScopesSupported, offlineAccessScope) {
+		scopes = append(scopes, offlineAccessScope)
+	}
+
+	cfg := &oauth2.Config{
+		ClientID: res.Msg.OidcConfig.ClientId,
+		Endpoint: provider.Endpoint(),
+		Scopes:   scopes,
+	}
+	if res.Msg.OidcConfig.CliClientId != "" {
+		// There is an OIDC client ID specifically meant for CLI use
+		cfg.ClientID = res.Msg.OidcConfig.CliClientId
+	}
+	return ctx, cfg, nil
+}
+
 // receiveAuthCode runs a web server that serves the callback endpoint for
 // receiving the authorization code at the end of an authorization code flow.
 // It returns the authorization code or any error that occurs via the provided
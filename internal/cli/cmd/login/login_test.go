@@ -1,17 +1,20 @@
 package login
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 func TestReceiveAuthCode(t *testing.T) {
@@ -51,6 +54,64 @@ func TestReceiveAuthCode(t *testing.T) {
 	}
 }
 
+func TestDeviceAuthorize(t *testing.T) {
+	const (
+		testDeviceCode = "fake-device-code"
+		testUserCode   = "FAKE-CODE"
+	)
+	var tokenRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "fake-client", r.PostForm.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(
+			w,
+			`{"device_code":%q,"user_code":%q,`+
+				`"verification_uri":"https://idp.example.com/device","interval":1}`,
+			testDeviceCode,
+			testUserCode,
+		)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, testDeviceCode, r.PostForm.Get("device_code"))
+		w.Header().Set("Content-Type", "application/json")
+		tokenRequests++
+		if tokenRequests == 1 {
+			// The user has not finished authenticating yet
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"authorization_pending"}`))
+			return
+		}
+		_, _ = w.Write([]byte(
+			`{"access_token":"fake-access-token","token_type":"Bearer",` +
+				`"refresh_token":"fake-refresh-token","id_token":"fake-id-token"}`,
+		))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	out := &bytes.Buffer{}
+	idToken, refreshToken, err := deviceAuthorize(
+		context.Background(),
+		&oauth2.Config{
+			ClientID: "fake-client",
+			Endpoint: oauth2.Endpoint{
+				DeviceAuthURL: srv.URL + "/device",
+				TokenURL:      srv.URL + "/token",
+			},
+		},
+		out,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-id-token", idToken)
+	require.Equal(t, "fake-refresh-token", refreshToken)
+	require.Equal(t, 2, tokenRequests)
+	require.Contains(t, out.String(), "https://idp.example.com/device")
+	require.Contains(t, out.String(), testUserCode)
+}
+
 func TestCreatePCKEVerifierAndChallenge(t *testing.T) {
 	codeVerifier, codeChallenge, err := createPCKEVerifierAndChallenge()
 	require.NoError(t, err)
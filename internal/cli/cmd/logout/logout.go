@@ -5,17 +5,38 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
 )
 
-func NewCommand() *cobra.Command {
+func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	return &cobra.Command{
-		Use:     "logout",
-		Short:   "Log out of the Kargo API server",
-		Example: "kargo logout",
+		Use:   "logout",
+		Short: "Log out of the Kargo API server",
+		Example: `
+# Log out of the Kargo API server of the current context
+kargo logout
+
+# Log out of the Kargo API server of the context named "staging"
+kargo logout --context=staging
+`,
 		RunE: func(*cobra.Command, []string) error {
+			contextName := opt.Context
+			if contextName == "" {
+				contextName = cfg.CurrentContext
+			}
+			if _, err := cfg.GetContext(contextName); err != nil {
+				return err
+			}
+			cfg.DeleteContext(contextName)
+			if len(cfg.Contexts) == 0 {
+				return errors.Wrap(
+					config.DeleteCLIConfig(),
+					"error deleting CLI configuration",
+				)
+			}
 			return errors.Wrap(
-				config.DeleteCLIConfig(),
-				"error deleting CLI configuration",
+				config.SaveCLIConfig(cfg),
+				"error persisting configuration",
 			)
 		},
 	}
@@ -0,0 +1,59 @@
+package project
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newExportCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	var includeFreight bool
+	var includeCredentials bool
+	cmd := &cobra.Command{
+		Use:   "export (PROJECT) [--include-freight] [--include-credentials]",
+		Short: "Export a project as a multi-document YAML manifest",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Export a project's warehouses and stages
+kargo project export my-project > my-project.yaml
+
+# Export a project, including its freight and references to its credentials
+kargo project export my-project --include-freight --include-credentials > my-project.yaml
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.ExportProject(ctx, connect.NewRequest(&v1alpha1.ExportProjectRequest{
+				Project:            project,
+				IncludeFreight:     includeFreight,
+				IncludeCredentials: includeCredentials,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "export project")
+			}
+			_, err = opt.IOStreams.Out.Write(res.Msg.GetManifest())
+			return errors.Wrap(err, "write manifest")
+		},
+	}
+	cmd.Flags().BoolVar(&includeFreight, "include-freight", false,
+		"Include freight, along with its approval and verification status")
+	cmd.Flags().BoolVar(&includeCredentials, "include-credentials", false,
+		"Include credential secrets, without their values")
+	return cmd
+}
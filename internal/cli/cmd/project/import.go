@@ -0,0 +1,76 @@
+package project
+
+import (
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newImportCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	var filenames []string
+	var name string
+	cmd := &cobra.Command{
+		Use:   "import -f (FILENAME) [--as=project]",
+		Short: "Import a project from a manifest created by kargo project export",
+		Example: `
+# Import a project
+kargo project import -f my-project.yaml
+
+# Import a project under a different name
+kargo project import -f my-project.yaml --as=my-project-copy
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if len(filenames) == 0 {
+				return errors.New("filename is required")
+			}
+
+			manifest, err := option.ReadManifests(filenames...)
+			if err != nil {
+				return errors.Wrap(err, "read manifests")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			res, err := kargoSvcCli.ImportProject(ctx, connect.NewRequest(&v1alpha1.ImportProjectRequest{
+				Manifest: manifest,
+				Project:  name,
+			}))
+			if err != nil {
+				return errors.Wrap(err, "import project")
+			}
+			printImportedResources(opt.IOStreams.Out, res.Msg)
+			return nil
+		},
+	}
+	option.Filenames(cmd.Flags(), &filenames, "import")
+	cmd.Flags().StringVar(&name, "as", "",
+		"Name of the project to import into, if different from the exported one")
+	return cmd
+}
+
+// printImportedResources prints one line per imported resource, in the order
+// in which the resources were applied.
+func printImportedResources(out io.Writer, res *v1alpha1.ImportProjectResponse) {
+	for _, r := range res.GetResources() {
+		name := r.GetName()
+		if r.GetKind() != "Project" {
+			name = types.NamespacedName{
+				Namespace: res.GetProject(),
+				Name:      r.GetName(),
+			}.String()
+		}
+		_, _ = fmt.Fprintf(out, "%s %s: %q\n", r.GetKind(), r.GetAction(), name)
+	}
+}
@@ -0,0 +1,30 @@
+package project
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPrintImportedResources(t *testing.T) {
+	var out bytes.Buffer
+	printImportedResources(&out, &v1alpha1.ImportProjectResponse{
+		Project: "kargo-copy",
+		Resources: []*v1alpha1.ImportedResource{
+			{Kind: "Project", Name: "kargo-copy", Action: "Created"},
+			{Kind: "Secret", Name: "repo", Action: "Unchanged"},
+			{Kind: "Stage", Name: "test", Action: "Updated"},
+		},
+	})
+	require.Equal(
+		t,
+		`Project Created: "kargo-copy"
+Secret Unchanged: "kargo-copy/repo"
+Stage Updated: "kargo-copy/test"
+`,
+		out.String(),
+	)
+}
@@ -0,0 +1,65 @@
+package promotion
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newAbortCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort --project=project (PROMOTION)",
+		Short: "Abort a pending or running promotion",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Abort a promotion for a specific project
+kargo promotion abort --project=my-project my-promotion
+
+# Abort a promotion for the default project
+kargo config set project my-project
+kargo promotion abort my-promotion
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			if _, err = kargoSvcCli.AbortPromotion(
+				ctx,
+				connect.NewRequest(&v1alpha1.AbortPromotionRequest{
+					Project: project,
+					Name:    name,
+				}),
+			); err != nil {
+				return errors.Wrap(err, "abort promotion")
+			}
+			fmt.Fprintf(opt.IOStreams.Out, "Promotion '%s/%s' abort requested\n", project, name)
+			return nil
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
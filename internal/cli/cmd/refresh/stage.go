@@ -25,7 +25,7 @@ func newRefreshStageCommand(
 		Example: "kargo refresh stage --project=guestbook (STAGE)",
 		RunE:    refreshObject(cfg, opt, "stage", wait),
 	}
-	option.Wait(cmd.Flags(), &wait)
+	option.Wait(cmd.Flags(), &wait, "refresh")
 	option.Project(cmd.Flags(), opt, opt.Project)
 	return cmd
 }
@@ -25,7 +25,7 @@ func newRefreshWarehouseCommand(
 		Example: "kargo warehouse refresh --project=guestbook (WAREHOUSE)",
 		RunE:    refreshObject(cfg, opt, "warehouse", wait),
 	}
-	option.Wait(cmd.Flags(), &wait)
+	option.Wait(cmd.Flags(), &wait, "refresh")
 	option.Project(cmd.Flags(), opt, opt.Project)
 	return cmd
 }
@@ -0,0 +1,135 @@
+package stage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newLockCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	var reason string
+	var duration time.Duration
+	cmd := &cobra.Command{
+		Use:   "lock --project=project (STAGE) [--reason=reason] [--duration=duration]",
+		Short: "Lock a stage on its current freight",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Lock a stage for a specific project
+kargo stage lock --project=my-project prod --reason="incident 123"
+
+# Lock a stage for two hours
+kargo stage lock --project=my-project prod --duration=2h
+
+# Lock a stage for the default project
+kargo config set project my-project
+kargo stage lock prod
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			if duration < 0 {
+				return errors.New("duration must not be negative")
+			}
+			var until *timestamppb.Timestamp
+			if duration > 0 {
+				until = timestamppb.New(time.Now().Add(duration))
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			if _, err = kargoSvcCli.LockStage(
+				ctx,
+				connect.NewRequest(&v1alpha1.LockStageRequest{
+					Project: project,
+					Name:    name,
+					Reason:  reason,
+					Until:   until,
+				}),
+			); err != nil {
+				return errors.Wrap(err, "lock stage")
+			}
+			fmt.Fprintf(opt.IOStreams.Out, "Stage '%s/%s' locked\n", project, name)
+			return nil
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for locking the stage")
+	cmd.Flags().DurationVar(&duration, "duration", 0,
+		"How long the stage should remain locked (e.g. 2h); locks indefinitely if unspecified")
+	return cmd
+}
+
+func newUnlockCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock --project=project (STAGE)",
+		Short: "Unlock a locked stage",
+		Args:  option.ExactArgs(1),
+		Example: `
+# Unlock a stage for a specific project
+kargo stage unlock --project=my-project prod
+
+# Unlock a stage for the default project
+kargo config set project my-project
+kargo stage unlock prod
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			if _, err = kargoSvcCli.UnlockStage(
+				ctx,
+				connect.NewRequest(&v1alpha1.UnlockStageRequest{
+					Project: project,
+					Name:    name,
+				}),
+			); err != nil {
+				return errors.Wrap(err, "unlock stage")
+			}
+			fmt.Fprintf(opt.IOStreams.Out, "Stage '%s/%s' unlocked\n", project, name)
+			return nil
+		},
+	}
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
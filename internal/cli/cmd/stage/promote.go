@@ -3,12 +3,14 @@ package stage
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/ptr"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -21,8 +23,11 @@ func newPromoteCommand(
 	opt *option.Option,
 ) *cobra.Command {
 	var freight string
+	var force bool
+	var wait bool
+	var timeout time.Duration
 	cmd := &cobra.Command{
-		Use:  "promote --project=project (STAGE) [(--freight=)freight-id]",
+		Use:  "promote --project=project (STAGE) [(--freight=)freight-id] [--wait [--timeout=timeout]]",
 		Args: option.ExactArgs(1),
 		Example: `
 # Promote a freight to a stage for a specific project
@@ -31,6 +36,15 @@ kargo stage promote dev --project=my-project --freight=abc123
 # Promote a freight to a stage for the default project
 kargo config set project my-project
 kargo stage promote dev --freight=abc123
+
+# Promote a freight to a locked stage
+kargo stage promote prod --project=my-project --freight=abc123 --force
+
+# Promote a freight to a stage and wait up to 10 minutes for it to be verified
+kargo stage promote dev --project=my-project --freight=abc123 --wait --timeout=10m
+
+# Promote a freight to a stage, wait and summarize the outcome as JSON
+kargo stage promote dev --project=my-project --freight=abc123 --wait -o json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -55,14 +69,35 @@ kargo stage promote dev --freight=abc123
 				return errors.New("freight is required")
 			}
 
+			if err = validateWaitFlags(opt, wait, timeout); err != nil {
+				return err
+			}
+
 			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(&v1alpha1.PromoteStageRequest{
 				Project: project,
 				Name:    stage,
 				Freight: freight,
+				Force:   force,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "promote stage")
 			}
+			promo := typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion())
+			if wait {
+				out := opt.IOStreams.Out
+				if ptr.Deref(opt.PrintFlags.OutputFormat, "") != "" {
+					out = opt.IOStreams.ErrOut
+				}
+				fmt.Fprintf(out, "Promotion Created: %q\n", promo.Name)
+				return waitForPromotions(
+					ctx,
+					kargoSvcCli,
+					opt,
+					project,
+					timeout,
+					[]*kargoapi.Promotion{promo},
+				)
+			}
 			if ptr.Deref(opt.PrintFlags.OutputFormat, "") == "" {
 				fmt.Fprintf(opt.IOStreams.Out,
 					"Promotion Created: %q\n", res.Msg.GetPromotion().GetMetadata().GetName())
@@ -72,13 +107,15 @@ kargo stage promote dev --freight=abc123
 			if err != nil {
 				return errors.Wrap(err, "new printer")
 			}
-			promo := typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion())
 			_ = printer.PrintObj(promo, opt.IOStreams.Out)
 			return nil
 		},
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(cmd.Flags(), &freight)
+	cmd.Flags().BoolVar(&force, "force", false, "Promote even if the stage is locked")
+	option.Wait(cmd.Flags(), &wait, "the promotion")
+	option.Timeout(cmd.Flags(), &timeout, "the promotion")
 	option.Project(cmd.Flags(), opt, opt.Project)
 	return cmd
 }
@@ -1,14 +1,17 @@
 package stage
 
 import (
+	goerrors "errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/ptr"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -21,8 +24,10 @@ func newPromoteSubscribersCommand(
 	opt *option.Option,
 ) *cobra.Command {
 	var freight string
+	var wait bool
+	var timeout time.Duration
 	cmd := &cobra.Command{
-		Use:  "promote-subscribers --project=project (STAGE) [(--freight=)freight-id]",
+		Use:  "promote-subscribers --project=project (STAGE) [(--freight=)freight-id] [--wait [--timeout=timeout]]",
 		Args: option.ExactArgs(1),
 		Example: `
 # Promote subscribers for a specific project
@@ -31,6 +36,9 @@ kargo stage promote-subscribers dev --project=my-project --freight=abc123
 # Promote subscribers for the default project
 kargo config set project my-project
 kargo stage promote-subscribers dev --freight=abc123
+
+# Promote subscribers and wait up to 10 minutes for all of them to be verified
+kargo stage promote-subscribers dev --project=my-project --freight=abc123 --wait --timeout=10m
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -54,11 +62,36 @@ kargo stage promote-subscribers dev --freight=abc123
 				return errors.New("freight is required")
 			}
 
+			if err = validateWaitFlags(opt, wait, timeout); err != nil {
+				return err
+			}
+
 			res, promoteErr := kargoSvcCli.PromoteSubscribers(ctx, connect.NewRequest(&v1alpha1.PromoteSubscribersRequest{
 				Project: project,
 				Stage:   stage,
 				Freight: freight,
 			}))
+			if wait {
+				out := opt.IOStreams.Out
+				if ptr.Deref(opt.PrintFlags.OutputFormat, "") != "" {
+					out = opt.IOStreams.ErrOut
+				}
+				var promos []*kargoapi.Promotion
+				if res != nil && res.Msg != nil {
+					for _, p := range res.Msg.GetPromotions() {
+						promo := typesv1alpha1.FromPromotionProto(p)
+						fmt.Fprintf(out, "Promotion Created: %q\n", promo.Name)
+						promos = append(promos, promo)
+					}
+				}
+				// Wait for whichever Promotions were created, even if creating
+				// others failed.
+				waitErr := waitForPromotions(ctx, kargoSvcCli, opt, project, timeout, promos)
+				if promoteErr != nil {
+					return goerrors.Join(errors.Wrap(promoteErr, "promote subscribers"), waitErr)
+				}
+				return waitErr
+			}
 			if ptr.Deref(opt.PrintFlags.OutputFormat, "") == "" {
 				if res != nil && res.Msg != nil {
 					for _, p := range res.Msg.GetPromotions() {
@@ -84,6 +117,8 @@ kargo stage promote-subscribers dev --freight=abc123
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(cmd.Flags(), &freight)
+	option.Wait(cmd.Flags(), &wait, "the promotions")
+	option.Timeout(cmd.Flags(), &timeout, "the promotions")
 	option.Project(cmd.Flags(), opt, opt.Project)
 	return cmd
 }
@@ -19,5 +19,7 @@ func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	cmd.AddCommand(newEnableAutoPromotion(cfg, opt))
 	cmd.AddCommand(newDisableAutoPromotion(cfg, opt))
 	cmd.AddCommand(newPromoteSubscribersCommand(cfg, opt))
+	cmd.AddCommand(newLockCommand(cfg, opt))
+	cmd.AddCommand(newUnlockCommand(cfg, opt))
 	return cmd
 }
@@ -0,0 +1,354 @@
+package stage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+// pullRequestURLMetadataKeyPrefix prefixes the keys of the PromotionStatus
+// metadata entries in which the URLs of pull requests opened by a Promotion are
+// recorded.
+const pullRequestURLMetadataKeyPrefix = "pr-url:"
+
+// promotionResult summarizes the outcome of waiting for a Promotion.
+type promotionResult struct {
+	Name            string              `json:"name"`
+	Stage           string              `json:"stage"`
+	Freight         string              `json:"freight"`
+	Phase           string              `json:"phase,omitempty"`
+	Message         string              `json:"message,omitempty"`
+	PullRequestURLs []string            `json:"pullRequestURLs,omitempty"`
+	Verification    *verificationResult `json:"verification,omitempty"`
+	TimedOut        bool                `json:"timedOut,omitempty"`
+	Error           string              `json:"error,omitempty"`
+}
+
+// verificationResult summarizes the outcome of the verification of the Freight
+// promoted to a Stage.
+type verificationResult struct {
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// succeeded returns true if the Promotion succeeded and the promoted Freight
+// was verified, if verification is configured for the Stage.
+func (r promotionResult) succeeded() bool {
+	return r.Phase == string(kargoapi.PromotionPhaseSucceeded) &&
+		!r.TimedOut &&
+		r.Error == "" &&
+		(r.Verification == nil ||
+			r.Verification.Phase == string(kargoapi.VerificationPhaseSuccessful))
+}
+
+// validateWaitFlags returns an error if the flags that control waiting for
+// Promotions are not used together, or if the requested output format cannot
+// be used to summarize the outcome of the Promotions.
+func validateWaitFlags(opt *option.Option, wait bool, timeout time.Duration) error {
+	if !wait {
+		if timeout != 0 {
+			return errors.New("--timeout may only be used with --wait")
+		}
+		return nil
+	}
+	if timeout < 0 {
+		return errors.New("--timeout must not be negative")
+	}
+	switch format := ptr.Deref(opt.PrintFlags.OutputFormat, ""); format {
+	case "", "json", "yaml":
+		return nil
+	default:
+		return errors.Errorf(
+			"output format %q is not supported with --wait; must be json or yaml",
+			format,
+		)
+	}
+}
+
+// waitForPromotions waits for each of the specified Promotions to reach a
+// terminal phase and, if it succeeded, for the promoted Freight to be verified.
+// Progress is reported as it happens. When an output format has been requested,
+// progress is reported to the error stream and a summary of the outcome is
+// written to the output stream in that format. An error is returned if any of
+// the Promotions did not succeed.
+func waitForPromotions(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	opt *option.Option,
+	project string,
+	timeout time.Duration,
+	promos []*kargoapi.Promotion,
+) error {
+	format := ptr.Deref(opt.PrintFlags.OutputFormat, "")
+	progress := opt.IOStreams.Out
+	if format != "" {
+		progress = opt.IOStreams.ErrOut
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	w := &promotionWaiter{
+		kargoSvcCli: kargoSvcCli,
+		project:     project,
+		out:         progress,
+	}
+	results := make([]promotionResult, len(promos))
+	var wg sync.WaitGroup
+	for i, promo := range promos {
+		results[i] = promotionResult{
+			Name:    promo.Name,
+			Stage:   promo.Spec.Stage,
+			Freight: promo.Spec.Freight,
+		}
+		wg.Add(1)
+		go func(result *promotionResult) {
+			defer wg.Done()
+			w.wait(ctx, result)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	var failed int
+	for _, result := range results {
+		if !result.succeeded() {
+			failed++
+		}
+	}
+	if err := writePromotionResults(opt.IOStreams.Out, format, results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d promotion(s) did not succeed", failed, len(results))
+	}
+	return nil
+}
+
+func writePromotionResults(
+	out io.Writer,
+	format string,
+	results []promotionResult,
+) error {
+	summary := struct {
+		Promotions []promotionResult `json:"promotions"`
+	}{Promotions: results}
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return errors.Wrap(enc.Encode(summary), "write summary")
+	case "yaml":
+		data, err := sigyaml.Marshal(summary)
+		if err != nil {
+			return errors.Wrap(err, "marshal summary")
+		}
+		_, err = out.Write(data)
+		return errors.Wrap(err, "write summary")
+	}
+	for _, result := range results {
+		outcome := result.Phase
+		switch {
+		case result.Error != "":
+			outcome = "Error: " + result.Error
+		case result.TimedOut:
+			outcome = fmt.Sprintf("Timed out (%s)", outcome)
+		case result.Verification != nil:
+			outcome = fmt.Sprintf("%s, verification %s", outcome, result.Verification.Phase)
+		}
+		if _, err := fmt.Fprintf(
+			out,
+			"Promotion %q to Stage %q: %s\n",
+			result.Name,
+			result.Stage,
+			outcome,
+		); err != nil {
+			return errors.Wrap(err, "write summary")
+		}
+	}
+	return nil
+}
+
+// promotionWaiter waits for Promotions and reports on their progress.
+// Promotions are waited for concurrently, so reports are serialized.
+type promotionWaiter struct {
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient
+	project     string
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *promotionWaiter) report(stage string, format string, args ...any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, _ = fmt.Fprintf(w.out, "[%s] %s\n", stage, fmt.Sprintf(format, args...))
+}
+
+// wait waits for the Promotion described by the result and records its outcome
+// in the result.
+func (w *promotionWaiter) wait(ctx context.Context, result *promotionResult) {
+	err := w.waitForPromotion(ctx, result)
+	if err == nil && result.Phase == string(kargoapi.PromotionPhaseSucceeded) {
+		err = w.waitForVerification(ctx, result)
+	}
+	if err != nil {
+		// The deadline is propagated to the server, which may hit it first.
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) ||
+			connect.CodeOf(err) == connect.CodeDeadlineExceeded {
+			result.TimedOut = true
+			w.report(result.Stage, "Timed out waiting for Promotion %q", result.Name)
+			return
+		}
+		result.Error = err.Error()
+		w.report(result.Stage, "Error waiting for Promotion %q: %s", result.Name, err)
+	}
+}
+
+func (w *promotionWaiter) waitForPromotion(
+	ctx context.Context,
+	result *promotionResult,
+) error {
+	// Canceling the context once done is what ends the watch.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	res, err := w.kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
+		Project: w.project,
+		Name:    result.Name,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "watch promotion")
+	}
+	reportedPRs := map[string]struct{}{}
+	for {
+		if !res.Receive() {
+			if err = res.Err(); err != nil {
+				return errors.Wrap(err, "watch promotion")
+			}
+			return errors.New("unexpected end of watch stream")
+		}
+		msg := res.Msg()
+		if msg == nil || msg.GetPromotion() == nil {
+			return errors.New("unexpected response")
+		}
+		promo := typesv1alpha1.FromPromotionProto(msg.GetPromotion())
+		if phase := string(promo.Status.Phase); phase != result.Phase && phase != "" {
+			result.Phase = phase
+			w.report(result.Stage, "Promotion %q is %s", result.Name, phase)
+		}
+		if promo.Status.Message != result.Message {
+			result.Message = promo.Status.Message
+			if result.Message != "" {
+				w.report(result.Stage, "Promotion %q: %s", result.Name, result.Message)
+			}
+		}
+		for _, url := range pullRequestURLs(promo.Status.Metadata) {
+			if _, ok := reportedPRs[url]; ok {
+				continue
+			}
+			reportedPRs[url] = struct{}{}
+			result.PullRequestURLs = append(result.PullRequestURLs, url)
+			w.report(result.Stage, "Promotion %q opened pull request %s", result.Name, url)
+		}
+		if promo.Status.Phase.IsTerminal() {
+			return nil
+		}
+	}
+}
+
+func (w *promotionWaiter) waitForVerification(
+	ctx context.Context,
+	result *promotionResult,
+) error {
+	// Canceling the context once done is what ends the watch.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	res, err := w.kargoSvcCli.WatchStages(ctx, connect.NewRequest(&v1alpha1.WatchStagesRequest{
+		Project: w.project,
+		Name:    result.Stage,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "watch stage")
+	}
+	// Whether the promoted Freight has been observed as the Stage's current
+	// Freight. The first states received may still predate the Promotion.
+	var seen bool
+	for {
+		if !res.Receive() {
+			if err = res.Err(); err != nil {
+				return errors.Wrap(err, "watch stage")
+			}
+			return errors.New("unexpected end of watch stream")
+		}
+		msg := res.Msg()
+		if msg == nil || msg.GetStage() == nil {
+			return errors.New("unexpected response")
+		}
+		stage := typesv1alpha1.FromStageProto(msg.GetStage())
+		if stage.Spec == nil || stage.Spec.Verification == nil {
+			return nil
+		}
+		current := stage.Status.CurrentFreight
+		if current == nil || !current.Includes(result.Freight) {
+			if seen {
+				return errors.Errorf(
+					"Stage %q moved on from Freight %q before its verification completed",
+					result.Stage,
+					result.Freight,
+				)
+			}
+			continue
+		}
+		seen = true
+		if current.VerificationInfo == nil {
+			continue
+		}
+		info := current.VerificationInfo
+		if result.Verification == nil ||
+			result.Verification.Phase != string(info.Phase) {
+			result.Verification = &verificationResult{
+				Phase:   string(info.Phase),
+				Message: info.Message,
+			}
+			w.report(result.Stage, "Verification is %s", info.Phase)
+			if info.Message != "" {
+				w.report(result.Stage, "Verification: %s", info.Message)
+			}
+		}
+		if info.Phase.IsTerminal() &&
+			stage.Status.Phase != kargoapi.StagePhaseVerifying {
+			return nil
+		}
+	}
+}
+
+// pullRequestURLs returns the URLs of the pull requests recorded in the
+// specified PromotionStatus metadata, in a stable order.
+func pullRequestURLs(metadata map[string]string) []string {
+	var urls []string
+	for k, v := range metadata {
+		if strings.HasPrefix(k, pullRequestURLMetadataKeyPrefix) && v != "" {
+			urls = append(urls, v)
+		}
+	}
+	sort.Strings(urls)
+	return urls
+}
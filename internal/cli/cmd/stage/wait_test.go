@@ -0,0 +1,287 @@
+package stage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+// fakeKargoService streams a fixed sequence of states for every watched
+// Promotion and Stage and then blocks until the watch is canceled.
+type fakeKargoService struct {
+	svcv1alpha1connect.UnimplementedKargoServiceHandler
+	promotionPhases []kargoapi.PromotionPhase
+	stages          []kargoapi.Stage
+}
+
+func (f *fakeKargoService) WatchPromotion(
+	ctx context.Context,
+	req *connect.Request[v1alpha1.WatchPromotionRequest],
+	stream *connect.ServerStream[v1alpha1.WatchPromotionResponse],
+) error {
+	for _, phase := range f.promotionPhases {
+		promo := kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: req.Msg.GetProject(),
+				Name:      req.Msg.GetName(),
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "dev",
+				Freight: "abc123",
+			},
+			Status: kargoapi.PromotionStatus{Phase: phase},
+		}
+		if phase == kargoapi.PromotionPhaseRunning {
+			promo.Status.Metadata = map[string]string{
+				"pr:https://github.com/x/y":     "42",
+				"pr-url:https://github.com/x/y": "https://github.com/x/y/pull/42",
+			}
+		}
+		if phase == kargoapi.PromotionPhaseFailed {
+			promo.Status.Message = "something went wrong"
+		}
+		if err := stream.Send(&v1alpha1.WatchPromotionResponse{
+			Promotion: typesv1alpha1.ToPromotionProto(promo),
+		}); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (f *fakeKargoService) WatchStages(
+	ctx context.Context,
+	_ *connect.Request[v1alpha1.WatchStagesRequest],
+	stream *connect.ServerStream[v1alpha1.WatchStagesResponse],
+) error {
+	for _, stage := range f.stages {
+		if err := stream.Send(&v1alpha1.WatchStagesResponse{
+			Stage: typesv1alpha1.ToStageProto(stage),
+		}); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newVerifyingStage(
+	freight string,
+	stagePhase kargoapi.StagePhase,
+	verificationPhase kargoapi.VerificationPhase,
+) kargoapi.Stage {
+	return kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "dev"},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{Warehouse: "fake-warehouse"},
+			Verification:  &kargoapi.Verification{},
+		},
+		Status: kargoapi.StageStatus{
+			Phase: stagePhase,
+			CurrentFreight: &kargoapi.FreightReference{
+				ID: freight,
+				VerificationInfo: &kargoapi.VerificationInfo{
+					Phase: verificationPhase,
+				},
+			},
+		},
+	}
+}
+
+func TestWaitForPromotions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		svc        *fakeKargoService
+		format     string
+		timeout    time.Duration
+		assertions func(t *testing.T, out, errOut string, err error)
+	}{
+		{
+			name: "promotion succeeds and freight is verified",
+			svc: &fakeKargoService{
+				promotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhasePending,
+					kargoapi.PromotionPhaseRunning,
+					kargoapi.PromotionPhaseSucceeded,
+				},
+				stages: []kargoapi.Stage{
+					// Verification info of the previously promoted Freight is ignored
+					newVerifyingStage(
+						"old",
+						kargoapi.StagePhaseSteady,
+						kargoapi.VerificationPhaseFailed,
+					),
+					newVerifyingStage(
+						"abc123",
+						kargoapi.StagePhaseVerifying,
+						kargoapi.VerificationPhaseRunning,
+					),
+					newVerifyingStage(
+						"abc123",
+						kargoapi.StagePhaseSteady,
+						kargoapi.VerificationPhaseSuccessful,
+					),
+				},
+			},
+			assertions: func(t *testing.T, out, _ string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`[dev] Promotion "fake-promotion" is Pending
+[dev] Promotion "fake-promotion" is Running
+[dev] Promotion "fake-promotion" opened pull request https://github.com/x/y/pull/42
+[dev] Promotion "fake-promotion" is Succeeded
+[dev] Verification is Running
+[dev] Verification is Successful
+Promotion "fake-promotion" to Stage "dev": Succeeded, verification Successful
+`,
+					out,
+				)
+			},
+		},
+		{
+			name: "stage moves on before verification completes",
+			svc: &fakeKargoService{
+				promotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhaseSucceeded,
+				},
+				stages: []kargoapi.Stage{
+					newVerifyingStage(
+						"abc123",
+						kargoapi.StagePhaseVerifying,
+						kargoapi.VerificationPhaseRunning,
+					),
+					newVerifyingStage(
+						"def456",
+						kargoapi.StagePhaseVerifying,
+						kargoapi.VerificationPhaseRunning,
+					),
+				},
+			},
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.EqualError(t, err, "1 of 1 promotion(s) did not succeed")
+				require.Contains(
+					t,
+					out,
+					`Stage "dev" moved on from Freight "abc123" before its verification completed`,
+				)
+			},
+		},
+		{
+			name: "promotion fails",
+			svc: &fakeKargoService{
+				promotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhaseRunning,
+					kargoapi.PromotionPhaseFailed,
+				},
+			},
+			format: "json",
+			assertions: func(t *testing.T, out, errOut string, err error) {
+				require.EqualError(t, err, "1 of 1 promotion(s) did not succeed")
+				require.Contains(t, errOut, "something went wrong")
+				var summary struct {
+					Promotions []promotionResult `json:"promotions"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(out), &summary))
+				require.Equal(
+					t,
+					[]promotionResult{{
+						Name:            "fake-promotion",
+						Stage:           "dev",
+						Freight:         "abc123",
+						Phase:           "Failed",
+						Message:         "something went wrong",
+						PullRequestURLs: []string{"https://github.com/x/y/pull/42"},
+					}},
+					summary.Promotions,
+				)
+			},
+		},
+		{
+			name: "timeout",
+			svc: &fakeKargoService{
+				promotionPhases: []kargoapi.PromotionPhase{
+					kargoapi.PromotionPhaseRunning,
+				},
+			},
+			timeout: 100 * time.Millisecond,
+			assertions: func(t *testing.T, out, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, out, "Timed out waiting for Promotion")
+				require.Contains(
+					t,
+					out,
+					`Promotion "fake-promotion" to Stage "dev": Timed out (Running)`,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.Handle(svcv1alpha1connect.NewKargoServiceHandler(testCase.svc))
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			var out, errOut bytes.Buffer
+			opt := &option.Option{
+				IOStreams: &genericclioptions.IOStreams{
+					Out:    &out,
+					ErrOut: &errOut,
+				},
+				PrintFlags: genericclioptions.NewPrintFlags(""),
+			}
+			opt.PrintFlags.OutputFormat = ptr.To(testCase.format)
+
+			err := waitForPromotions(
+				context.Background(),
+				svcv1alpha1connect.NewKargoServiceClient(srv.Client(), srv.URL),
+				opt,
+				"fake-project",
+				testCase.timeout,
+				[]*kargoapi.Promotion{{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-promotion",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "dev",
+						Freight: "abc123",
+					},
+				}},
+			)
+			testCase.assertions(t, out.String(), errOut.String(), err)
+		})
+	}
+}
+
+func TestValidateWaitFlags(t *testing.T) {
+	newOpt := func(format string) *option.Option {
+		opt := &option.Option{PrintFlags: genericclioptions.NewPrintFlags("")}
+		opt.PrintFlags.OutputFormat = ptr.To(format)
+		return opt
+	}
+	require.NoError(t, validateWaitFlags(newOpt(""), false, 0))
+	require.NoError(t, validateWaitFlags(newOpt("json"), true, time.Minute))
+	require.NoError(t, validateWaitFlags(newOpt("yaml"), true, 0))
+	require.Error(t, validateWaitFlags(newOpt(""), false, time.Minute))
+	require.Error(t, validateWaitFlags(newOpt(""), true, -time.Minute))
+	require.Error(t, validateWaitFlags(newOpt("name"), true, 0))
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -36,8 +37,21 @@ func init() {
 	}
 }
 
-// CLIConfig represents CLI configuration.
+// CLIConfig represents CLI configuration. Settings for each Kargo API server
+// the user has logged in to are kept in a separate, named Context.
 type CLIConfig struct {
+	// CurrentContext is the name of the Context used by all commands that are
+	// not explicitly directed to use another.
+	CurrentContext string `json:"currentContext,omitempty"`
+	// Contexts are the named Contexts available to commands.
+	Contexts []Context `json:"contexts,omitempty"`
+}
+
+// Context represents the settings for communicating with a single Kargo API
+// server.
+type Context struct {
+	// Name uniquely identifies the Context.
+	Name string `json:"name"`
 	// APIAddress is the address of the Kargo API server.
 	APIAddress string `json:"apiAddress,omitempty"`
 	// BearerToken is used to authenticate with the Kargo API server. This could
@@ -63,6 +77,96 @@ type CLIConfig struct {
 	Project string `json:"project,omitempty"`
 }
 
+// legacyCLIConfig represents CLI configuration as it was persisted before
+// support for multiple Contexts was introduced. It is used only to migrate
+// such configuration.
+type legacyCLIConfig struct {
+	APIAddress            string `json:"apiAddress,omitempty"`
+	BearerToken           string `json:"bearerToken,omitempty"`
+	RefreshToken          string `json:"refreshToken,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`
+	Project               string `json:"project,omitempty"`
+}
+
+// GetContext returns the Context with the specified name. If the name is
+// empty, the current Context is returned instead, or an empty Context if there
+// is no current Context. An error is returned if a Context was explicitly
+// requested by name and does not exist.
+func (c CLIConfig) GetContext(name string) (Context, error) {
+	if name == "" {
+		if c.CurrentContext == "" {
+			return Context{}, nil
+		}
+		name = c.CurrentContext
+	}
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx, nil
+		}
+	}
+	return Context{}, errors.Errorf("context %q not found", name)
+}
+
+// SetContext adds the provided Context to the configuration or, if a Context
+// with the same name already exists, replaces it.
+func (c *CLIConfig) SetContext(ctx Context) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == ctx.Name {
+			c.Contexts[i] = ctx
+			return
+		}
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+// RenameContext renames the Context with the specified name. If that Context
+// is the current Context, the current Context is updated accordingly.
+func (c *CLIConfig) RenameContext(oldName, newName string) error {
+	if newName == "" {
+		return errors.New("new context name must not be empty")
+	}
+	if oldName == newName {
+		return nil
+	}
+	if _, err := c.GetContext(newName); err == nil {
+		return errors.Errorf("context %q already exists", newName)
+	}
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == oldName {
+			c.Contexts[i].Name = newName
+			if c.CurrentContext == oldName {
+				c.CurrentContext = newName
+			}
+			return nil
+		}
+	}
+	return errors.Errorf("context %q not found", oldName)
+}
+
+// DeleteContext removes the Context with the specified name, if it exists. If
+// that Context is the current Context, there will no longer be a current
+// Context.
+func (c *CLIConfig) DeleteContext(name string) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			break
+		}
+	}
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+}
+
+// ContextNameFromAPIAddress derives a default name for a Context from the
+// address of the Kargo API server it is used to communicate with.
+func ContextNameFromAPIAddress(apiAddress string) string {
+	if u, err := url.Parse(apiAddress); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return apiAddress
+}
+
 // NewDefaultCLIConfig returns a new default CLI configuration.
 func NewDefaultCLIConfig() CLIConfig {
 	return CLIConfig{}
@@ -99,6 +203,29 @@ func loadCLIConfig(configPath string) (CLIConfig, error) {
 			configPath,
 		)
 	}
+	if len(cfg.Contexts) == 0 {
+		// This may be configuration persisted before support for multiple
+		// Contexts was introduced. If so, migrate it to a single Context.
+		var legacyCfg legacyCLIConfig
+		if err := yaml.Unmarshal(configBytes, &legacyCfg); err != nil {
+			return cfg, errors.Wrapf(
+				err,
+				"error parsing configuration file at %s",
+				configPath,
+			)
+		}
+		if legacyCfg.APIAddress != "" {
+			cfg.CurrentContext = ContextNameFromAPIAddress(legacyCfg.APIAddress)
+			cfg.Contexts = []Context{{
+				Name:                  cfg.CurrentContext,
+				APIAddress:            legacyCfg.APIAddress,
+				BearerToken:           legacyCfg.BearerToken,
+				RefreshToken:          legacyCfg.RefreshToken,
+				InsecureSkipTLSVerify: legacyCfg.InsecureSkipTLSVerify,
+				Project:               legacyCfg.Project,
+			}}
+		}
+	}
 	return cfg, nil
 }
 
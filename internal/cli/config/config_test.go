@@ -11,8 +11,12 @@ import (
 
 func TestLoadCLIConfig(t *testing.T) {
 	testConfig := CLIConfig{
-		APIAddress:  "http://localhost:8080",
-		BearerToken: "thisisafaketoken",
+		CurrentContext: "localhost:8080",
+		Contexts: []Context{{
+			Name:        "localhost:8080",
+			APIAddress:  "http://localhost:8080",
+			BearerToken: "thisisafaketoken",
+		}},
 	}
 	testCases := []struct {
 		name       string
@@ -57,6 +61,26 @@ func TestLoadCLIConfig(t *testing.T) {
 				require.Equal(t, testConfig, cfg)
 			},
 		},
+		{
+			name: "file exists and predates contexts",
+			setup: func() string {
+				configPath := getTestConfigPath()
+				err := os.WriteFile(
+					configPath,
+					[]byte(
+						"apiAddress: http://localhost:8080\n"+
+							"bearerToken: thisisafaketoken\n",
+					),
+					0600,
+				)
+				require.NoError(t, err)
+				return configPath
+			},
+			assertions: func(cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testConfig, cfg)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -68,8 +92,12 @@ func TestLoadCLIConfig(t *testing.T) {
 
 func TestSaveCLIConfig(t *testing.T) {
 	testConfig := CLIConfig{
-		APIAddress:  "http://localhost:8080",
-		BearerToken: "thisisafaketoken",
+		CurrentContext: "localhost:8080",
+		Contexts: []Context{{
+			Name:        "localhost:8080",
+			APIAddress:  "http://localhost:8080",
+			BearerToken: "thisisafaketoken",
+		}},
 	}
 
 	configPath := getTestConfigPath()
@@ -113,6 +141,141 @@ func TestDeleteCLIConfig(t *testing.T) {
 	}
 }
 
+func TestGetContext(t *testing.T) {
+	testConfig := CLIConfig{
+		CurrentContext: "staging",
+		Contexts: []Context{
+			{Name: "staging", APIAddress: "https://kargo-staging.example.com"},
+			{Name: "prod", APIAddress: "https://kargo.example.com"},
+		},
+	}
+	testCases := []struct {
+		name       string
+		cfg        CLIConfig
+		ctxName    string
+		assertions func(Context, error)
+	}{
+		{
+			name: "no current context",
+			assertions: func(ctx Context, err error) {
+				require.NoError(t, err)
+				require.Equal(t, Context{}, ctx)
+			},
+		},
+		{
+			name: "current context",
+			cfg:  testConfig,
+			assertions: func(ctx Context, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "staging", ctx.Name)
+			},
+		},
+		{
+			name:    "named context",
+			cfg:     testConfig,
+			ctxName: "prod",
+			assertions: func(ctx Context, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "prod", ctx.Name)
+			},
+		},
+		{
+			name:    "named context not found",
+			cfg:     testConfig,
+			ctxName: "nonexistent",
+			assertions: func(_ Context, err error) {
+				require.EqualError(t, err, `context "nonexistent" not found`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.cfg.GetContext(testCase.ctxName))
+		})
+	}
+}
+
+func TestSetContext(t *testing.T) {
+	cfg := CLIConfig{}
+	cfg.SetContext(Context{Name: "staging", Project: "foo"})
+	cfg.SetContext(Context{Name: "prod"})
+	cfg.SetContext(Context{Name: "staging", Project: "bar"})
+	require.Equal(
+		t,
+		[]Context{
+			{Name: "staging", Project: "bar"},
+			{Name: "prod"},
+		},
+		cfg.Contexts,
+	)
+}
+
+func TestRenameContext(t *testing.T) {
+	newConfig := func() CLIConfig {
+		return CLIConfig{
+			CurrentContext: "staging",
+			Contexts:       []Context{{Name: "staging"}, {Name: "prod"}},
+		}
+	}
+	testCases := []struct {
+		name       string
+		oldName    string
+		newName    string
+		assertions func(CLIConfig, error)
+	}{
+		{
+			name:    "new name empty",
+			oldName: "staging",
+			assertions: func(_ CLIConfig, err error) {
+				require.EqualError(t, err, "new context name must not be empty")
+			},
+		},
+		{
+			name:    "new name already in use",
+			oldName: "staging",
+			newName: "prod",
+			assertions: func(_ CLIConfig, err error) {
+				require.EqualError(t, err, `context "prod" already exists`)
+			},
+		},
+		{
+			name:    "context not found",
+			oldName: "nonexistent",
+			newName: "dev",
+			assertions: func(_ CLIConfig, err error) {
+				require.EqualError(t, err, `context "nonexistent" not found`)
+			},
+		},
+		{
+			name:    "success",
+			oldName: "staging",
+			newName: "dev",
+			assertions: func(cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "dev", cfg.CurrentContext)
+				require.Equal(t, []Context{{Name: "dev"}, {Name: "prod"}}, cfg.Contexts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := newConfig()
+			err := cfg.RenameContext(testCase.oldName, testCase.newName)
+			testCase.assertions(cfg, err)
+		})
+	}
+}
+
+func TestDeleteContext(t *testing.T) {
+	cfg := CLIConfig{
+		CurrentContext: "staging",
+		Contexts:       []Context{{Name: "staging"}, {Name: "prod"}},
+	}
+	cfg.DeleteContext("staging")
+	require.Empty(t, cfg.CurrentContext)
+	require.Equal(t, []Context{{Name: "prod"}}, cfg.Contexts)
+}
+
 func getTestConfigPath() string {
 	return filepath.Join(os.TempDir(), "config")
 }
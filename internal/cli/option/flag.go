@@ -2,6 +2,7 @@ package option
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -23,6 +24,15 @@ func ClientVersion(fs *pflag.FlagSet, opt *Option) {
 	fs.BoolVar(&opt.ClientVersionOnly, "client", false, "If true, shows client version only (no server required)")
 }
 
+func Context(fs *pflag.FlagSet, opt *Option) {
+	fs.StringVar(
+		&opt.Context,
+		"context",
+		"",
+		"Name of the CLI configuration context to use instead of the current context",
+	)
+}
+
 func Project(fs *pflag.FlagSet, opt *Option, defaultProject string) {
 	fs.StringVarP(&opt.Project, "project", "p", defaultProject, "Project")
 }
@@ -35,6 +45,26 @@ func Freight(fs *pflag.FlagSet, freight *string) {
 	fs.StringVar(freight, "freight", "", "Freight ID")
 }
 
-func Wait(fs *pflag.FlagSet, wait *bool) {
-	fs.BoolVar(wait, "wait", false, "Wait until refresh completes")
+func Wait(fs *pflag.FlagSet, wait *bool, operation string) {
+	fs.BoolVar(wait, "wait", false, fmt.Sprintf("Wait until %s completes", operation))
+}
+
+func Timeout(fs *pflag.FlagSet, timeout *time.Duration, operation string) {
+	fs.DurationVar(timeout, "timeout", 0,
+		fmt.Sprintf("Maximum time to wait for %s to complete; if zero, wait indefinitely", operation))
+}
+
+func ServiceAccount(fs *pflag.FlagSet, serviceAccount *string) {
+	fs.StringVar(serviceAccount, "service-account", "", "Service account backing a robot account")
+}
+
+func Selector(fs *pflag.FlagSet, selector *string) {
+	fs.StringVarP(selector, "selector", "l", "",
+		"Label selector to filter on, supports '=', '==', '!=', 'in' and 'notin' "+
+			"(e.g. -l key1=value1,key2=value2)")
+}
+
+func Limit(fs *pflag.FlagSet, limit *int32) {
+	fs.Int32Var(limit, "limit", 0,
+		"Maximum number of results to return; if zero, all results are returned")
 }
@@ -20,6 +20,9 @@ type Option struct {
 
 	ClientVersionOnly bool
 
+	// Context is the name of the CLI configuration context to use instead of
+	// the current context.
+	Context string
 	Project string
 
 	IOStreams  *genericclioptions.IOStreams
@@ -27,8 +30,10 @@ type Option struct {
 }
 
 func NewOption(cfg config.CLIConfig) *Option {
+	// If the current context cannot be found, there is simply no default Project
+	currentCtx, _ := cfg.GetContext("")
 	return &Option{
-		Project: cfg.Project,
+		Project: currentCtx.Project,
 	}
 }
 
@@ -55,8 +55,9 @@ type ApplicationSourceKustomize struct {
 }
 
 type ApplicationStatus struct {
-	Health HealthStatus `json:"health,omitempty"`
-	Sync   SyncStatus   `json:"sync,omitempty"`
+	Health         HealthStatus    `json:"health,omitempty"`
+	Sync           SyncStatus      `json:"sync,omitempty"`
+	OperationState *OperationState `json:"operationState,omitempty"`
 }
 
 type OperationInitiator struct {
@@ -72,8 +73,49 @@ type Operation struct {
 }
 
 type SyncOperation struct {
-	SyncOptions SyncOptions `json:"syncOptions,omitempty"`
-	Revisions   []string    `json:"revisions,omitempty"`
+	Prune        bool                    `json:"prune,omitempty"`
+	SyncStrategy *SyncStrategy           `json:"syncStrategy,omitempty"`
+	Resources    []SyncOperationResource `json:"resources,omitempty"`
+	SyncOptions  SyncOptions             `json:"syncOptions,omitempty"`
+	Revisions    []string                `json:"revisions,omitempty"`
+}
+
+type SyncStrategy struct {
+	Apply *SyncStrategyApply `json:"apply,omitempty"`
+	Hook  *SyncStrategyHook  `json:"hook,omitempty"`
+}
+
+type SyncStrategyApply struct {
+	Force bool `json:"force,omitempty"`
+}
+
+type SyncStrategyHook struct {
+	SyncStrategyApply `json:",inline"`
+}
+
+type SyncOperationResource struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type OperationPhase string
+
+const (
+	OperationRunning     OperationPhase = "Running"
+	OperationTerminating OperationPhase = "Terminating"
+	OperationFailed      OperationPhase = "Failed"
+	OperationError       OperationPhase = "Error"
+	OperationSucceeded   OperationPhase = "Succeeded"
+)
+
+type OperationState struct {
+	Operation  Operation      `json:"operation"`
+	Phase      OperationPhase `json:"phase"`
+	Message    string         `json:"message,omitempty"`
+	StartedAt  metav1.Time    `json:"startedAt"`
+	FinishedAt *metav1.Time   `json:"finishedAt,omitempty"`
 }
 
 type Info struct {
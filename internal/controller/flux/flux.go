@@ -0,0 +1,182 @@
+package flux
+
+// This package provides just enough support for Flux resources to permit Kargo
+// to update them and assess their health without having to incur undesired
+// dependencies on Flux's own API packages. Flux resources are manipulated as
+// unstructured objects.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// AnnotationKeyReconcileRequestedAt is the key of an annotation that, when
+	// its value is changed, prompts Flux to reconcile a resource immediately.
+	AnnotationKeyReconcileRequestedAt = "reconcile.fluxcd.io/requestedAt"
+
+	// ConditionTypeReady is the type of the condition Flux uses to summarize the
+	// readiness of a resource.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeReconciling is the type of the condition Flux uses to
+	// indicate that a resource is being reconciled.
+	ConditionTypeReconciling = "Reconciling"
+)
+
+var gvks = map[kargoapi.FluxResourceKind]schema.GroupVersionKind{
+	kargoapi.FluxResourceKindHelmRelease: {
+		Group:   "helm.toolkit.fluxcd.io",
+		Version: "v2beta2",
+		Kind:    "HelmRelease",
+	},
+	kargoapi.FluxResourceKindOCIRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1beta2",
+		Kind:    "OCIRepository",
+	},
+	kargoapi.FluxResourceKindGitRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    "GitRepository",
+	},
+	kargoapi.FluxResourceKindKustomization: {
+		Group:   "kustomize.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    "Kustomization",
+	},
+}
+
+// GroupVersionKindFor returns the GroupVersionKind of the Flux resource of the
+// specified kind. An error is returned if the kind is not supported.
+func GroupVersionKindFor(
+	kind kargoapi.FluxResourceKind,
+) (schema.GroupVersionKind, error) {
+	gvk, ok := gvks[kind]
+	if !ok {
+		return schema.GroupVersionKind{},
+			errors.Errorf("unsupported Flux resource kind %q", kind)
+	}
+	return gvk, nil
+}
+
+// GetResource returns a pointer to the Flux resource of the specified kind,
+// namespace, and name. If no such resource is found, nil is returned instead.
+func GetResource(
+	ctx context.Context,
+	ctrlRuntimeClient client.Client,
+	kind kargoapi.FluxResourceKind,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	gvk, err := GroupVersionKindFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err = ctrlRuntimeClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      name,
+		},
+		obj,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(
+			err,
+			"error getting Flux %s %q in namespace %q",
+			kind,
+			name,
+			namespace,
+		)
+	}
+	return obj, nil
+}
+
+// GetCondition returns the condition of the specified type from the status of
+// the provided Flux resource. If no such condition is found, nil is returned
+// instead.
+func GetCondition(
+	obj *unstructured.Unstructured,
+	conditionType string,
+) *metav1.Condition {
+	conditions, _, _ :=
+		unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		var condition metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			m,
+			&condition,
+		); err != nil {
+			continue
+		}
+		if condition.Type == conditionType {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// GetObservedGeneration returns the generation of the provided Flux resource
+// that was most recently observed by Flux.
+func GetObservedGeneration(obj *unstructured.Unstructured) int64 {
+	generation, _, _ :=
+		unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	return generation
+}
+
+// GetObservedRevision returns the revision most recently observed by Flux for
+// the provided resource. For HelmReleases, this is the last attempted chart
+// version. For sources, this is the revision of the current artifact. For
+// Kustomizations, this is the last applied source revision.
+func GetObservedRevision(
+	kind kargoapi.FluxResourceKind,
+	obj *unstructured.Unstructured,
+) string {
+	var fields []string
+	switch kind {
+	case kargoapi.FluxResourceKindHelmRelease:
+		fields = []string{"status", "lastAttemptedRevision"}
+	case kargoapi.FluxResourceKindOCIRepository,
+		kargoapi.FluxResourceKindGitRepository:
+		fields = []string{"status", "artifact", "revision"}
+	case kargoapi.FluxResourceKindKustomization:
+		fields = []string{"status", "lastAppliedRevision"}
+	default:
+		return ""
+	}
+	revision, _, _ := unstructured.NestedString(obj.Object, fields...)
+	return revision
+}
+
+// RevisionMatches answers whether a revision observed by Flux corresponds to
+// the desired revision. Flux decorates revisions in a variety of ways, e.g.
+// "main@sha1:<commit>" for Git repositories, "<tag>@sha256:<digest>" for OCI
+// artifacts, or "<version>+<digest>" for charts from OCI repositories, so an
+// exact match is not required.
+func RevisionMatches(observed, desired string) bool {
+	if desired == "" {
+		return true
+	}
+	return observed == desired ||
+		strings.HasPrefix(observed, desired+"@") ||
+		strings.HasPrefix(observed, desired+"+") ||
+		strings.HasSuffix(observed, ":"+desired) ||
+		strings.HasSuffix(observed, "@"+desired)
+}
@@ -0,0 +1,181 @@
+package flux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetResource(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(gvks[kargoapi.FluxResourceKindGitRepository])
+	existing.SetNamespace("fake-namespace")
+	existing.SetName("fake-repo")
+	c := fake.NewClientBuilder().WithObjects(existing).Build()
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := GetResource(
+			context.Background(),
+			c,
+			"Bogus",
+			"fake-namespace",
+			"fake-repo",
+		)
+		require.ErrorContains(t, err, "unsupported Flux resource kind")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		obj, err := GetResource(
+			context.Background(),
+			c,
+			kargoapi.FluxResourceKindGitRepository,
+			"fake-namespace",
+			"nonexistent",
+		)
+		require.NoError(t, err)
+		require.Nil(t, obj)
+	})
+
+	t.Run("found", func(t *testing.T) {
+		obj, err := GetResource(
+			context.Background(),
+			c,
+			kargoapi.FluxResourceKindGitRepository,
+			"fake-namespace",
+			"fake-repo",
+		)
+		require.NoError(t, err)
+		require.NotNil(t, obj)
+		require.Equal(t, "fake-repo", obj.GetName())
+	})
+}
+
+func TestGetCondition(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{
+						"type":    "Reconciling",
+						"status":  "True",
+						"reason":  "Progressing",
+						"message": "reconciliation in progress",
+					},
+					map[string]any{
+						"type":    "Ready",
+						"status":  "False",
+						"reason":  "InstallFailed",
+						"message": "install failed",
+					},
+				},
+			},
+		},
+	}
+	ready := GetCondition(obj, ConditionTypeReady)
+	require.NotNil(t, ready)
+	require.Equal(t, metav1.ConditionFalse, ready.Status)
+	require.Equal(t, "InstallFailed", ready.Reason)
+	require.Equal(t, "install failed", ready.Message)
+	require.Nil(t, GetCondition(obj, "Stalled"))
+	require.Nil(t, GetCondition(&unstructured.Unstructured{}, ConditionTypeReady))
+}
+
+func TestGetObservedRevision(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"status": map[string]any{
+				"lastAttemptedRevision": "1.2.3",
+				"lastAppliedRevision":   "main@sha1:abc",
+				"artifact": map[string]any{
+					"revision": "v1.0.0@sha256:def",
+				},
+			},
+		},
+	}
+	require.Equal(
+		t,
+		"1.2.3",
+		GetObservedRevision(kargoapi.FluxResourceKindHelmRelease, obj),
+	)
+	require.Equal(
+		t,
+		"v1.0.0@sha256:def",
+		GetObservedRevision(kargoapi.FluxResourceKindOCIRepository, obj),
+	)
+	require.Equal(
+		t,
+		"v1.0.0@sha256:def",
+		GetObservedRevision(kargoapi.FluxResourceKindGitRepository, obj),
+	)
+	require.Equal(
+		t,
+		"main@sha1:abc",
+		GetObservedRevision(kargoapi.FluxResourceKindKustomization, obj),
+	)
+}
+
+func TestRevisionMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		observed string
+		desired  string
+		expected bool
+	}{
+		{
+			name:     "no desired revision",
+			observed: "anything",
+			expected: true,
+		},
+		{
+			name:     "exact match",
+			observed: "1.2.3",
+			desired:  "1.2.3",
+			expected: true,
+		},
+		{
+			name:     "git commit",
+			observed: "main@sha1:abc",
+			desired:  "abc",
+			expected: true,
+		},
+		{
+			name:     "oci tag",
+			observed: "v1.0.0@sha256:def",
+			desired:  "v1.0.0",
+			expected: true,
+		},
+		{
+			name:     "oci digest",
+			observed: "v1.0.0@sha256:def",
+			desired:  "sha256:def",
+			expected: true,
+		},
+		{
+			name:     "oci chart version",
+			observed: "1.2.3+def",
+			desired:  "1.2.3",
+			expected: true,
+		},
+		{
+			name:     "mismatch",
+			observed: "1.2.30",
+			desired:  "1.2.3",
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				RevisionMatches(testCase.observed, testCase.desired),
+			)
+		})
+	}
+}
@@ -15,6 +15,11 @@ import (
 	libExec "github.com/akuity/kargo/internal/exec"
 )
 
+// ErrPushRejected is returned by Push when the remote repository rejects the
+// push because the remote branch has moved and the push would not have been a
+// fast-forward.
+var ErrPushRejected = errors.New("push rejected by remote repository")
+
 // RepoCredentials represents the credentials for connecting to a private git
 // repository.
 type RepoCredentials struct {
@@ -78,8 +83,19 @@ type Repo interface {
 	// CommitMessages returns a slice of commit messages starting with id1 and
 	// ending with id2. The results exclude id1, but include id2.
 	CommitMessages(id1, id2 string) ([]string, error)
+	// CommitLog returns a slice of entries describing the commits starting with
+	// id1 and ending with id2, most recent first. The results exclude id1, but
+	// include id2.
+	CommitLog(id1, id2 string) ([]CommitLogEntry, error)
 	// Push pushes from the current branch to a remote branch by the same name.
+	// If the remote branch has moved on and the push is rejected for that
+	// reason, the returned error wraps ErrPushRejected.
 	Push(force bool) error
+	// PullRebase fetches the remote branch by the same name as the current
+	// branch and rebases any local commits onto it. If the rebase cannot be
+	// completed because of conflicts, it is aborted, the current branch is left
+	// as it was, and an error is returned.
+	PullRebase() error
 	// RefsHaveDiffs returns whether there is a diff between two commits/branches
 	RefsHaveDiffs(commit1 string, commit2 string) (bool, error)
 	// RemoteBranchExists returns a bool indicating if the specified branch exists
@@ -96,6 +112,16 @@ type Repo interface {
 	HomeDir() string
 }
 
+// CommitLogEntry describes a single commit in a git repository.
+type CommitLogEntry struct {
+	// ID is the ID (sha) of the commit.
+	ID string
+	// Author is the name of the commit's author.
+	Author string
+	// Subject is the first line of the commit message.
+	Subject string
+}
+
 // repo is an implementation of the Repo interface for interacting with a git
 // repository.
 type repo struct {
@@ -153,6 +179,36 @@ func Clone(
 	return r, r.clone(opts)
 }
 
+// CloneHistory produces a bare, partial clone of the remote git repository at
+// the specified URL containing only the specified commits and their ancestors.
+// No trees or blobs are fetched, so the returned implementation of the Repo
+// interface is only suitable for inspecting commit history, e.g. by calling
+// CommitLog. Like Clone, it is stateful and NOT suitable for use across
+// multiple goroutines.
+func CloneHistory(
+	repoURL string,
+	repoCreds RepoCredentials,
+	commitIDs ...string,
+) (Repo, error) {
+	homeDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error creating home directory for repo %q",
+			repoURL,
+		)
+	}
+	r := &repo{
+		url:     repoURL,
+		homeDir: homeDir,
+		dir:     filepath.Join(homeDir, "repo"),
+	}
+	if err = r.setupAuth(repoCreds); err != nil {
+		return nil, err
+	}
+	return r, r.fetchHistory(commitIDs)
+}
+
 func (r *repo) AddAll() error {
 	_, err := libExec.Exec(r.buildCommand("add", "."))
 	return errors.Wrap(err, "error staging changes for commit")
@@ -210,6 +266,32 @@ func (r *repo) clone(opts *CloneOptions) error {
 	return nil
 }
 
+func (r *repo) fetchHistory(commitIDs []string) error {
+	cmd := r.buildCommand("init", "--bare", r.dir)
+	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+	if _, err := libExec.Exec(cmd); err != nil {
+		return errors.Wrapf(err, "error initializing bare repo in %q", r.dir)
+	}
+	args := []string{
+		"fetch",
+		"--no-tags",
+		// Commit history is all we need, so we skip trees and blobs. Servers that
+		// do not support partial clones will simply ignore this.
+		"--filter=tree:0",
+		r.url,
+	}
+	args = append(args, commitIDs...)
+	if _, err := libExec.Exec(r.buildCommand(args...)); err != nil {
+		return errors.Wrapf(
+			err,
+			"error fetching commits %q from repo %q",
+			commitIDs,
+			r.url,
+		)
+	}
+	return nil
+}
+
 func (r *repo) Close() error {
 	return os.RemoveAll(r.homeDir)
 }
@@ -411,15 +493,81 @@ func (r *repo) CommitMessages(id1, id2 string) ([]string, error) {
 	return msgs, nil
 }
 
+func (r *repo) CommitLog(id1, id2 string) ([]CommitLogEntry, error) {
+	logBytes, err := libExec.Exec(r.buildCommand(
+		"log",
+		// Fields are delimited with the ASCII unit separator, which is
+		// vanishingly unlikely to occur in an author's name or a commit subject.
+		"--pretty=format:%H%x1f%an%x1f%s",
+		fmt.Sprintf("%s..%s", id1, id2),
+	))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining commit log between commits %q and %q",
+			id1,
+			id2,
+		)
+	}
+	entries := []CommitLogEntry{}
+	for _, line := range strings.Split(string(logBytes), "\n") {
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		entries = append(entries, CommitLogEntry{
+			ID:      fields[0],
+			Author:  fields[1],
+			Subject: fields[2],
+		})
+	}
+	return entries, nil
+}
+
 func (r *repo) Push(force bool) error {
 	args := []string{"push", "origin", r.currentBranch}
 	if force {
 		args = append(args, "--force")
 	}
-	_, err := libExec.Exec(r.buildCommand(args...))
+	res, err := libExec.Exec(r.buildCommand(args...))
+	if _, ok := err.(*libExec.ExitError); ok && isPushRejection(res) {
+		// Keep git's own output, which explains why the push was rejected
+		return errors.Wrapf(
+			ErrPushRejected,
+			"error pushing branch %q: %s",
+			r.currentBranch,
+			err,
+		)
+	}
 	return errors.Wrapf(err, "error pushing branch %q", r.currentBranch)
 }
 
+// isPushRejection returns a bool indicating whether the provided output of
+// git push indicates that the push was rejected because the remote branch
+// contains commits that the local branch does not.
+func isPushRejection(output []byte) bool {
+	return bytes.Contains(output, []byte("[rejected]"))
+}
+
+func (r *repo) PullRebase() error {
+	if _, err := libExec.Exec(r.buildCommand(
+		"pull",
+		"--rebase",
+		"origin",
+		r.currentBranch,
+	)); err != nil {
+		// Leave things as they were before the attempt. This is a no-op if the
+		// rebase never started, so its own result is deliberately ignored.
+		_, _ = libExec.Exec(r.buildCommand("rebase", "--abort"))
+		return errors.Wrapf(
+			err,
+			"error rebasing branch %q onto remote branch",
+			r.currentBranch,
+		)
+	}
+	return nil
+}
+
 func (r *repo) RemoteBranchExists(branch string) (bool, error) {
 	_, err := libExec.Exec(r.buildCommand(
 		"ls-remote",
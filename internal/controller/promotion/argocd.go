@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/gobwas/glob"
@@ -17,7 +18,19 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
-const authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+const (
+	authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+
+	// argoCDAppUpdateInitiated is the value recorded in a Promotion's status
+	// metadata for each Argo CD Application that has already been updated and
+	// had a sync operation initiated on the Promotion's behalf.
+	argoCDAppUpdateInitiated = "initiated"
+
+	// argoCDOperationInfoPromotion is the name of the Info entry, added to every
+	// sync operation Kargo initiates, whose value is the name of the Promotion
+	// on whose behalf the operation was initiated.
+	argoCDOperationInfoPromotion = "Promotion"
+)
 
 // argoCDMechanism is an implementation of the Mechanism interface that updates
 // Argo CD Application resources.
@@ -27,9 +40,15 @@ type argoCDMechanism struct {
 	doSingleUpdateFn func(
 		ctx context.Context,
 		stageMeta metav1.ObjectMeta,
+		promoName string,
 		update kargoapi.ArgoCDAppUpdate,
 		newFreight kargoapi.FreightReference,
 	) error
+	getSyncOperationPhaseFn func(
+		ctx context.Context,
+		promoName string,
+		update kargoapi.ArgoCDAppUpdate,
+	) (kargoapi.PromotionPhase, string, error)
 	getArgoCDAppFn func(
 		ctx context.Context,
 		namespace string,
@@ -55,6 +74,7 @@ func newArgoCDMechanism(argocdClient client.Client) Mechanism {
 		argocdClient: argocdClient,
 	}
 	a.doSingleUpdateFn = a.doSingleUpdate
+	a.getSyncOperationPhaseFn = a.getSyncOperationPhase
 	a.getArgoCDAppFn = getApplicationFn(argocdClient)
 	a.applyArgoCDSourceUpdateFn = applyArgoCDSourceUpdate
 	if argocdClient != nil {
@@ -92,25 +112,55 @@ func (a *argoCDMechanism) Promote(
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debug("executing Argo CD-based promotion mechanisms")
 
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+	// Any message left over from a previous reconciliation of the Promotion is
+	// no longer relevant.
+	newStatus.Message = ""
 	for _, update := range updates {
-		if err := a.doSingleUpdateFn(
-			ctx,
-			stage.ObjectMeta,
-			update,
-			newFreight,
-		); err != nil {
-			return nil, newFreight, err
+		updateStatus := kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhaseSucceeded,
+		}
+		if !isArgoCDAppUpdateInitiated(promo.Status.Metadata, update) {
+			if err := a.doSingleUpdateFn(
+				ctx,
+				stage.ObjectMeta,
+				promo.Name,
+				update,
+				newFreight,
+			); err != nil {
+				return nil, newFreight, err
+			}
+			updateStatus.Metadata = setArgoCDAppUpdateMetadata(nil, update)
+			if update.SyncOperation != nil {
+				// The sync operation was only just initiated, so there is no point in
+				// checking on it yet.
+				updateStatus.Phase = kargoapi.PromotionPhaseRunning
+				updateStatus.Message = fmt.Sprintf(
+					"waiting for sync operation of Argo CD Application %q in "+
+						"namespace %q to complete",
+					update.AppName,
+					update.AppNamespaceOrDefault(),
+				)
+			}
+		} else if update.SyncOperation != nil {
+			var err error
+			if updateStatus.Phase, updateStatus.Message, err =
+				a.getSyncOperationPhaseFn(ctx, promo.Name, update); err != nil {
+				return nil, newFreight, err
+			}
 		}
+		newStatus = aggregateGitPromoStatus(newStatus, updateStatus)
 	}
 
 	logger.Debug("done executing Argo CD-based promotion mechanisms")
 
-	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	return newStatus, newFreight, nil
 }
 
 func (a *argoCDMechanism) doSingleUpdate(
 	ctx context.Context,
 	stageMeta metav1.ObjectMeta,
+	promoName string,
 	update kargoapi.ArgoCDAppUpdate,
 	newFreight kargoapi.FreightReference,
 ) error {
@@ -181,9 +231,13 @@ func (a *argoCDMechanism) doSingleUpdate(
 				Name:  "Reason",
 				Value: "Promotion triggered a sync of this Application resource.",
 			},
+			{
+				Name:  argoCDOperationInfoPromotion,
+				Value: promoName,
+			},
 		},
 		Sync: &argocd.SyncOperation{
-			Revisions: []string{},
+			Revisions: getArgoCDAppRevisions(app),
 		},
 	}
 	if app.Spec.SyncPolicy != nil {
@@ -194,13 +248,7 @@ func (a *argoCDMechanism) doSingleUpdate(
 			app.Operation.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
 		}
 	}
-	if app.Spec.Source != nil {
-		app.Operation.Sync.Revisions = []string{app.Spec.Source.TargetRevision}
-	}
-	for _, source := range app.Spec.Sources {
-		app.Operation.Sync.Revisions =
-			append(app.Operation.Sync.Revisions, source.TargetRevision)
-	}
+	applyArgoCDSyncOperation(app.Operation.Sync, update.SyncOperation)
 	if err = a.argoCDAppPatchFn(
 		ctx,
 		app,
@@ -213,6 +261,175 @@ func (a *argoCDMechanism) doSingleUpdate(
 	return nil
 }
 
+// getSyncOperationPhase returns the phase the Promotion should be in, along
+// with an explanatory message, based on the state of the sync operation that
+// was previously initiated, on behalf of the specified Promotion, against the
+// Argo CD Application referenced by the provided update.
+func (a *argoCDMechanism) getSyncOperationPhase(
+	ctx context.Context,
+	promoName string,
+	update kargoapi.ArgoCDAppUpdate,
+) (kargoapi.PromotionPhase, string, error) {
+	app, err :=
+		a.getArgoCDAppFn(ctx, update.AppNamespaceOrDefault(), update.AppName)
+	if err != nil {
+		return "", "", errors.Wrapf(
+			err,
+			"error finding Argo CD Application %q in namespace %q",
+			update.AppName,
+			update.AppNamespaceOrDefault(),
+		)
+	}
+	if app == nil {
+		return "", "", errors.Errorf(
+			"unable to find Argo CD Application %q in namespace %q",
+			update.AppName,
+			update.AppNamespaceOrDefault(),
+		)
+	}
+	phase, msg := getArgoCDSyncOperationPhase(app, promoName)
+	return phase, msg, nil
+}
+
+// getArgoCDSyncOperationPhase maps the state of the most recent sync operation
+// of the provided Argo CD Application to a Promotion phase and explanatory
+// message. Only a completed sync operation of the Application's current target
+// revisions that Kargo initiated on behalf of the specified Promotion is
+// considered. Anything else, including an earlier sync operation of the same
+// revisions, is assumed to mean that the sync operation initiated by Kargo has
+// not completed yet.
+func getArgoCDSyncOperationPhase(
+	app *argocd.Application,
+	promoName string,
+) (kargoapi.PromotionPhase, string) {
+	runningMsg := fmt.Sprintf(
+		"waiting for sync operation of Argo CD Application %q in namespace %q "+
+			"to complete",
+		app.Name,
+		app.Namespace,
+	)
+	if app.Operation != nil {
+		// The operation is pending or still in progress
+		return kargoapi.PromotionPhaseRunning, runningMsg
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil ||
+		!slices.Equal(opState.Operation.Sync.Revisions, getArgoCDAppRevisions(app)) {
+		return kargoapi.PromotionPhaseRunning, runningMsg
+	}
+	if !isArgoCDOperationForPromotion(opState.Operation, promoName) {
+		// This is an earlier sync operation of the same revisions
+		return kargoapi.PromotionPhaseRunning, runningMsg
+	}
+	switch opState.Phase {
+	case argocd.OperationSucceeded:
+		return kargoapi.PromotionPhaseSucceeded, ""
+	case argocd.OperationFailed, argocd.OperationError:
+		return kargoapi.PromotionPhaseFailed, fmt.Sprintf(
+			"sync operation of Argo CD Application %q in namespace %q ended "+
+				"with phase %q: %s",
+			app.Name,
+			app.Namespace,
+			opState.Phase,
+			opState.Message,
+		)
+	default:
+		return kargoapi.PromotionPhaseRunning, runningMsg
+	}
+}
+
+// isArgoCDOperationForPromotion returns true if the provided Argo CD operation
+// was initiated by Kargo on behalf of the specified Promotion.
+func isArgoCDOperationForPromotion(op argocd.Operation, promoName string) bool {
+	for _, info := range op.Info {
+		if info != nil && info.Name == argoCDOperationInfoPromotion &&
+			info.Value == promoName {
+			return true
+		}
+	}
+	return false
+}
+
+// getArgoCDAppRevisions returns the target revisions of all of the provided
+// Argo CD Application's sources.
+func getArgoCDAppRevisions(app *argocd.Application) []string {
+	revisions := []string{}
+	if app.Spec.Source != nil {
+		revisions = append(revisions, app.Spec.Source.TargetRevision)
+	}
+	for _, source := range app.Spec.Sources {
+		revisions = append(revisions, source.TargetRevision)
+	}
+	return revisions
+}
+
+// applyArgoCDSyncOperation applies the options described by the provided
+// ArgoCDSyncOperation, if any, to an Argo CD SyncOperation.
+func applyArgoCDSyncOperation(
+	syncOp *argocd.SyncOperation,
+	opts *kargoapi.ArgoCDSyncOperation,
+) {
+	if opts == nil {
+		return
+	}
+	syncOp.Prune = opts.Prune
+	if opts.Force {
+		syncOp.SyncStrategy = &argocd.SyncStrategy{
+			Hook: &argocd.SyncStrategyHook{
+				SyncStrategyApply: argocd.SyncStrategyApply{
+					Force: true,
+				},
+			},
+		}
+	}
+	if len(opts.Resources) > 0 {
+		syncOp.Resources = make([]argocd.SyncOperationResource, len(opts.Resources))
+		for i, resource := range opts.Resources {
+			syncOp.Resources[i] = argocd.SyncOperationResource{
+				Group:     resource.Group,
+				Kind:      resource.Kind,
+				Name:      resource.Name,
+				Namespace: resource.Namespace,
+			}
+		}
+	}
+	if len(opts.SyncOptions) > 0 {
+		syncOp.SyncOptions = opts.SyncOptions
+	}
+}
+
+// argoCDAppUpdateMetadataKey returns the key used to record, in a Promotion's
+// status metadata, that the provided update has already been applied.
+func argoCDAppUpdateMetadataKey(update kargoapi.ArgoCDAppUpdate) string {
+	return fmt.Sprintf(
+		"argocd-app:%s/%s",
+		update.AppNamespaceOrDefault(),
+		update.AppName,
+	)
+}
+
+// setArgoCDAppUpdateMetadata records in the metadata map that the provided
+// update has been applied.
+func setArgoCDAppUpdateMetadata(
+	metadata map[string]string,
+	update kargoapi.ArgoCDAppUpdate,
+) map[string]string {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	metadata[argoCDAppUpdateMetadataKey(update)] = argoCDAppUpdateInitiated
+	return metadata
+}
+
+// isArgoCDAppUpdateInitiated returns true if the metadata map records that the
+// provided update has already been applied.
+func isArgoCDAppUpdateInitiated(
+	metadata map[string]string,
+	update kargoapi.ArgoCDAppUpdate,
+) bool {
+	return metadata[argoCDAppUpdateMetadataKey(update)] == argoCDAppUpdateInitiated
+}
+
 func getApplicationFn(
 	argocdClient client.Client,
 ) func(
@@ -236,47 +453,93 @@ func authorizeArgoCDAppUpdate(
 	stageMeta metav1.ObjectMeta,
 	appMeta metav1.ObjectMeta,
 ) error {
+	return authorizeStageUpdate("Argo CD Application", stageMeta, appMeta)
+}
+
+// authorizeStageUpdate returns an error if the resource (of the kind described
+// by kindDesc) represented by objMeta does not explicitly permit mutation by the
+// Kargo Stage represented by stageMeta.
+func authorizeStageUpdate(
+	kindDesc string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
+) error {
+	return authorizeStage(kindDesc, "mutation", stageMeta, objMeta)
+}
+
+// AuthorizeStageRead returns an error if the resource (of the kind described by
+// kindDesc) represented by objMeta does not explicitly permit reads by the
+// Kargo Stage represented by stageMeta. Permission is granted using the same
+// annotation that permits mutation.
+func AuthorizeStageRead(
+	kindDesc string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
+) error {
+	return authorizeStage(kindDesc, "reads", stageMeta, objMeta)
+}
+
+// AuthorizeStageUse returns an error if the resource (of the kind described by
+// kindDesc) represented by objMeta does not explicitly permit its use by the
+// Kargo Stage represented by stageMeta. Permission is granted using the same
+// annotation that permits mutation.
+func AuthorizeStageUse(
+	kindDesc string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
+) error {
+	return authorizeStage(kindDesc, "use", stageMeta, objMeta)
+}
+
+// authorizeStage returns an error if the resource (of the kind described by
+// kindDesc) represented by objMeta does not explicitly permit the described
+// kind of access by the Kargo Stage represented by stageMeta.
+func authorizeStage(
+	kindDesc string,
+	access string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
+) error {
+	objDesc := fmt.Sprintf("%s %q", kindDesc, objMeta.Name)
+	if objMeta.Namespace != "" {
+		objDesc = fmt.Sprintf("%s in namespace %q", objDesc, objMeta.Namespace)
+	}
 	permErr := errors.Errorf(
-		"Argo CD Application %q in namespace %q does not permit mutation by "+
-			"Kargo Stage %s in namespace %s",
-		appMeta.Name,
-		appMeta.Namespace,
+		"%s does not permit %s by Kargo Stage %s in namespace %s",
+		objDesc,
+		access,
 		stageMeta.Name,
 		stageMeta.Namespace,
 	)
-	if appMeta.Annotations == nil {
+	if objMeta.Annotations == nil {
 		return permErr
 	}
-	allowedStage, ok := appMeta.Annotations[authorizedStageAnnotationKey]
+	allowedStage, ok := objMeta.Annotations[authorizedStageAnnotationKey]
 	if !ok {
 		return permErr
 	}
 	tokens := strings.SplitN(allowedStage, ":", 2)
 	if len(tokens) != 2 {
 		return errors.Errorf(
-			"unable to parse value of annotation %q (%q) on Argo CD Application "+
-				"%q in namespace %q",
+			"unable to parse value of annotation %q (%q) on %s",
 			authorizedStageAnnotationKey,
 			allowedStage,
-			appMeta.Name,
-			appMeta.Namespace,
+			objDesc,
 		)
 	}
 	allowedNamespaceGlob, err := glob.Compile(tokens[0])
 	if err != nil {
 		return errors.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
+			"%s has invalid glob expression: %q",
+			objDesc,
 			tokens[0],
 		)
 	}
 	allowedNameGlob, err := glob.Compile(tokens[1])
 	if err != nil {
 		return errors.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
+			"%s has invalid glob expression: %q",
+			objDesc,
 			tokens[1],
 		)
 	}
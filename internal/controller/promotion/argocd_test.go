@@ -21,6 +21,7 @@ func TestNewArgoCDMechanism(t *testing.T) {
 	apm, ok := pm.(*argoCDMechanism)
 	require.True(t, ok)
 	require.NotNil(t, apm.doSingleUpdateFn)
+	require.NotNil(t, apm.getSyncOperationPhaseFn)
 	require.NotNil(t, apm.getArgoCDAppFn)
 	require.NotNil(t, apm.applyArgoCDSourceUpdateFn)
 	require.NotNil(t, apm.argoCDAppPatchFn)
@@ -35,6 +36,7 @@ func TestArgoCDPromote(t *testing.T) {
 		name       string
 		promoMech  *argoCDMechanism
 		stage      *kargoapi.Stage
+		promo      kargoapi.Promotion
 		newFreight kargoapi.FreightReference
 		assertions func(
 			newStatus *kargoapi.PromotionStatus,
@@ -94,6 +96,7 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					string,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) error {
@@ -131,6 +134,7 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					string,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.FreightReference,
 				) error {
@@ -141,7 +145,55 @@ func TestArgoCDPromote(t *testing.T) {
 				Spec: &kargoapi.StageSpec{
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{
 						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
-							{},
+							{
+								AppName:      "fake-name",
+								AppNamespace: "fake-namespace",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, newFreightIn, newFreightOut)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+				require.Equal(
+					t,
+					map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+					newStatus.Metadata,
+				)
+			},
+		},
+		{
+			name: "success; sync operation initiated",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					string,
+					kargoapi.ArgoCDAppUpdate,
+					kargoapi.FreightReference,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:       "fake-name",
+								AppNamespace:  "fake-namespace",
+								SyncOperation: &kargoapi.ArgoCDSyncOperation{},
+							},
 						},
 					},
 				},
@@ -154,6 +206,204 @@ func TestArgoCDPromote(t *testing.T) {
 			) {
 				require.NoError(t, err)
 				require.Equal(t, newFreightIn, newFreightOut)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, newStatus.Phase)
+				require.Contains(t, newStatus.Message, "waiting for sync operation")
+				require.Equal(
+					t,
+					map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+					newStatus.Metadata,
+				)
+			},
+		},
+		{
+			name: "update already applied",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					string,
+					kargoapi.ArgoCDAppUpdate,
+					kargoapi.FreightReference,
+				) error {
+					return errors.New("update should not have been re-applied")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:      "fake-name",
+								AppNamespace: "fake-namespace",
+							},
+						},
+					},
+				},
+			},
+			promo: kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+					Metadata: map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+				},
+			},
+			assertions: func(
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+			},
+		},
+		{
+			name: "error getting sync operation phase",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				getSyncOperationPhaseFn: func(
+					_ context.Context,
+					promoName string,
+					_ kargoapi.ArgoCDAppUpdate,
+				) (kargoapi.PromotionPhase, string, error) {
+					require.Equal(t, "fake-promotion", promoName)
+					return "", "", errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:       "fake-name",
+								AppNamespace:  "fake-namespace",
+								SyncOperation: &kargoapi.ArgoCDSyncOperation{},
+							},
+						},
+					},
+				},
+			},
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+					Metadata: map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+				},
+			},
+			assertions: func(
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "sync operation failed",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				getSyncOperationPhaseFn: func(
+					_ context.Context,
+					promoName string,
+					_ kargoapi.ArgoCDAppUpdate,
+				) (kargoapi.PromotionPhase, string, error) {
+					require.Equal(t, "fake-promotion", promoName)
+					return kargoapi.PromotionPhaseFailed, "sync failed", nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:       "fake-name",
+								AppNamespace:  "fake-namespace",
+								SyncOperation: &kargoapi.ArgoCDSyncOperation{},
+							},
+						},
+					},
+				},
+			},
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseRunning,
+					Message: "waiting for sync operation",
+					Metadata: map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+				},
+			},
+			assertions: func(
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, newStatus.Phase)
+				require.Equal(t, "sync failed", newStatus.Message)
+			},
+		},
+		{
+			name: "sync operation succeeded",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewClientBuilder().Build(),
+				getSyncOperationPhaseFn: func(
+					_ context.Context,
+					promoName string,
+					_ kargoapi.ArgoCDAppUpdate,
+				) (kargoapi.PromotionPhase, string, error) {
+					require.Equal(t, "fake-promotion", promoName)
+					return kargoapi.PromotionPhaseSucceeded, "", nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppName:       "fake-name",
+								AppNamespace:  "fake-namespace",
+								SyncOperation: &kargoapi.ArgoCDSyncOperation{},
+							},
+						},
+					},
+				},
+			},
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseRunning,
+					Message: "waiting for sync operation",
+					Metadata: map[string]string{
+						"argocd-app:fake-namespace/fake-name": "initiated",
+					},
+				},
+			},
+			assertions: func(
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.FreightReference,
+				newFreightOut kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+				require.Empty(t, newStatus.Message)
 			},
 		},
 	}
@@ -162,7 +412,7 @@ func TestArgoCDPromote(t *testing.T) {
 			newStatus, newFreightOut, err := testCase.promoMech.Promote(
 				context.Background(),
 				testCase.stage,
-				&kargoapi.Promotion{},
+				&testCase.promo,
 				testCase.newFreight,
 			)
 			testCase.assertions(newStatus, testCase.newFreight, newFreightOut, err)
@@ -395,11 +645,22 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 					}, nil
 				},
 				argoCDAppPatchFn: func(
-					context.Context,
-					client.Object,
-					client.Patch,
-					...client.PatchOption,
+					_ context.Context,
+					obj client.Object,
+					_ client.Patch,
+					_ ...client.PatchOption,
 				) error {
+					app, ok := obj.(*argocd.Application)
+					require.True(t, ok)
+					require.NotNil(t, app.Operation)
+					require.Contains(
+						t,
+						app.Operation.Info,
+						&argocd.Info{
+							Name:  "Promotion",
+							Value: "fake-promotion",
+						},
+					)
 					return nil
 				},
 			},
@@ -418,6 +679,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				testCase.promoMech.doSingleUpdate(
 					context.Background(),
 					testCase.stageMeta,
+					"fake-promotion",
 					testCase.update,
 					kargoapi.FreightReference{},
 				),
@@ -851,3 +1113,264 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 		result,
 	)
 }
+
+func TestGetArgoCDSyncOperationPhase(t *testing.T) {
+	const testPromoName = "fake-promotion"
+	testOperationInfo := []*argocd.Info{
+		{
+			Name:  "Promotion",
+			Value: testPromoName,
+		},
+	}
+	testCases := []struct {
+		name          string
+		app           *argocd.Application
+		expectedPhase kargoapi.PromotionPhase
+		assertMessage func(string)
+	}{
+		{
+			name: "operation pending",
+			app: &argocd.Application{
+				Operation: &argocd.Operation{},
+			},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name:          "no operation state",
+			app:           &argocd.Application{},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name: "operation state for other revisions",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						TargetRevision: "fake-revision",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"other-revision"},
+							},
+						},
+						Phase: argocd.OperationSucceeded,
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name: "operation running",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						TargetRevision: "fake-revision",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"fake-revision"},
+							},
+						},
+						Phase: argocd.OperationRunning,
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name: "operation failed",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Sources: []argocd.ApplicationSource{
+						{
+							TargetRevision: "fake-revision",
+						},
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Info: testOperationInfo,
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"fake-revision"},
+							},
+						},
+						Phase:   argocd.OperationFailed,
+						Message: "something went wrong",
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseFailed,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "ended with phase \"Failed\"")
+				require.Contains(t, msg, "something went wrong")
+			},
+		},
+		{
+			name: "operation of same revisions for other Promotion succeeded",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						TargetRevision: "fake-revision",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Info: []*argocd.Info{
+								{
+									Name:  "Promotion",
+									Value: "other-promotion",
+								},
+							},
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"fake-revision"},
+							},
+						},
+						Phase: argocd.OperationSucceeded,
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name: "operation of same revisions not initiated by Kargo succeeded",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						TargetRevision: "fake-revision",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"fake-revision"},
+							},
+						},
+						Phase: argocd.OperationSucceeded,
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+			assertMessage: func(msg string) {
+				require.Contains(t, msg, "waiting for sync operation")
+			},
+		},
+		{
+			name: "operation succeeded",
+			app: &argocd.Application{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						TargetRevision: "fake-revision",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Operation: argocd.Operation{
+							Info: testOperationInfo,
+							Sync: &argocd.SyncOperation{
+								Revisions: []string{"fake-revision"},
+							},
+						},
+						Phase: argocd.OperationSucceeded,
+					},
+				},
+			},
+			expectedPhase: kargoapi.PromotionPhaseSucceeded,
+			assertMessage: func(msg string) {
+				require.Empty(t, msg)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			phase, msg :=
+				getArgoCDSyncOperationPhase(testCase.app, testPromoName)
+			require.Equal(t, testCase.expectedPhase, phase)
+			testCase.assertMessage(msg)
+		})
+	}
+}
+
+func TestApplyArgoCDSyncOperation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     *kargoapi.ArgoCDSyncOperation
+		expected argocd.SyncOperation
+	}{
+		{
+			name: "no options",
+			expected: argocd.SyncOperation{
+				SyncOptions: argocd.SyncOptions{"fake-option"},
+				Revisions:   []string{"fake-revision"},
+			},
+		},
+		{
+			name: "all options",
+			opts: &kargoapi.ArgoCDSyncOperation{
+				Prune: true,
+				Force: true,
+				Resources: []kargoapi.ArgoCDSyncResource{
+					{
+						Group:     "apps",
+						Kind:      "Deployment",
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+				},
+				SyncOptions: []string{"ServerSideApply=true"},
+			},
+			expected: argocd.SyncOperation{
+				Prune: true,
+				SyncStrategy: &argocd.SyncStrategy{
+					Hook: &argocd.SyncStrategyHook{
+						SyncStrategyApply: argocd.SyncStrategyApply{
+							Force: true,
+						},
+					},
+				},
+				Resources: []argocd.SyncOperationResource{
+					{
+						Group:     "apps",
+						Kind:      "Deployment",
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+				},
+				SyncOptions: argocd.SyncOptions{"ServerSideApply=true"},
+				Revisions:   []string{"fake-revision"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			syncOp := &argocd.SyncOperation{
+				SyncOptions: argocd.SyncOptions{"fake-option"},
+				Revisions:   []string{"fake-revision"},
+			}
+			applyArgoCDSyncOperation(syncOp, testCase.opts)
+			require.Equal(t, testCase.expected, *syncOp)
+		})
+	}
+}
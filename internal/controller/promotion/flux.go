@@ -0,0 +1,427 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// fluxMechanism is an implementation of the Mechanism interface that updates
+// Flux resources.
+type fluxMechanism struct {
+	fluxClient client.Client
+	// These behaviors are overridable for testing purposes:
+	doSingleUpdateFn func(
+		ctx context.Context,
+		stageMeta metav1.ObjectMeta,
+		update kargoapi.FluxUpdate,
+		newFreight kargoapi.FreightReference,
+	) error
+	getFluxResourceFn func(
+		ctx context.Context,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error)
+	applyFluxUpdateFn func(
+		*unstructured.Unstructured,
+		kargoapi.FreightReference,
+		kargoapi.FluxUpdate,
+	) error
+	fluxResourcePatchFn func(
+		ctx context.Context,
+		obj client.Object,
+		patch client.Patch,
+		opts ...client.PatchOption,
+	) error
+}
+
+// newFluxMechanism returns an implementation of the Mechanism interface that
+// updates Flux resources.
+func newFluxMechanism(fluxClient client.Client) Mechanism {
+	f := &fluxMechanism{
+		fluxClient: fluxClient,
+	}
+	f.doSingleUpdateFn = f.doSingleUpdate
+	f.getFluxResourceFn = getFluxResourceFn(fluxClient)
+	f.applyFluxUpdateFn = applyFluxUpdate
+	if fluxClient != nil {
+		f.fluxResourcePatchFn = fluxClient.Patch
+	}
+	return f
+}
+
+// GetName implements the Mechanism interface.
+func (*fluxMechanism) GetName() string {
+	return "Flux promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (f *fluxMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	updates := stage.Spec.PromotionMechanisms.FluxUpdates
+
+	if len(updates) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	if f.fluxClient == nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseFailed), newFreight,
+			errors.New(
+				"Flux integration is disabled on this controller; cannot perform " +
+					"promotion",
+			)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Flux-based promotion mechanisms")
+
+	for _, update := range updates {
+		if err := f.doSingleUpdateFn(
+			ctx,
+			stage.ObjectMeta,
+			update,
+			newFreight,
+		); err != nil {
+			return nil, newFreight, err
+		}
+	}
+
+	logger.Debug("done executing Flux-based promotion mechanisms")
+
+	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+func (f *fluxMechanism) doSingleUpdate(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	update kargoapi.FluxUpdate,
+	newFreight kargoapi.FreightReference,
+) error {
+	obj, err :=
+		f.getFluxResourceFn(ctx, update.Kind, update.Namespace, update.Name)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Flux %s %q in namespace %q",
+			update.Kind,
+			update.Name,
+			update.Namespace,
+		)
+	}
+	if obj == nil {
+		return errors.Errorf(
+			"unable to find Flux %s %q in namespace %q",
+			update.Kind,
+			update.Name,
+			update.Namespace,
+		)
+	}
+	// Make sure this is allowed!
+	if err = authorizeFluxUpdate(update.Kind, stageMeta, obj); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(obj.DeepCopy())
+	if err = f.applyFluxUpdateFn(obj, newFreight, update); err != nil {
+		return errors.Wrapf(
+			err,
+			"error updating Flux %s %q in namespace %q",
+			update.Kind,
+			update.Name,
+			update.Namespace,
+		)
+	}
+	// Prompt Flux to reconcile the resource immediately
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[flux.AnnotationKeyReconcileRequestedAt] =
+		time.Now().Format(time.RFC3339Nano)
+	obj.SetAnnotations(annotations)
+	if err = f.fluxResourcePatchFn(ctx, obj, patch); err != nil {
+		return errors.Wrapf(
+			err,
+			"error patching Flux %s %q",
+			update.Kind,
+			update.Name,
+		)
+	}
+	logging.LoggerFromContext(ctx).
+		WithField("kind", update.Kind).
+		WithField("name", update.Name).
+		Debug("patched Flux resource")
+	return nil
+}
+
+func getFluxResourceFn(
+	fluxClient client.Client,
+) func(
+	ctx context.Context,
+	kind kargoapi.FluxResourceKind,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	return func(
+		ctx context.Context,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error) {
+		return flux.GetResource(ctx, fluxClient, kind, namespace, name)
+	}
+}
+
+// authorizeFluxUpdate returns an error if the Flux resource represented by obj
+// does not explicitly permit mutation by the Kargo Stage represented by
+// stageMeta.
+func authorizeFluxUpdate(
+	kind kargoapi.FluxResourceKind,
+	stageMeta metav1.ObjectMeta,
+	obj *unstructured.Unstructured,
+) error {
+	return authorizeStageUpdate(
+		fmt.Sprintf("Flux %s", kind),
+		stageMeta,
+		metav1.ObjectMeta{
+			Namespace:   obj.GetNamespace(),
+			Name:        obj.GetName(),
+			Annotations: obj.GetAnnotations(),
+		},
+	)
+}
+
+// applyFluxUpdate updates a single Flux resource.
+func applyFluxUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.FreightReference,
+	update kargoapi.FluxUpdate,
+) error {
+	switch update.Kind {
+	case kargoapi.FluxResourceKindHelmRelease:
+		return applyFluxHelmReleaseUpdate(obj, newFreight, update.HelmRelease)
+	case kargoapi.FluxResourceKindOCIRepository:
+		return applyFluxOCIRepositoryUpdate(obj, newFreight, update.OCIRepository)
+	case kargoapi.FluxResourceKindGitRepository:
+		return applyFluxGitRepositoryUpdate(obj, newFreight)
+	case kargoapi.FluxResourceKindKustomization:
+		return applyFluxKustomizationUpdate(obj, newFreight, update.Kustomization)
+	default:
+		return errors.Errorf("unsupported Flux resource kind %q", update.Kind)
+	}
+}
+
+// applyFluxHelmReleaseUpdate updates the chart version and/or values of a Flux
+// HelmRelease.
+func applyFluxHelmReleaseUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.FreightReference,
+	update *kargoapi.FluxHelmReleaseUpdate,
+) error {
+	if update == nil {
+		return nil
+	}
+	if update.Chart != nil {
+		for _, chart := range newFreight.Charts {
+			// path.Join accounts for the possibility that chart.Name is empty
+			if path.Join(chart.RepoURL, chart.Name) ==
+				path.Join(update.Chart.RepoURL, update.Chart.Name) {
+				if err := unstructured.SetNestedField(
+					obj.Object,
+					chart.Version,
+					"spec", "chart", "spec", "version",
+				); err != nil {
+					return errors.Wrap(err, "error setting chart version")
+				}
+				break
+			}
+		}
+	}
+	changes := buildHelmValuesChangesForFluxHelmRelease(
+		newFreight.Images,
+		update.Images,
+	)
+	for key, value := range changes {
+		fields := append([]string{"spec", "values"}, strings.Split(key, ".")...)
+		if err :=
+			unstructured.SetNestedField(obj.Object, value, fields...); err != nil {
+			return errors.Wrapf(err, "error setting value for key %q", key)
+		}
+	}
+	return nil
+}
+
+// applyFluxOCIRepositoryUpdate updates the ref of a Flux OCIRepository to
+// point at the tag (or digest) of the image or chart from the Freight that
+// corresponds to the OCIRepository's URL.
+func applyFluxOCIRepositoryUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.FreightReference,
+	update *kargoapi.FluxOCIRepositoryUpdate,
+) error {
+	url, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+	// Kargo does not use the "oci://" prefix for images, but Flux does.
+	url = strings.TrimPrefix(url, "oci://")
+	var ref map[string]any
+	for _, image := range newFreight.Images {
+		if image.RepoURL != url {
+			continue
+		}
+		if update != nil && update.UseDigest {
+			ref = map[string]any{"digest": image.Digest}
+		} else {
+			ref = map[string]any{"tag": image.Tag}
+		}
+		break
+	}
+	if ref == nil {
+		for _, chart := range newFreight.Charts {
+			// path.Join accounts for the possibility that chart.Name is empty
+			if path.Join(strings.TrimPrefix(chart.RepoURL, "oci://"), chart.Name) ==
+				url {
+				ref = map[string]any{"tag": chart.Version}
+				break
+			}
+		}
+	}
+	if ref == nil {
+		// There's no change to make in this case.
+		return nil
+	}
+	// Replace the ref entirely, since a digest or semver range would otherwise
+	// take precedence over a tag.
+	return unstructured.SetNestedMap(obj.Object, ref, "spec", "ref")
+}
+
+// applyFluxGitRepositoryUpdate updates the ref of a Flux GitRepository to point
+// at the commit from the Freight that corresponds to the GitRepository's URL.
+func applyFluxGitRepositoryUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.FreightReference,
+) error {
+	url, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+	url = git.NormalizeGitURL(url)
+	for _, commit := range newFreight.Commits {
+		if git.NormalizeGitURL(commit.RepoURL) == url {
+			// A commit takes precedence over all other fields of the ref, so there
+			// is no need to remove any of them.
+			return unstructured.SetNestedField(
+				obj.Object,
+				commit.ID,
+				"spec", "ref", "commit",
+			)
+		}
+	}
+	return nil
+}
+
+// applyFluxKustomizationUpdate updates the image overrides of a Flux
+// Kustomization.
+func applyFluxKustomizationUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.FreightReference,
+	update *kargoapi.FluxKustomizationUpdate,
+) error {
+	if update == nil || len(update.Images) == 0 {
+		return nil
+	}
+	images, _, _ := unstructured.NestedSlice(obj.Object, "spec", "images")
+	newImages := buildKustomizeImagesForFluxKustomization(
+		newFreight.Images,
+		update.Images,
+	)
+imageUpdateLoop:
+	for _, newImage := range newImages {
+		for i, image := range images {
+			if m, ok := image.(map[string]any); ok && m["name"] == newImage["name"] {
+				images[i] = newImage
+				continue imageUpdateLoop
+			}
+		}
+		images = append(images, newImage)
+	}
+	return unstructured.SetNestedSlice(obj.Object, images, "spec", "images")
+}
+
+// buildKustomizeImagesForFluxKustomization takes a list of images and a list of
+// instructions about which of them should be overridden and distills them into
+// a list of Kustomize image overrides in the form expected by a Flux
+// Kustomization.
+func buildKustomizeImagesForFluxKustomization(
+	images []kargoapi.Image,
+	imageUpdates []kargoapi.FluxKustomizeImageUpdate,
+) []map[string]any {
+	imagesByRepoURL := make(map[string]kargoapi.Image, len(images))
+	for _, image := range images {
+		imagesByRepoURL[image.RepoURL] = image
+	}
+	kustomizeImages := make([]map[string]any, 0, len(imageUpdates))
+	for _, imageUpdate := range imageUpdates {
+		image, found := imagesByRepoURL[imageUpdate.Image]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		kustomizeImage := map[string]any{"name": imageUpdate.Image}
+		if imageUpdate.UseDigest {
+			kustomizeImage["digest"] = image.Digest
+		} else {
+			kustomizeImage["newTag"] = image.Tag
+		}
+		kustomizeImages = append(kustomizeImages, kustomizeImage)
+	}
+	return kustomizeImages
+}
+
+// buildHelmValuesChangesForFluxHelmRelease takes a list of images and a list of
+// instructions about changes that should be made to various Helm values and
+// distills them into a map of new values indexed by (dot-separated) key.
+func buildHelmValuesChangesForFluxHelmRelease(
+	images []kargoapi.Image,
+	imageUpdates []kargoapi.FluxHelmImageUpdate,
+) map[string]string {
+	tagsByImage := make(map[string]string, len(images))
+	digestsByImage := make(map[string]string, len(images))
+	for _, image := range images {
+		tagsByImage[image.RepoURL] = image.Tag
+		digestsByImage[image.RepoURL] = image.Digest
+	}
+	changes := map[string]string{}
+	for _, imageUpdate := range imageUpdates {
+		tag, tagFound := tagsByImage[imageUpdate.Image]
+		digest, digestFound := digestsByImage[imageUpdate.Image]
+		if !tagFound && !digestFound {
+			// There's no change to make in this case.
+			continue
+		}
+		switch imageUpdate.Value {
+		case kargoapi.ImageUpdateValueTypeImageAndTag:
+			changes[imageUpdate.Key] = fmt.Sprintf("%s:%s", imageUpdate.Image, tag)
+		case kargoapi.ImageUpdateValueTypeTag:
+			changes[imageUpdate.Key] = tag
+		case kargoapi.ImageUpdateValueTypeImageAndDigest:
+			changes[imageUpdate.Key] = fmt.Sprintf("%s@%s", imageUpdate.Image, digest)
+		case kargoapi.ImageUpdateValueTypeDigest:
+			changes[imageUpdate.Key] = digest
+		default:
+			// This really shouldn't happen, so we'll ignore it.
+		}
+	}
+	return changes
+}
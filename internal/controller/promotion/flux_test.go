@@ -0,0 +1,510 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+)
+
+func TestNewFluxMechanism(t *testing.T) {
+	pm := newFluxMechanism(
+		fake.NewClientBuilder().Build(),
+	)
+	fpm, ok := pm.(*fluxMechanism)
+	require.True(t, ok)
+	require.NotNil(t, fpm.doSingleUpdateFn)
+	require.NotNil(t, fpm.getFluxResourceFn)
+	require.NotNil(t, fpm.applyFluxUpdateFn)
+	require.NotNil(t, fpm.fluxResourcePatchFn)
+}
+
+func TestFluxGetName(t *testing.T) {
+	require.NotEmpty(t, (&fluxMechanism{}).GetName())
+}
+
+func TestFluxPromote(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *fluxMechanism
+		stage      *kargoapi.Stage
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no updates",
+			promoMech: &fluxMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name:      "flux integration disabled",
+			promoMech: &fluxMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxUpdates: []kargoapi.FluxUpdate{{}},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"Flux integration is disabled on this controller",
+				)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+			},
+		},
+		{
+			name: "error applying update",
+			promoMech: &fluxMechanism{
+				fluxClient: fake.NewClientBuilder().Build(),
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.FluxUpdate,
+					kargoapi.FreightReference,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxUpdates: []kargoapi.FluxUpdate{{}},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &fluxMechanism{
+				fluxClient: fake.NewClientBuilder().Build(),
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.FluxUpdate,
+					kargoapi.FreightReference,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxUpdates: []kargoapi.FluxUpdate{{}},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				kargoapi.FreightReference{},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestFluxDoSingleUpdate(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Namespace: "fake-namespace",
+		Name:      "fake-stage",
+	}
+	testUpdate := kargoapi.FluxUpdate{
+		Kind:      kargoapi.FluxResourceKindGitRepository,
+		Namespace: "fake-namespace",
+		Name:      "fake-repo",
+	}
+	newGitRepository := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("fake-namespace")
+		obj.SetName("fake-repo")
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *fluxMechanism
+		assertions func(error)
+	}{
+		{
+			name: "error finding resource",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.ErrorContains(t, err, "error finding Flux GitRepository")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "resource not found",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.ErrorContains(t, err, "unable to find Flux GitRepository")
+			},
+		},
+		{
+			name: "update not authorized",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newGitRepository(nil), nil
+				},
+			},
+			assertions: func(err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+			},
+		},
+		{
+			name: "error patching resource",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newGitRepository(map[string]string{
+						authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+					}), nil
+				},
+				applyFluxUpdateFn: applyFluxUpdate,
+				fluxResourcePatchFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.ErrorContains(t, err, "error patching Flux GitRepository")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newGitRepository(map[string]string{
+						authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+					}), nil
+				},
+				applyFluxUpdateFn: applyFluxUpdate,
+				fluxResourcePatchFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					require.Contains(
+						t,
+						obj.GetAnnotations(),
+						flux.AnnotationKeyReconcileRequestedAt,
+					)
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.promoMech.doSingleUpdate(
+					context.Background(),
+					testStageMeta,
+					testUpdate,
+					kargoapi.FreightReference{},
+				),
+			)
+		})
+	}
+}
+
+func TestApplyFluxUpdate(t *testing.T) {
+	testFreight := kargoapi.FreightReference{
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo.git",
+			ID:      "fake-commit",
+		}},
+		Images: []kargoapi.Image{{
+			RepoURL: "ghcr.io/example/image",
+			Tag:     "v1.2.3",
+			Digest:  "sha256:abc",
+		}},
+		Charts: []kargoapi.Chart{{
+			RepoURL: "https://charts.example.com",
+			Name:    "fake-chart",
+			Version: "4.5.6",
+		}},
+	}
+	testCases := []struct {
+		name       string
+		obj        map[string]any
+		update     kargoapi.FluxUpdate
+		assertions func(map[string]any, error)
+	}{
+		{
+			name: "unsupported kind",
+			obj:  map[string]any{},
+			update: kargoapi.FluxUpdate{
+				Kind: "Bogus",
+			},
+			assertions: func(_ map[string]any, err error) {
+				require.ErrorContains(t, err, "unsupported Flux resource kind")
+			},
+		},
+		{
+			name: "HelmRelease",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"chart": map[string]any{
+						"spec": map[string]any{
+							"chart":   "fake-chart",
+							"version": "4.5.5",
+						},
+					},
+					"values": map[string]any{
+						"replicas": int64(2),
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindHelmRelease,
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{
+					Chart: &kargoapi.FluxHelmChartUpdate{
+						RepoURL: "https://charts.example.com",
+						Name:    "fake-chart",
+					},
+					Images: []kargoapi.FluxHelmImageUpdate{
+						{
+							Image: "ghcr.io/example/image",
+							Key:   "image.tag",
+							Value: kargoapi.ImageUpdateValueTypeTag,
+						},
+						{
+							Image: "ghcr.io/example/other-image",
+							Key:   "other.image",
+							Value: kargoapi.ImageUpdateValueTypeImageAndTag,
+						},
+					},
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"chart": map[string]any{
+							"spec": map[string]any{
+								"chart":   "fake-chart",
+								"version": "4.5.6",
+							},
+						},
+						"values": map[string]any{
+							"replicas": int64(2),
+							"image": map[string]any{
+								"tag": "v1.2.3",
+							},
+						},
+					},
+					obj["spec"],
+				)
+			},
+		},
+		{
+			name: "OCIRepository with tag",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "oci://ghcr.io/example/image",
+					"ref": map[string]any{
+						"semver": ">=1.0.0",
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindOCIRepository,
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				ref, _, _ := unstructured.NestedMap(obj, "spec", "ref")
+				require.Equal(t, map[string]any{"tag": "v1.2.3"}, ref)
+			},
+		},
+		{
+			name: "OCIRepository with digest",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "oci://ghcr.io/example/image",
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindOCIRepository,
+				OCIRepository: &kargoapi.FluxOCIRepositoryUpdate{
+					UseDigest: true,
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				ref, _, _ := unstructured.NestedMap(obj, "spec", "ref")
+				require.Equal(t, map[string]any{"digest": "sha256:abc"}, ref)
+			},
+		},
+		{
+			name: "OCIRepository with no matching artifact",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "oci://ghcr.io/example/unrelated",
+					"ref": map[string]any{
+						"tag": "v0.0.1",
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindOCIRepository,
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				ref, _, _ := unstructured.NestedMap(obj, "spec", "ref")
+				require.Equal(t, map[string]any{"tag": "v0.0.1"}, ref)
+			},
+		},
+		{
+			name: "GitRepository",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "https://github.com/example/repo",
+					"ref": map[string]any{
+						"branch": "main",
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindGitRepository,
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				ref, _, _ := unstructured.NestedMap(obj, "spec", "ref")
+				require.Equal(
+					t,
+					map[string]any{
+						"branch": "main",
+						"commit": "fake-commit",
+					},
+					ref,
+				)
+			},
+		},
+		{
+			name: "Kustomization",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"images": []any{
+						map[string]any{
+							"name":   "ghcr.io/example/image",
+							"newTag": "v1.0.0",
+						},
+						map[string]any{
+							"name":   "ghcr.io/example/unrelated",
+							"newTag": "v0.0.1",
+						},
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindKustomization,
+				Kustomization: &kargoapi.FluxKustomizationUpdate{
+					Images: []kargoapi.FluxKustomizeImageUpdate{
+						{
+							Image:     "ghcr.io/example/image",
+							UseDigest: true,
+						},
+					},
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				images, _, _ := unstructured.NestedSlice(obj, "spec", "images")
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{
+							"name":   "ghcr.io/example/image",
+							"digest": "sha256:abc",
+						},
+						map[string]any{
+							"name":   "ghcr.io/example/unrelated",
+							"newTag": "v0.0.1",
+						},
+					},
+					images,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: testCase.obj}
+			err := applyFluxUpdate(obj, testFreight, testCase.update)
+			testCase.assertions(obj.Object, err)
+		})
+	}
+}
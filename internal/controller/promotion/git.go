@@ -15,6 +15,11 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// maxPushAttempts is the maximum number of times an attempt will be made to
+// push a commit to a remote branch that other writers are concurrently pushing
+// to.
+const maxPushAttempts = 5
+
 // gitMechanism is an implementation of the Mechanism interface that uses Git to
 // update configuration in a repository. It is easily configured to support
 // different types of configuration management tools.
@@ -398,7 +403,7 @@ func (g *gitMechanism) gitCommit(
 				update.RepoURL,
 			)
 		}
-		if err = repo.Push(false); err != nil {
+		if err = pushWithRebase(repo); err != nil {
 			return "", errors.Wrapf(
 				err,
 				"error pushing updates to git repo %q",
@@ -419,6 +424,28 @@ func (g *gitMechanism) gitCommit(
 	return commitID, nil
 }
 
+// pushWithRebase pushes the current branch of the provided repository to the
+// remote branch by the same name. If the push is rejected because another
+// writer has moved the remote branch in the meantime, local commits are
+// rebased onto the new tip of the remote branch and the push is retried, up to
+// maxPushAttempts times in total. An error is returned if the rebase runs into
+// conflicts or the push still fails after the final attempt.
+func pushWithRebase(repo git.Repo) error {
+	for attempt := 1; ; attempt++ {
+		err := repo.Push(false)
+		if err == nil || !errors.Is(err, git.ErrPushRejected) ||
+			attempt == maxPushAttempts {
+			return err
+		}
+		if err = repo.PullRebase(); err != nil {
+			return errors.Wrap(
+				err,
+				"error rebasing updates onto the latest commit from remote branch",
+			)
+		}
+	}
+}
+
 // moveRepoContents transplants the entire contents of the source directory
 // EXCEPT for the .git subdirectory into the destination directory.
 func moveRepoContents(srcDir, destDir string) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	libExec "github.com/akuity/kargo/internal/exec"
 )
 
 func TestNewGitMechanism(t *testing.T) {
@@ -460,6 +462,155 @@ func TestGetRepoCredentials(t *testing.T) {
 	}
 }
 
+func TestPushWithRebase(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pushErrs   []error
+		rebaseErr  error
+		assertions func(repo *fakePushRepo, err error)
+	}{
+		{
+			name: "push succeeds on first attempt",
+			assertions: func(repo *fakePushRepo, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, repo.pushes)
+				require.Zero(t, repo.rebases)
+			},
+		},
+		{
+			name:     "push fails for reason other than rejection",
+			pushErrs: []error{errors.New("something went wrong")},
+			assertions: func(repo *fakePushRepo, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, 1, repo.pushes)
+				require.Zero(t, repo.rebases)
+			},
+		},
+		{
+			name:     "push rejected, then succeeds after rebase",
+			pushErrs: []error{git.ErrPushRejected, git.ErrPushRejected},
+			assertions: func(repo *fakePushRepo, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 3, repo.pushes)
+				require.Equal(t, 2, repo.rebases)
+			},
+		},
+		{
+			name:      "push rejected and rebase conflicts",
+			pushErrs:  []error{git.ErrPushRejected},
+			rebaseErr: errors.New("conflict"),
+			assertions: func(repo *fakePushRepo, err error) {
+				require.ErrorContains(t, err, "error rebasing updates")
+				require.ErrorContains(t, err, "conflict")
+				require.Equal(t, 1, repo.pushes)
+				require.Equal(t, 1, repo.rebases)
+			},
+		},
+		{
+			name: "push rejected on every attempt",
+			pushErrs: []error{
+				git.ErrPushRejected,
+				git.ErrPushRejected,
+				git.ErrPushRejected,
+				git.ErrPushRejected,
+				git.ErrPushRejected,
+			},
+			assertions: func(repo *fakePushRepo, err error) {
+				require.ErrorIs(t, err, git.ErrPushRejected)
+				require.Equal(t, maxPushAttempts, repo.pushes)
+				require.Equal(t, maxPushAttempts-1, repo.rebases)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo := &fakePushRepo{
+				pushErrs:  testCase.pushErrs,
+				rebaseErr: testCase.rebaseErr,
+			}
+			testCase.assertions(repo, pushWithRebase(repo))
+		})
+	}
+}
+
+// fakePushRepo is a git.Repo that only implements pushing and rebasing, and
+// records how many times each was attempted.
+type fakePushRepo struct {
+	git.Repo
+	pushErrs  []error
+	rebaseErr error
+	pushes    int
+	rebases   int
+}
+
+func (f *fakePushRepo) Push(bool) error {
+	f.pushes++
+	if f.pushes <= len(f.pushErrs) {
+		return errors.Wrap(f.pushErrs[f.pushes-1], "error pushing branch")
+	}
+	return nil
+}
+
+func (f *fakePushRepo) PullRebase() error {
+	f.rebases++
+	return f.rebaseErr
+}
+
+func TestPushWithRebaseDivergedClone(t *testing.T) {
+	// Set up a local bare repository to act as the remote, with one commit
+	remoteDir := t.TempDir()
+	_, err := libExec.Exec(exec.Command("git", "init", "--bare", remoteDir))
+	require.NoError(t, err)
+	cloneRemote := func() git.Repo {
+		repo, err := git.Clone(remoteDir, git.RepoCredentials{}, &git.CloneOptions{})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.Close() })
+		return repo
+	}
+	commitFile := func(repo git.Repo, name, content string) {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(repo.WorkingDir(), name),
+			[]byte(content),
+			0600,
+		))
+		require.NoError(t, repo.AddAllAndCommit(fmt.Sprintf("update %s", name)))
+	}
+	seed := cloneRemote()
+	commitFile(seed, "README.md", "hello\n")
+	require.NoError(t, seed.Push(false))
+
+	// Two clones diverge. The second pushes first.
+	repo := cloneRemote()
+	otherRepo := cloneRemote()
+	commitFile(otherRepo, "other.txt", "other\n")
+	require.NoError(t, otherRepo.Push(false))
+
+	// A plain push of the first clone is rejected, and git's explanation is
+	// kept in the error
+	commitFile(repo, "mine.txt", "mine\n")
+	err = repo.Push(false)
+	require.ErrorIs(t, err, git.ErrPushRejected)
+	require.ErrorContains(t, err, "[rejected]")
+
+	// Rebasing onto the remote branch lets the push through, keeping both
+	// clones' changes
+	require.NoError(t, pushWithRebase(repo))
+	check := cloneRemote()
+	for _, name := range []string{"README.md", "other.txt", "mine.txt"} {
+		require.FileExists(t, filepath.Join(check.WorkingDir(), name))
+	}
+
+	// Conflicting changes cannot be rebased
+	commitFile(otherRepo, "README.md", "theirs\n")
+	require.ErrorIs(t, otherRepo.Push(false), git.ErrPushRejected)
+	require.NoError(t, otherRepo.PullRebase())
+	require.NoError(t, otherRepo.Push(false))
+	commitFile(repo, "README.md", "ours\n")
+	err = pushWithRebase(repo)
+	require.ErrorContains(t, err, "error rebasing updates")
+	require.NotErrorIs(t, err, git.ErrPushRejected)
+}
+
 func TestMoveRepoContents(t *testing.T) {
 	const subdirCount = 50
 	const fileCount = 50
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/pkg/errors"
+
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/gitprovider/github"
 )
@@ -199,3 +202,50 @@ func getPullRequestNumberFromMetadata(metadata map[string]string, repoURL string
 	}
 	return intVal
 }
+
+// NewPullRequestCloser returns a function that closes any pull requests that
+// were opened on behalf of the given Promotion and that are still open. This
+// is used when a Promotion is aborted so that changes it proposed can no longer
+// be merged.
+func NewPullRequestCloser(
+	credentialsDB credentials.Database,
+) func(context.Context, *kargoapi.Stage, *kargoapi.Promotion) error {
+	getCredentialsFn := getRepoCredentialsFn(credentialsDB)
+	return func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		promo *kargoapi.Promotion,
+	) error {
+		if stage.Spec.PromotionMechanisms == nil {
+			return nil
+		}
+		for _, update := range stage.Spec.PromotionMechanisms.GitRepoUpdates {
+			if update.PullRequest == nil {
+				continue
+			}
+			prNumber := getPullRequestNumberFromMetadata(promo.Status.Metadata, update.RepoURL)
+			if prNumber == -1 {
+				continue
+			}
+			creds, err := getCredentialsFn(ctx, promo.Namespace, update.RepoURL)
+			if err != nil {
+				return err
+			}
+			gpClient, err := newGitProvider(update.RepoURL, update.PullRequest, creds)
+			if err != nil {
+				return err
+			}
+			pr, err := gpClient.GetPullRequest(ctx, update.RepoURL, prNumber)
+			if err != nil {
+				return errors.Wrapf(err, "error getting pull request %d for repo %q", prNumber, update.RepoURL)
+			}
+			if !pr.IsOpen() {
+				continue
+			}
+			if err = gpClient.ClosePullRequest(ctx, update.RepoURL, prNumber); err != nil {
+				return errors.Wrapf(err, "error closing pull request %d for repo %q", prNumber, update.RepoURL)
+			}
+		}
+		return nil
+	}
+}
@@ -28,6 +28,7 @@ type Mechanism interface {
 // mechanisms.
 func NewMechanisms(
 	argocdClient client.Client,
+	fluxClient client.Client,
 	credentialsDB credentials.Database,
 ) Mechanism {
 	return newCompositeMechanism(
@@ -40,5 +41,6 @@ func NewMechanisms(
 			newHelmMechanism(credentialsDB),
 		),
 		newArgoCDMechanism(argocdClient),
+		newFluxMechanism(fluxClient),
 	)
 }
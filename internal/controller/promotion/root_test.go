@@ -13,6 +13,7 @@ import (
 
 func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
+		fake.NewClientBuilder().Build(),
 		fake.NewClientBuilder().Build(),
 		credentials.NewKubernetesDatabase(nil, credentials.KubernetesDatabaseConfig{}),
 	)
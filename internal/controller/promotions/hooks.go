@@ -0,0 +1,412 @@
+package promotions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// runningHookRequeueInterval is the interval at which a Promotion with a
+// running hook Job is checked on.
+const runningHookRequeueInterval = 10 * time.Second
+
+// promoteWithHooks runs any pre-promotion hooks defined by the Promotion's
+// Stage, followed by the promotion itself, followed by any post-promotion
+// hooks. Hooks are Kubernetes Jobs, so this generally spans multiple
+// reconciliations of the Promotion, with progress being tracked in the
+// Promotion's status.
+func (r *reconciler) promoteWithHooks(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (*kargoapi.PromotionStatus, error) {
+	hooks, err := r.getPromotionHooks(ctx, &promo)
+	if err != nil {
+		return nil, err
+	}
+	if hooks == nil {
+		return r.promoteFn(ctx, promo)
+	}
+
+	// Before the first hook Job is created, make sure the promotion itself can
+	// go ahead. Hooks must not run for Freight that is unavailable to the
+	// Stage or that the Stage already has.
+	if len(promo.Status.Hooks) == 0 {
+		_, targetFreight, err := r.getPromotionTargetFn(ctx, promo)
+		if err != nil {
+			return nil, err
+		}
+		if targetFreight == nil {
+			return &kargoapi.PromotionStatus{
+				Phase:   kargoapi.PromotionPhaseSucceeded,
+				Message: "Stage already has the desired Freight",
+			}, nil
+		}
+	}
+
+	hookStatuses := make([]kargoapi.PromotionHookStatus, len(promo.Status.Hooks))
+	copy(hookStatuses, promo.Status.Hooks)
+
+	var newStatus *kargoapi.PromotionStatus
+	// If any post-promotion hook has been started, the promotion itself has
+	// already succeeded and must not be repeated.
+	if !hasHooksOfType(hookStatuses, kargoapi.PromotionHookTypePostPromotion) {
+		var phase kargoapi.PromotionHookPhase
+		if phase, hookStatuses, err = r.runHooks(
+			ctx,
+			&promo,
+			kargoapi.PromotionHookTypePrePromotion,
+			hooks.PrePromotion,
+			hookStatuses,
+		); err != nil {
+			return nil, err
+		}
+		switch phase {
+		case kargoapi.PromotionHookPhaseRunning:
+			newStatus = promo.Status.WithPhase(kargoapi.PromotionPhaseRunning)
+			newStatus.Hooks = hookStatuses
+			return newStatus, nil
+		case kargoapi.PromotionHookPhaseFailed:
+			newStatus = promo.Status.WithPhase(kargoapi.PromotionPhaseFailed)
+			newStatus.Message = hookFailureMessage(hookStatuses)
+			newStatus.Hooks = hookStatuses
+			return newStatus, nil
+		}
+		if newStatus, err = r.promoteFn(ctx, promo); err != nil {
+			return nil, err
+		}
+		newStatus.Hooks = hookStatuses
+		if newStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+			return newStatus, nil
+		}
+	} else {
+		newStatus = promo.Status.DeepCopy()
+	}
+
+	phase, hookStatuses, err := r.runHooks(
+		ctx,
+		&promo,
+		kargoapi.PromotionHookTypePostPromotion,
+		hooks.PostPromotion,
+		newStatus.Hooks,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newStatus.Hooks = hookStatuses
+	switch phase {
+	case kargoapi.PromotionHookPhaseRunning:
+		newStatus.Phase = kargoapi.PromotionPhaseRunning
+	case kargoapi.PromotionHookPhaseFailed:
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = hookFailureMessage(hookStatuses)
+	default:
+		newStatus.Phase = kargoapi.PromotionPhaseSucceeded
+	}
+	return newStatus, nil
+}
+
+// getPromotionHooks returns the hooks of the Stage the given Promotion applies
+// to. If the Stage does not exist or has no hooks, nil is returned.
+func (r *reconciler) getPromotionHooks(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.PromotionHooks, error) {
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error finding Stage %q in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+	if stage == nil || stage.Spec == nil {
+		return nil, nil
+	}
+	return stage.Spec.Hooks, nil
+}
+
+// runHooks runs the given hooks of the given type, one at a time and in order,
+// updating the provided hook statuses as it goes. The returned phase is
+// Succeeded only once all hooks have succeeded. It is Failed as soon as any
+// hook has failed and Running otherwise.
+func (r *reconciler) runHooks(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	hookType kargoapi.PromotionHookType,
+	hooks []kargoapi.PromotionHook,
+	hookStatuses []kargoapi.PromotionHookStatus,
+) (kargoapi.PromotionHookPhase, []kargoapi.PromotionHookStatus, error) {
+	logger := logging.LoggerFromContext(ctx)
+	for _, hook := range hooks {
+		idx := -1
+		for i, hookStatus := range hookStatuses {
+			if hookStatus.Type == hookType && hookStatus.Name == hook.Name {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			jobName, err := r.createHookJob(ctx, promo, hookType, hook)
+			if err != nil {
+				return "", hookStatuses, err
+			}
+			logger.WithField("job", jobName).Debugf("started %s hook %q", hookType, hook.Name)
+			hookStatuses = append(
+				hookStatuses,
+				kargoapi.PromotionHookStatus{
+					Name:    hook.Name,
+					Type:    hookType,
+					JobName: jobName,
+					Phase:   kargoapi.PromotionHookPhaseRunning,
+				},
+			)
+			return kargoapi.PromotionHookPhaseRunning, hookStatuses, nil
+		}
+		hookStatus := &hookStatuses[idx]
+		if hookStatus.Phase == kargoapi.PromotionHookPhaseRunning {
+			var err error
+			if hookStatus.Phase, hookStatus.Message, err = r.getHookJobPhase(
+				ctx,
+				promo.Namespace,
+				hookStatus.JobName,
+			); err != nil {
+				return "", hookStatuses, err
+			}
+		}
+		if hookStatus.Phase != kargoapi.PromotionHookPhaseSucceeded {
+			return hookStatus.Phase, hookStatuses, nil
+		}
+	}
+	return kargoapi.PromotionHookPhaseSucceeded, hookStatuses, nil
+}
+
+// createHookJob creates a Kubernetes Job for the given hook from the hook's
+// Job template, injecting information about the Promotion and its Freight
+// into every container as environment variables. The Job is owned by the
+// Promotion. The name of the Job is returned.
+func (r *reconciler) createHookJob(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	hookType kargoapi.PromotionHookType,
+	hook kargoapi.PromotionHook,
+) (string, error) {
+	freight, err := kargoapi.GetFreight(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+	if freight == nil {
+		return "", errors.Errorf(
+			"Freight %q not found in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+
+	env, err := hookEnv(promo, freight)
+	if err != nil {
+		return "", err
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: *hook.JobTemplate.ObjectMeta.DeepCopy(),
+		Spec:       *hook.JobTemplate.Spec.DeepCopy(),
+	}
+	job.Namespace = promo.Namespace
+	job.Name = hookJobName(promo.Name, hookType, hook.Name)
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[kargoapi.StageLabelKey] = promo.Spec.Stage
+	job.Labels[kargoapi.FreightLabelKey] = promo.Spec.Freight
+	job.OwnerReferences = append(
+		job.OwnerReferences,
+		*metav1.NewControllerRef(promo, kargoapi.GroupVersion.WithKind("Promotion")),
+	)
+	podSpec := &job.Spec.Template.Spec
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Env = append(podSpec.InitContainers[i].Env, env...)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env...)
+	}
+
+	if err = r.jobsClient.Create(ctx, job); err != nil && !kubeerr.IsAlreadyExists(err) {
+		return "", errors.Wrapf(
+			err,
+			"error creating Job for %s hook %q in namespace %q",
+			hookType,
+			hook.Name,
+			promo.Namespace,
+		)
+	}
+	return job.Name, nil
+}
+
+// getHookJobPhase returns the phase of the hook implemented by the specified
+// Job, along with a message explaining any failure.
+func (r *reconciler) getHookJobPhase(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (kargoapi.PromotionHookPhase, string, error) {
+	job := &batchv1.Job{}
+	if err := r.jobsClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		job,
+	); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return kargoapi.PromotionHookPhaseFailed,
+				fmt.Sprintf("Job %q no longer exists", name), nil
+		}
+		return "", "", errors.Wrapf(
+			err,
+			"error getting Job %q in namespace %q",
+			name,
+			namespace,
+		)
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return kargoapi.PromotionHookPhaseSucceeded, "", nil
+		case batchv1.JobFailed:
+			return kargoapi.PromotionHookPhaseFailed, cond.Message, nil
+		}
+	}
+	return kargoapi.PromotionHookPhaseRunning, "", nil
+}
+
+// hookEnv returns the environment variables through which information about
+// the given Promotion and Freight is made available to hooks.
+func hookEnv(
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+) ([]corev1.EnvVar, error) {
+	// Empty JSON arrays are friendlier to hooks than nulls
+	if freight.Commits == nil {
+		freight.Commits = []kargoapi.GitCommit{}
+	}
+	if freight.Images == nil {
+		freight.Images = []kargoapi.Image{}
+	}
+	if freight.Charts == nil {
+		freight.Charts = []kargoapi.Chart{}
+	}
+	commits, err := json.Marshal(freight.Commits)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight commits")
+	}
+	images, err := json.Marshal(freight.Images)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight images")
+	}
+	charts, err := json.Marshal(freight.Charts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight charts")
+	}
+	return []corev1.EnvVar{
+		{Name: "KARGO_PROJECT", Value: promo.Namespace},
+		{Name: "KARGO_STAGE", Value: promo.Spec.Stage},
+		{Name: "KARGO_PROMOTION", Value: promo.Name},
+		{Name: "KARGO_FREIGHT", Value: freight.Name},
+		{Name: "KARGO_FREIGHT_COMMITS", Value: string(commits)},
+		{Name: "KARGO_FREIGHT_IMAGES", Value: string(images)},
+		{Name: "KARGO_FREIGHT_CHARTS", Value: string(charts)},
+	}, nil
+}
+
+// hookJobName deterministically derives the name of the Job for the given
+// hook of the given Promotion. Including a hash of all inputs keeps the name
+// short enough to be used as a label value while still being unique.
+func hookJobName(
+	promoName string,
+	hookType kargoapi.PromotionHookType,
+	hookName string,
+) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", promoName, hookType, hookName)))
+	return fmt.Sprintf("%s-%x", hookName, sum[:5])
+}
+
+// hasHooksOfType answers whether any of the given hook statuses are of the
+// given type.
+func hasHooksOfType(
+	hookStatuses []kargoapi.PromotionHookStatus,
+	hookType kargoapi.PromotionHookType,
+) bool {
+	for _, hookStatus := range hookStatuses {
+		if hookStatus.Type == hookType {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRunningHooks answers whether any of the given hook statuses are Running.
+func hasRunningHooks(hookStatuses []kargoapi.PromotionHookStatus) bool {
+	for _, hookStatus := range hookStatuses {
+		if hookStatus.Phase == kargoapi.PromotionHookPhaseRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// hookFailureMessage returns a message describing the first failed hook among
+// the given hook statuses.
+func hookFailureMessage(hookStatuses []kargoapi.PromotionHookStatus) string {
+	for _, hookStatus := range hookStatuses {
+		if hookStatus.Phase != kargoapi.PromotionHookPhaseFailed {
+			continue
+		}
+		msg := fmt.Sprintf(
+			"%s hook %q failed (Job %q)",
+			hookStatus.Type,
+			hookStatus.Name,
+			hookStatus.JobName,
+		)
+		if hookStatus.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, hookStatus.Message)
+		}
+		return msg
+	}
+	return ""
+}
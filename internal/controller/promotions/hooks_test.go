@@ -0,0 +1,404 @@
+package promotions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPromoteWithHooks(t *testing.T) {
+	jobTemplate := batchv1.JobTemplateSpec{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "fake-container"}},
+				},
+			},
+		},
+	}
+	hooks := &kargoapi.PromotionHooks{
+		PrePromotion: []kargoapi.PromotionHook{
+			{Name: "migrate", JobTemplate: jobTemplate},
+		},
+		PostPromotion: []kargoapi.PromotionHook{
+			{Name: "smoke-test", JobTemplate: jobTemplate},
+		},
+	}
+	promo := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	newJob := func(
+		hookType kargoapi.PromotionHookType,
+		hookName string,
+		condType batchv1.JobConditionType,
+	) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      hookJobName(promo.Name, hookType, hookName),
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:    condType,
+					Status:  corev1.ConditionTrue,
+					Message: "fake message",
+				}},
+			},
+		}
+	}
+	testCases := []struct {
+		name         string
+		hooks        *kargoapi.PromotionHooks
+		hookStatuses []kargoapi.PromotionHookStatus
+		jobs         []client.Object
+		targetErr    error
+		noTarget     bool
+		assertions   func(*kargoapi.PromotionStatus, bool, error)
+	}{
+		{
+			name: "no hooks",
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.True(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Empty(t, status.Hooks)
+			},
+		},
+		{
+			name:      "Freight not available",
+			hooks:     hooks,
+			targetErr: errors.New("something went wrong"),
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, promoted)
+				require.Nil(t, status)
+			},
+		},
+		{
+			name:     "Stage already has the Freight",
+			hooks:    hooks,
+			noTarget: true,
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.False(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, "Stage already has the desired Freight", status.Message)
+				require.Empty(t, status.Hooks)
+			},
+		},
+		{
+			name:  "pre-promotion hook started",
+			hooks: hooks,
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.False(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.PromotionHookStatus{{
+						Name: "migrate",
+						Type: kargoapi.PromotionHookTypePrePromotion,
+						JobName: hookJobName(
+							promo.Name,
+							kargoapi.PromotionHookTypePrePromotion,
+							"migrate",
+						),
+						Phase: kargoapi.PromotionHookPhaseRunning,
+					}},
+					status.Hooks,
+				)
+			},
+		},
+		{
+			name:  "pre-promotion hook failed",
+			hooks: hooks,
+			hookStatuses: []kargoapi.PromotionHookStatus{{
+				Name: "migrate",
+				Type: kargoapi.PromotionHookTypePrePromotion,
+				JobName: hookJobName(
+					promo.Name,
+					kargoapi.PromotionHookTypePrePromotion,
+					"migrate",
+				),
+				Phase: kargoapi.PromotionHookPhaseRunning,
+			}},
+			jobs: []client.Object{
+				newJob(kargoapi.PromotionHookTypePrePromotion, "migrate", batchv1.JobFailed),
+			},
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.False(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, `PrePromotion hook "migrate" failed`)
+				require.Contains(t, status.Message, "fake message")
+				require.Len(t, status.Hooks, 1)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, status.Hooks[0].Phase)
+			},
+		},
+		{
+			name:  "pre-promotion hook succeeded; post-promotion hook started",
+			hooks: hooks,
+			hookStatuses: []kargoapi.PromotionHookStatus{{
+				Name: "migrate",
+				Type: kargoapi.PromotionHookTypePrePromotion,
+				JobName: hookJobName(
+					promo.Name,
+					kargoapi.PromotionHookTypePrePromotion,
+					"migrate",
+				),
+				Phase: kargoapi.PromotionHookPhaseRunning,
+			}},
+			jobs: []client.Object{
+				newJob(kargoapi.PromotionHookTypePrePromotion, "migrate", batchv1.JobComplete),
+			},
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.True(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Len(t, status.Hooks, 2)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, status.Hooks[0].Phase)
+				require.Equal(t, kargoapi.PromotionHookTypePostPromotion, status.Hooks[1].Type)
+				require.Equal(t, kargoapi.PromotionHookPhaseRunning, status.Hooks[1].Phase)
+			},
+		},
+		{
+			name:  "post-promotion hook succeeded",
+			hooks: hooks,
+			hookStatuses: []kargoapi.PromotionHookStatus{
+				{
+					Name:  "migrate",
+					Type:  kargoapi.PromotionHookTypePrePromotion,
+					Phase: kargoapi.PromotionHookPhaseSucceeded,
+				},
+				{
+					Name: "smoke-test",
+					Type: kargoapi.PromotionHookTypePostPromotion,
+					JobName: hookJobName(
+						promo.Name,
+						kargoapi.PromotionHookTypePostPromotion,
+						"smoke-test",
+					),
+					Phase: kargoapi.PromotionHookPhaseRunning,
+				},
+			},
+			jobs: []client.Object{
+				newJob(kargoapi.PromotionHookTypePostPromotion, "smoke-test", batchv1.JobComplete),
+			},
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.False(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, status.Hooks[1].Phase)
+			},
+		},
+		{
+			name:  "post-promotion hook failed",
+			hooks: hooks,
+			hookStatuses: []kargoapi.PromotionHookStatus{
+				{
+					Name:  "migrate",
+					Type:  kargoapi.PromotionHookTypePrePromotion,
+					Phase: kargoapi.PromotionHookPhaseSucceeded,
+				},
+				{
+					Name: "smoke-test",
+					Type: kargoapi.PromotionHookTypePostPromotion,
+					JobName: hookJobName(
+						promo.Name,
+						kargoapi.PromotionHookTypePostPromotion,
+						"smoke-test",
+					),
+					Phase: kargoapi.PromotionHookPhaseRunning,
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, promoted bool, err error) {
+				require.NoError(t, err)
+				require.False(t, promoted)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, `PostPromotion hook "smoke-test" failed`)
+				require.Contains(t, status.Message, "no longer exists")
+			},
+		},
+	}
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	jobsScheme := k8sruntime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(jobsScheme))
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+				Spec: &kargoapi.StageSpec{
+					Hooks: testCase.hooks,
+				},
+			}
+			freight := &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-freight",
+				},
+			}
+			var promoted bool
+			r := &reconciler{
+				kargoClient: fake.NewClientBuilder().WithScheme(scheme).
+					WithObjects(stage, freight).Build(),
+				jobsClient: fake.NewClientBuilder().WithScheme(jobsScheme).
+					WithObjects(testCase.jobs...).Build(),
+				getPromotionTargetFn: func(
+					context.Context,
+					kargoapi.Promotion,
+				) (*kargoapi.Stage, *kargoapi.FreightReference, error) {
+					if testCase.targetErr != nil {
+						return nil, nil, testCase.targetErr
+					}
+					if testCase.noTarget {
+						return stage, nil, nil
+					}
+					return stage, &kargoapi.FreightReference{ID: "fake-freight"}, nil
+				},
+				promoteFn: func(
+					context.Context,
+					kargoapi.Promotion,
+				) (*kargoapi.PromotionStatus, error) {
+					promoted = true
+					return &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseSucceeded,
+					}, nil
+				},
+			}
+			p := *promo.DeepCopy()
+			p.Status.Phase = kargoapi.PromotionPhaseRunning
+			p.Status.Hooks = testCase.hookStatuses
+			status, err := r.promoteWithHooks(context.Background(), p)
+			testCase.assertions(status, promoted, err)
+		})
+	}
+}
+
+func TestCreateHookJob(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	jobsScheme := k8sruntime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(jobsScheme))
+	r := &reconciler{
+		kargoClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-freight",
+				},
+				Images: []kargoapi.Image{{RepoURL: "fake-repo", Tag: "fake-tag"}},
+			},
+		).Build(),
+		jobsClient: fake.NewClientBuilder().WithScheme(jobsScheme).Build(),
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	jobName, err := r.createHookJob(
+		context.Background(),
+		promo,
+		kargoapi.PromotionHookTypePrePromotion,
+		kargoapi.PromotionHook{
+			Name: "fake-hook",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "fake-container",
+								Env:  []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	job := &batchv1.Job{}
+	require.NoError(
+		t,
+		r.jobsClient.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: "fake-namespace", Name: jobName},
+			job,
+		),
+	)
+	require.Equal(t, "fake-stage", job.Labels[kargoapi.StageLabelKey])
+	require.Len(t, job.OwnerReferences, 1)
+	require.Equal(t, "fake-promo", job.OwnerReferences[0].Name)
+	env := map[string]string{}
+	for _, e := range job.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	require.Equal(t, "bar", env["FOO"])
+	require.Equal(t, "fake-namespace", env["KARGO_PROJECT"])
+	require.Equal(t, "fake-stage", env["KARGO_STAGE"])
+	require.Equal(t, "fake-promo", env["KARGO_PROMOTION"])
+	require.Equal(t, "fake-freight", env["KARGO_FREIGHT"])
+	require.Equal(t, "[]", env["KARGO_FREIGHT_COMMITS"])
+	require.Equal(
+		t,
+		`[{"repoURL":"fake-repo","tag":"fake-tag"}]`,
+		env["KARGO_FREIGHT_IMAGES"],
+	)
+
+	// Creating the same Job again should not fail
+	_, err = r.createHookJob(
+		context.Background(),
+		promo,
+		kargoapi.PromotionHookTypePrePromotion,
+		kargoapi.PromotionHook{Name: "fake-hook"},
+	)
+	require.NoError(t, err)
+}
+
+func TestHookJobName(t *testing.T) {
+	name := hookJobName("fake-promo", kargoapi.PromotionHookTypePrePromotion, "fake-hook")
+	require.Equal(
+		t,
+		name,
+		hookJobName("fake-promo", kargoapi.PromotionHookTypePrePromotion, "fake-hook"),
+	)
+	require.NotEqual(
+		t,
+		name,
+		hookJobName("fake-promo", kargoapi.PromotionHookTypePostPromotion, "fake-hook"),
+	)
+	require.NotEqual(
+		t,
+		name,
+		hookJobName("another-fake-promo", kargoapi.PromotionHookTypePrePromotion, "fake-hook"),
+	)
+	require.Regexp(t, "^fake-hook-[0-9a-f]{10}$", name)
+}
@@ -3,11 +3,15 @@ package promotions
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,9 +29,25 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+const (
+	// defaultPromotionRetryBackoff is the amount of time to wait before the
+	// first retry of an Errored Promotion if the Stage's promotion policy does
+	// not specify one.
+	defaultPromotionRetryBackoff = 10 * time.Second
+	// maxPromotionRetryBackoff caps the amount of time to wait before retrying
+	// an Errored Promotion, regardless of how many attempts have been made.
+	maxPromotionRetryBackoff = time.Hour
+	// runningPromotionRequeueInterval is the interval at which a Running
+	// Promotion is checked on.
+	//
+	// TODO: Make this configurable
+	runningPromotionRequeueInterval = 5 * time.Minute
+)
+
 // reconciler reconciles Promotion resources.
 type reconciler struct {
 	kargoClient     client.Client
+	jobsClient      client.Client
 	promoMechanisms promotion.Mechanism
 
 	pqs            *promoQueues
@@ -35,7 +55,18 @@ type reconciler struct {
 
 	// The following behaviors are overridable for testing purposes:
 
+	getPromotionTargetFn func(
+		context.Context,
+		kargoapi.Promotion,
+	) (*kargoapi.Stage, *kargoapi.FreightReference, error)
+
 	promoteFn func(context.Context, kargoapi.Promotion) (*kargoapi.PromotionStatus, error)
+
+	closePullRequestsFn func(
+		context.Context,
+		*kargoapi.Stage,
+		*kargoapi.Promotion,
+	) error
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -44,6 +75,8 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	fluxClient client.Client,
+	jobsClient client.Client,
 	credentialsDB credentials.Database,
 	shardName string,
 ) error {
@@ -61,6 +94,8 @@ func SetupReconcilerWithManager(
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		argocdClient,
+		fluxClient,
+		jobsClient,
 		credentialsDB,
 	)
 
@@ -105,6 +140,8 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	fluxClient client.Client,
+	jobsClient client.Client,
 	credentialsDB credentials.Database,
 ) *reconciler {
 	pqs := promoQueues{
@@ -113,13 +150,17 @@ func newReconciler(
 	}
 	r := &reconciler{
 		kargoClient: kargoClient,
+		jobsClient:  jobsClient,
 		pqs:         &pqs,
 		promoMechanisms: promotion.NewMechanisms(
 			argocdClient,
+			fluxClient,
 			credentialsDB,
 		),
 	}
+	r.getPromotionTargetFn = r.getPromotionTarget
 	r.promoteFn = r.promote
+	r.closePullRequestsFn = promotion.NewPullRequestCloser(credentialsDB)
 	return r
 }
 
@@ -170,6 +211,10 @@ func (r *reconciler) Reconcile(
 		"freight":   promo.Spec.Freight,
 	})
 
+	if _, ok := promo.Annotations[kargoapi.AnnotationKeyAbort]; ok {
+		return ctrl.Result{}, r.abort(logging.ContextWithLogger(ctx, logger), promo)
+	}
+
 	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
 		logger.Debug("continuing Promotion")
@@ -193,11 +238,50 @@ func (r *reconciler) Reconcile(
 	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhaseRunning
+			if status.StartedAt == nil {
+				status.StartedAt = &metav1.Time{Time: time.Now()}
+			}
 		}); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	policy, err := r.getPromotionPolicy(ctx, promo)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if remaining, ok := promotionTimeRemaining(policy, promo.Status, time.Now()); ok && remaining <= 0 {
+		err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+			status.Phase = kargoapi.PromotionPhaseFailed
+			status.Message = fmt.Sprintf(
+				"Promotion timed out after %s",
+				policy.Timeout.Duration,
+			)
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		logger.Infof("promotion %s: timed out", kargoapi.PromotionPhaseFailed)
+		stage, err := kargoapi.GetStage(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.resetStage(ctx, stage, promo)
+	}
+
+	if wait := promotionRetryWait(policy, promo.Status, time.Now()); wait > 0 {
+		logger.Debugf("waiting %s before retrying Promotion", wait)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
 	promoCtx := logging.ContextWithLogger(ctx, logger)
 
 	newStatus := promo.Status.DeepCopy()
@@ -213,7 +297,7 @@ func (r *reconciler) Reconcile(
 				newStatus.Message = fmt.Sprintf("%v", err)
 			}
 		}()
-		otherStatus, promoteErr := r.promoteFn(
+		otherStatus, promoteErr := r.promoteWithHooks(
 			promoCtx,
 			*promo,
 		)
@@ -226,6 +310,34 @@ func (r *reconciler) Reconcile(
 		}
 	}()
 
+	// Mechanisms do not concern themselves with bookkeeping related to the
+	// promotion policy, so carry it over from the existing status.
+	newStatus.StartedAt = promo.Status.StartedAt
+	newStatus.Attempts = promo.Status.Attempts
+
+	var retryAfter time.Duration
+	if newStatus.Phase == kargoapi.PromotionPhaseErrored &&
+		policy != nil && policy.Retry != nil &&
+		len(newStatus.Attempts) < int(policy.Retry.Limit) {
+		newStatus.Attempts = append(
+			newStatus.Attempts,
+			kargoapi.PromotionAttempt{
+				FinishedAt: metav1.Now(),
+				Message:    newStatus.Message,
+			},
+		)
+		retryAfter = promotionRetryBackoff(policy, len(newStatus.Attempts))
+		newStatus.Phase = kargoapi.PromotionPhaseRunning
+		newStatus.Message = fmt.Sprintf(
+			"attempt %d of %d ended in an error; retrying in %s: %s",
+			len(newStatus.Attempts),
+			policy.Retry.Limit+1,
+			retryAfter,
+			newStatus.Message,
+		)
+		logger.Infof("promotion attempt errored; retrying in %s", retryAfter)
+	}
+
 	if newStatus.Phase.IsTerminal() {
 		logger.Infof("promotion %s", newStatus.Phase)
 	}
@@ -247,14 +359,182 @@ func (r *reconciler) Reconcile(
 
 	// If the promotion is still running, we'll need to periodically check on
 	// it.
-	//
-	// TODO: Make this configurable
 	if newStatus.Phase == kargoapi.PromotionPhaseRunning {
-		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+		requeueAfter := runningPromotionRequeueInterval
+		if retryAfter > 0 {
+			requeueAfter = retryAfter
+		} else if hasRunningHooks(newStatus.Hooks) {
+			requeueAfter = runningHookRequeueInterval
+		}
+		if remaining, ok := promotionTimeRemaining(policy, *newStatus, time.Now()); ok && remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 	return ctrl.Result{}, nil
 }
 
+// getPromotionPolicy returns the promotion policy of the Stage the given
+// Promotion applies to. If the Stage does not exist or has no promotion policy,
+// nil is returned.
+func (r *reconciler) getPromotionPolicy(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.StagePromotionPolicy, error) {
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if stage == nil || stage.Spec == nil {
+		return nil, nil
+	}
+	return stage.Spec.PromotionPolicy, nil
+}
+
+// promotionTimeRemaining returns the amount of time a Running Promotion with
+// the given status may continue to run before it times out in accordance with
+// the given policy. The second return value is false if no timeout applies.
+func promotionTimeRemaining(
+	policy *kargoapi.StagePromotionPolicy,
+	status kargoapi.PromotionStatus,
+	now time.Time,
+) (time.Duration, bool) {
+	if policy == nil || policy.Timeout == nil || status.StartedAt == nil {
+		return 0, false
+	}
+	return status.StartedAt.Add(policy.Timeout.Duration).Sub(now), true
+}
+
+// promotionRetryBackoff returns the amount of time to wait before retrying a
+// Promotion after the given number of attempts have ended in an error. The
+// backoff specified by the policy is doubled for each attempt after the first.
+func promotionRetryBackoff(
+	policy *kargoapi.StagePromotionPolicy,
+	attempts int,
+) time.Duration {
+	backoff := defaultPromotionRetryBackoff
+	if policy != nil && policy.Retry != nil &&
+		policy.Retry.Backoff != nil && policy.Retry.Backoff.Duration > 0 {
+		backoff = policy.Retry.Backoff.Duration
+	}
+	for i := 1; i < attempts && backoff < maxPromotionRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxPromotionRetryBackoff {
+		backoff = maxPromotionRetryBackoff
+	}
+	return backoff
+}
+
+// promotionRetryWait returns the amount of time remaining before a Promotion
+// with the given status may be retried following an attempt that ended in an
+// error. Zero is returned if the Promotion may be (re)attempted immediately.
+func promotionRetryWait(
+	policy *kargoapi.StagePromotionPolicy,
+	status kargoapi.PromotionStatus,
+	now time.Time,
+) time.Duration {
+	if len(status.Attempts) == 0 {
+		return 0
+	}
+	lastAttempt := status.Attempts[len(status.Attempts)-1]
+	nextAttempt := lastAttempt.FinishedAt.Add(
+		promotionRetryBackoff(policy, len(status.Attempts)),
+	)
+	if wait := nextAttempt.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// abort moves a non-terminal Promotion into the Aborted phase. If the
+// Promotion was already Running, any pull requests it opened are closed on a
+// best-effort basis. Note that an aborted Promotion that is still in its
+// Stage's pending queue is discarded from that queue once the next Promotion
+// for that Stage is selected.
+func (r *reconciler) abort(ctx context.Context, promo *kargoapi.Promotion) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	var stage *kargoapi.Stage
+	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
+		var err error
+		if stage, err = kargoapi.GetStage(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		); err != nil {
+			return errors.Wrap(err, "error finding Stage for aborted Promotion")
+		}
+		if stage != nil {
+			if err = r.closePullRequestsFn(ctx, stage, promo); err != nil {
+				logger.Errorf("error closing pull requests for aborted Promotion: %s", err)
+			}
+		}
+	}
+
+	if err := kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		status.Phase = kargoapi.PromotionPhaseAborted
+		status.Message = "Promotion was aborted"
+	}); err != nil {
+		return err
+	}
+	logger.Infof("promotion %s", kargoapi.PromotionPhaseAborted)
+
+	if err := r.resetStage(ctx, stage, promo); err != nil {
+		return err
+	}
+
+	if err := kargoapi.ClearPromotionRefresh(ctx, r.kargoClient, promo); err != nil {
+		logger.Errorf("error clearing Promotion refresh annotation: %s", err)
+	}
+	return nil
+}
+
+// resetStage moves a Stage out of the Promoting phase after the specified
+// Running Promotion ended without the promotion process itself moving the
+// Stage on, i.e. because the Promotion was aborted or timed out. The Stage
+// reconciler does not do this, since it only ever moves Stages out of the
+// Verifying phase. The Stage is returned to Steady if it has current Freight
+// and to NotApplicable otherwise. Stages that are not being promoted by the
+// specified Promotion are left alone.
+func (r *reconciler) resetStage(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+) error {
+	if stage == nil || stage.Status.Phase != kargoapi.StagePhasePromoting {
+		return nil
+	}
+	if cur := stage.Status.CurrentPromotion; cur != nil && cur.Name != promo.Name {
+		return nil
+	}
+	if err := kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+		status.Phase = kargoapi.StagePhaseSteady
+		if status.CurrentFreight == nil {
+			status.Phase = kargoapi.StagePhaseNotApplicable
+		}
+		status.CurrentPromotion = nil
+	}); err != nil {
+		return errors.Wrapf(
+			err,
+			"error resetting status of Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	return nil
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -262,6 +542,79 @@ func (r *reconciler) promote(
 	logger := logging.LoggerFromContext(ctx)
 	stageName := promo.Spec.Stage
 	stageNamespace := promo.Namespace
+
+	stage, targetFreight, err := r.getPromotionTargetFn(ctx, promo)
+	if err != nil {
+		return nil, err
+	}
+	if targetFreight == nil {
+		return &kargoapi.PromotionStatus{
+			Phase:   kargoapi.PromotionPhaseSucceeded,
+			Message: "Stage already has the desired Freight",
+		}, nil
+	}
+	simpleTargetFreight := *targetFreight
+
+	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+		status.Phase = kargoapi.StagePhasePromoting
+		status.CurrentPromotion = &kargoapi.PromotionInfo{
+			Name:    promo.Name,
+			Freight: simpleTargetFreight,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newStatus, nextFreight, err := r.promoMechanisms.Promote(ctx, stage, &promo, simpleTargetFreight)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debugf("promotion %s", newStatus.Phase)
+
+	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+		// Only update Stage status if the promotion succeeded
+		// The assumption is that controller does not process multiple promotions in one stage
+		// so we are safe from race conditions and can just update the status
+		// TODO: remove all patching of Stage status out of promo reconciler
+		err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+			status.Phase = kargoapi.StagePhaseVerifying
+			status.CurrentPromotion = nil
+			// control-flow Stage history is maintained in Stage controller.
+			// So we only modify history for normal Stages.
+			// (Technically, we should prevent creating promotion jobs on
+			// control-flow stages in the first place)
+			if stage.Spec.PromotionMechanisms != nil {
+				status.CurrentFreight = &nextFreight
+				status.History.Push(nextFreight)
+			}
+		})
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error updating status of Stage %q in namespace %q",
+				stageName,
+				stageNamespace,
+			)
+		}
+	}
+
+	return newStatus, nil
+}
+
+// getPromotionTarget returns the Stage the specified Promotion applies to,
+// along with the Freight, composite or otherwise, that the Stage should be
+// promoted to. An error is returned if the Stage or the Promotion's Freight
+// cannot be found or if the Freight is not available to the Stage. If the
+// Stage already has the Promotion's Freight, the returned Freight is nil.
+func (r *reconciler) getPromotionTarget(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (*kargoapi.Stage, *kargoapi.FreightReference, error) {
+	logger := logging.LoggerFromContext(ctx)
+	stageName := promo.Spec.Stage
+	stageNamespace := promo.Namespace
 	freightName := promo.Spec.Freight
 
 	stage, err := kargoapi.GetStage(
@@ -273,7 +626,7 @@ func (r *reconciler) promote(
 		},
 	)
 	if err != nil {
-		return nil, errors.Wrapf(
+		return nil, nil, errors.Wrapf(
 			err,
 			"error finding Stage %q in namespace %q",
 			stageName,
@@ -281,7 +634,7 @@ func (r *reconciler) promote(
 		)
 	}
 	if stage == nil {
-		return nil, errors.Errorf(
+		return nil, nil, errors.Errorf(
 			"could not find Stage %q in namespace %q",
 			stageName,
 			stageNamespace,
@@ -289,11 +642,8 @@ func (r *reconciler) promote(
 	}
 	logger.Debug("found associated Stage")
 
-	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID == freightName {
-		return &kargoapi.PromotionStatus{
-			Phase:   kargoapi.PromotionPhaseSucceeded,
-			Message: "Stage already has the desired Freight",
-		}, nil
+	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.Includes(freightName) {
+		return stage, nil, nil
 	}
 
 	targetFreight, err := kargoapi.GetFreight(
@@ -305,25 +655,26 @@ func (r *reconciler) promote(
 		},
 	)
 	if err != nil {
-		return nil, errors.Wrapf(
+		return nil, nil, errors.Wrapf(
 			err,
 			"error finding Freight %q in namespace %q",
 			promo.Spec.Freight, promo.Namespace,
 		)
 	}
 	if targetFreight == nil {
-		return nil, errors.Errorf(
+		return nil, nil, errors.Errorf(
 			"Freight %q not found in namespace %q",
 			promo.Spec.Freight,
 			promo.Namespace,
 		)
 	}
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	if !kargoapi.IsFreightAvailable(targetFreight, stageName, upstreamStages) {
-		return nil, errors.Errorf(
+	if !kargoapi.IsFreightAvailable(
+		targetFreight,
+		stageName,
+		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
+	) {
+		return nil, nil, errors.Errorf(
 			"Freight %q is not available to Stage %q in namespace %q",
 			promo.Spec.Freight,
 			stageName,
@@ -337,51 +688,105 @@ func (r *reconciler) promote(
 		Images:  targetFreight.Images,
 		Charts:  targetFreight.Charts,
 	}
-
-	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
-		status.Phase = kargoapi.StagePhasePromoting
-		status.CurrentPromotion = &kargoapi.PromotionInfo{
-			Name:    promo.Name,
-			Freight: simpleTargetFreight,
+	if len(stage.Spec.Subscriptions.Warehouses) > 0 {
+		if simpleTargetFreight, err = r.composeTargetFreight(
+			ctx,
+			stage,
+			targetFreight,
+		); err != nil {
+			return nil, nil, err
 		}
-	})
-	if err != nil {
-		return nil, err
 	}
 
-	newStatus, nextFreight, err := r.promoMechanisms.Promote(ctx, stage, &promo, simpleTargetFreight)
-	if err != nil {
-		return nil, err
-	}
+	return stage, &simpleTargetFreight, nil
+}
 
-	logger.Debugf("promotion %s", newStatus.Phase)
+// composeTargetFreight returns a composite FreightReference for a Stage that
+// subscribes to multiple Warehouses. The composite combines the provided
+// Freight with the latest Freight from every other Warehouse the Stage
+// subscribes to, so that a single Promotion brings the Stage up to date with
+// all of them.
+func (r *reconciler) composeTargetFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	targetFreight *kargoapi.Freight,
+) (kargoapi.FreightReference, error) {
+	targetWarehouse := targetFreight.GetWarehouse()
+	if !slices.Contains(stage.Spec.Subscriptions.Warehouses, targetWarehouse) {
+		return kargoapi.FreightReference{}, errors.Errorf(
+			"Freight %q is from Warehouse %q, to which Stage %q in namespace %q "+
+				"is not subscribed",
+			targetFreight.Name,
+			targetWarehouse,
+			stage.Name,
+			stage.Namespace,
+		)
+	}
 
-	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
-		// Only update Stage status if the promotion succeeded
-		// The assumption is that controller does not process multiple promotions in one stage
-		// so we are safe from race conditions and can just update the status
-		// TODO: remove all patching of Stage status out of promo reconciler
-		err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
-			status.Phase = kargoapi.StagePhaseVerifying
-			status.CurrentPromotion = nil
-			// control-flow Stage history is maintained in Stage controller.
-			// So we only modify history for normal Stages.
-			// (Technically, we should prevent creating promotion jobs on
-			// control-flow stages in the first place)
-			if stage.Spec.PromotionMechanisms != nil {
-				status.CurrentFreight = &nextFreight
-				status.History.Push(nextFreight)
+	freightByWarehouse :=
+		make(map[string]kargoapi.FreightReference, len(stage.Spec.Subscriptions.Warehouses))
+	for _, warehouse := range stage.Spec.Subscriptions.Warehouses {
+		freight := targetFreight
+		if warehouse != targetWarehouse {
+			var err error
+			if freight, err = r.getLatestFreight(
+				ctx,
+				stage.Namespace,
+				warehouse,
+			); err != nil {
+				return kargoapi.FreightReference{}, err
+			}
+			if freight == nil {
+				return kargoapi.FreightReference{}, errors.Errorf(
+					"no Freight found from Warehouse %q in namespace %q",
+					warehouse,
+					stage.Namespace,
+				)
 			}
-		})
-		if err != nil {
-			return nil, errors.Wrapf(
-				err,
-				"error updating status of Stage %q in namespace %q",
-				stageName,
-				stageNamespace,
-			)
+		}
+		freightByWarehouse[warehouse] = kargoapi.FreightReference{
+			ID:      freight.ID,
+			Commits: freight.Commits,
+			Images:  freight.Images,
+			Charts:  freight.Charts,
 		}
 	}
+	return kargoapi.ComposeFreight(freightByWarehouse), nil
+}
 
-	return newStatus, nil
+// getLatestFreight returns the latest Freight from the specified Warehouse. If
+// the Warehouse has not produced any Freight, nil is returned.
+func (r *reconciler) getLatestFreight(
+	ctx context.Context,
+	namespace string,
+	warehouse string,
+) (*kargoapi.Freight, error) {
+	var freight kargoapi.FreightList
+	if err := r.kargoClient.List(
+		ctx,
+		&freight,
+		&client.ListOptions{
+			Namespace: namespace,
+			FieldSelector: fields.OneTermEqualSelector(
+				kubeclient.FreightByWarehouseIndexField,
+				warehouse,
+			),
+		},
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing Freight for Warehouse %q in namespace %q",
+			warehouse,
+			namespace,
+		)
+	}
+	if len(freight.Items) == 0 {
+		return nil, nil
+	}
+	// Sort by creation timestamp, descending
+	sort.SliceStable(freight.Items, func(i, j int) bool {
+		return freight.Items[j].CreationTimestamp.
+			Before(&freight.Items[i].CreationTimestamp)
+	})
+	return &freight.Items[0], nil
 }
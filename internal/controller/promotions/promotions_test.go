@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -15,11 +17,14 @@ import (
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/kubeclient"
 )
 
 func TestNewPromotionReconciler(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
 	r := newReconciler(
+		kubeClient,
+		kubeClient,
 		kubeClient,
 		kubeClient,
 		&credentials.FakeDB{},
@@ -27,6 +32,7 @@ func TestNewPromotionReconciler(t *testing.T) {
 	require.NotNil(t, r.kargoClient)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.closePullRequestsFn)
 }
 
 func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
@@ -38,6 +44,8 @@ func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 	return newReconciler(
 		kargoClient,
 		kubeClient,
+		kubeClient,
+		kubeClient,
 		&credentials.FakeDB{},
 	)
 }
@@ -48,6 +56,7 @@ func TestReconcile(t *testing.T) {
 		promos                []client.Object
 		promoteFn             func(context.Context, v1alpha1.Promotion) (*kargoapi.PromotionStatus, error)
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
+		abort                 bool                  // if true, the first of the promos is marked for abort
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
 	}{
@@ -122,10 +131,42 @@ func TestReconcile(t *testing.T) {
 				return nil, errors.New("expected error")
 			},
 		},
+		{
+			name:                  "pending promo aborted",
+			abort:                 true,
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseAborted,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+		},
+		{
+			name:                  "running promo aborted",
+			abort:                 true,
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseAborted,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
+			},
+		},
+		{
+			name:                  "completed promo not aborted",
+			abort:                 true,
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseSucceeded, now),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.TODO()
+			if tc.abort {
+				tc.promos[0].SetAnnotations(map[string]string{
+					kargoapi.AnnotationKeyAbort: now.Format(time.RFC3339),
+				})
+			}
 			r := newFakeReconciler(t, tc.promos...)
 			promoteWasCalled := false
 			r.promoteFn = func(ctx context.Context, p v1alpha1.Promotion) (*kargoapi.PromotionStatus, error) {
@@ -160,6 +201,314 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcilePromotionPolicy(t *testing.T) {
+	newStage := func(policy *kargoapi.StagePromotionPolicy) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				PromotionPolicy: policy,
+			},
+		}
+	}
+	testCases := []struct {
+		name                  string
+		promo                 *kargoapi.Promotion
+		stage                 *kargoapi.Stage
+		promoteErr            error
+		expectPromoteFnCalled bool
+		assertions            func(ctrl.Result, kargoapi.PromotionStatus)
+	}{
+		{
+			name: "promo timed out",
+			promo: func() *kargoapi.Promotion {
+				p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+				p.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+				return p
+			}(),
+			stage: newStage(&kargoapi.StagePromotionPolicy{
+				Timeout: &metav1.Duration{Duration: time.Hour},
+			}),
+			expectPromoteFnCalled: false,
+			assertions: func(_ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, "timed out")
+			},
+		},
+		{
+			name:  "promo started",
+			promo: newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			stage: newStage(&kargoapi.StagePromotionPolicy{
+				Timeout: &metav1.Duration{Duration: time.Hour},
+			}),
+			expectPromoteFnCalled: true,
+			assertions: func(_ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.NotNil(t, status.StartedAt)
+			},
+		},
+		{
+			name:  "errored promo retried",
+			promo: newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			stage: newStage(&kargoapi.StagePromotionPolicy{
+				Retry: &kargoapi.PromotionRetryPolicy{
+					Limit:   2,
+					Backoff: &metav1.Duration{Duration: 30 * time.Second},
+				},
+			}),
+			promoteErr:            errors.New("expected error"),
+			expectPromoteFnCalled: true,
+			assertions: func(res ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Len(t, status.Attempts, 1)
+				require.Equal(t, "expected error", status.Attempts[0].Message)
+				require.Equal(t, 30*time.Second, res.RequeueAfter)
+			},
+		},
+		{
+			name: "errored promo waiting for backoff",
+			promo: func() *kargoapi.Promotion {
+				p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+				p.Status.StartedAt = &metav1.Time{Time: time.Now()}
+				p.Status.Attempts = []kargoapi.PromotionAttempt{{
+					FinishedAt: metav1.Now(),
+					Message:    "expected error",
+				}}
+				return p
+			}(),
+			stage: newStage(&kargoapi.StagePromotionPolicy{
+				Retry: &kargoapi.PromotionRetryPolicy{
+					Limit:   2,
+					Backoff: &metav1.Duration{Duration: time.Minute},
+				},
+			}),
+			expectPromoteFnCalled: false,
+			assertions: func(res ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Greater(t, res.RequeueAfter, time.Duration(0))
+				require.LessOrEqual(t, res.RequeueAfter, time.Minute)
+			},
+		},
+		{
+			name: "errored promo retries exhausted",
+			promo: func() *kargoapi.Promotion {
+				p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+				p.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+				p.Status.Attempts = []kargoapi.PromotionAttempt{
+					{FinishedAt: metav1.Time{Time: time.Now().Add(-time.Hour)}},
+					{FinishedAt: metav1.Time{Time: time.Now().Add(-time.Hour)}},
+				}
+				return p
+			}(),
+			stage: newStage(&kargoapi.StagePromotionPolicy{
+				Retry: &kargoapi.PromotionRetryPolicy{
+					Limit: 2,
+				},
+			}),
+			promoteErr:            errors.New("expected error"),
+			expectPromoteFnCalled: true,
+			assertions: func(_ ctrl.Result, status kargoapi.PromotionStatus) {
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Len(t, status.Attempts, 2)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.promo, tc.stage)
+			promoteWasCalled := false
+			r.promoteFn = func(context.Context, v1alpha1.Promotion) (*kargoapi.PromotionStatus, error) {
+				promoteWasCalled = true
+				if tc.promoteErr != nil {
+					return nil, tc.promoteErr
+				}
+				return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+			}
+			req := ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: tc.promo.Namespace,
+				Name:      tc.promo.Name,
+			}}
+			res, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPromoteFnCalled, promoteWasCalled)
+
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo))
+			tc.assertions(res, updatedPromo.Status)
+		})
+	}
+}
+
+func TestReconcileResetsStage(t *testing.T) {
+	newPromotingStage := func(policy *kargoapi.StagePromotionPolicy) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				PromotionPolicy: policy,
+			},
+			Status: kargoapi.StageStatus{
+				Phase:          kargoapi.StagePhasePromoting,
+				CurrentFreight: &kargoapi.FreightReference{ID: "fake-freight"},
+				CurrentPromotion: &kargoapi.PromotionInfo{
+					Name: "fake-promo",
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name          string
+		promo         *kargoapi.Promotion
+		stage         *kargoapi.Stage
+		expectedPhase kargoapi.PromotionPhase
+	}{
+		{
+			name: "running promo aborted",
+			promo: func() *kargoapi.Promotion {
+				p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+				p.Annotations = map[string]string{
+					kargoapi.AnnotationKeyAbort: now.Format(time.RFC3339),
+				}
+				return p
+			}(),
+			stage:         newPromotingStage(nil),
+			expectedPhase: kargoapi.PromotionPhaseAborted,
+		},
+		{
+			name: "running promo timed out",
+			promo: func() *kargoapi.Promotion {
+				p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+				p.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+				return p
+			}(),
+			stage: newPromotingStage(&kargoapi.StagePromotionPolicy{
+				Timeout: &metav1.Duration{Duration: time.Hour},
+			}),
+			expectedPhase: kargoapi.PromotionPhaseFailed,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.promo, tc.stage)
+			r.promoteFn = func(context.Context, v1alpha1.Promotion) (*kargoapi.PromotionStatus, error) {
+				require.Fail(t, "promoteFn should not be called")
+				return nil, nil
+			}
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: tc.promo.Namespace,
+				Name:      tc.promo.Name,
+			}})
+			require.NoError(t, err)
+
+			var updatedPromo kargoapi.Promotion
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(tc.promo), &updatedPromo))
+			require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+
+			var updatedStage kargoapi.Stage
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(tc.stage), &updatedStage))
+			require.Equal(t, kargoapi.StagePhaseSteady, updatedStage.Status.Phase)
+			require.Nil(t, updatedStage.Status.CurrentPromotion)
+		})
+	}
+}
+
+func TestResetStage(t *testing.T) {
+	newStage := func(
+		phase kargoapi.StagePhase,
+		currentFreight *kargoapi.FreightReference,
+		currentPromo string,
+	) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{},
+			Status: kargoapi.StageStatus{
+				Phase:          phase,
+				CurrentFreight: currentFreight,
+			},
+		}
+		if currentPromo != "" {
+			stage.Status.CurrentPromotion = &kargoapi.PromotionInfo{Name: currentPromo}
+		}
+		return stage
+	}
+	testCases := []struct {
+		name                 string
+		stage                *kargoapi.Stage
+		expectedPhase        kargoapi.StagePhase
+		expectedCurrentPromo bool
+	}{
+		{
+			name: "promoting with current freight",
+			stage: newStage(
+				kargoapi.StagePhasePromoting,
+				&kargoapi.FreightReference{ID: "fake-freight"},
+				"fake-promo",
+			),
+			expectedPhase: kargoapi.StagePhaseSteady,
+		},
+		{
+			name:          "promoting without current freight",
+			stage:         newStage(kargoapi.StagePhasePromoting, nil, "fake-promo"),
+			expectedPhase: kargoapi.StagePhaseNotApplicable,
+		},
+		{
+			name: "promoting by another promo",
+			stage: newStage(
+				kargoapi.StagePhasePromoting,
+				&kargoapi.FreightReference{ID: "fake-freight"},
+				"other-promo",
+			),
+			expectedPhase:        kargoapi.StagePhasePromoting,
+			expectedCurrentPromo: true,
+		},
+		{
+			name: "not promoting",
+			stage: newStage(
+				kargoapi.StagePhaseVerifying,
+				&kargoapi.FreightReference{ID: "fake-freight"},
+				"",
+			),
+			expectedPhase: kargoapi.StagePhaseVerifying,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+			r := newFakeReconciler(t, promo, tc.stage)
+			require.NoError(t, r.resetStage(ctx, tc.stage, promo))
+
+			var updatedStage kargoapi.Stage
+			require.NoError(t, r.kargoClient.Get(ctx, client.ObjectKeyFromObject(tc.stage), &updatedStage))
+			require.Equal(t, tc.expectedPhase, updatedStage.Status.Phase)
+			require.Equal(t, tc.expectedCurrentPromo, updatedStage.Status.CurrentPromotion != nil)
+		})
+	}
+}
+
+func TestPromotionRetryBackoff(t *testing.T) {
+	policy := &kargoapi.StagePromotionPolicy{
+		Retry: &kargoapi.PromotionRetryPolicy{
+			Limit:   10,
+			Backoff: &metav1.Duration{Duration: time.Second},
+		},
+	}
+	require.Equal(t, defaultPromotionRetryBackoff, promotionRetryBackoff(nil, 1))
+	require.Equal(t, time.Second, promotionRetryBackoff(policy, 1))
+	require.Equal(t, 2*time.Second, promotionRetryBackoff(policy, 2))
+	require.Equal(t, 8*time.Second, promotionRetryBackoff(policy, 4))
+	require.Equal(t, maxPromotionRetryBackoff, promotionRetryBackoff(policy, 100))
+}
+
 // Tests that initalizeQueues is called properly
 func TestReconcileInitializeQueues(t *testing.T) {
 	ctx := context.TODO()
@@ -179,3 +528,132 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestComposeTargetFreight(t *testing.T) {
+	newFreight := func(name, warehouse string, created time.Time) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-namespace",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: kargoapi.GroupVersion.String(),
+					Kind:       "Warehouse",
+					Name:       warehouse,
+				}},
+			},
+			ID: name,
+		}
+	}
+	now := time.Now()
+	testCases := []struct {
+		name           string
+		currentFreight *kargoapi.FreightReference
+		targetFreight  *kargoapi.Freight
+		objects        []client.Object
+		assertions     func(kargoapi.FreightReference, error)
+	}{
+		{
+			name:          "Freight from unsubscribed Warehouse",
+			targetFreight: newFreight("fake-freight", "unsubscribed-warehouse", now),
+			assertions: func(_ kargoapi.FreightReference, err error) {
+				require.ErrorContains(t, err, "is not subscribed")
+			},
+		},
+		{
+			name:          "no Freight from other Warehouse",
+			targetFreight: newFreight("freight-a", "warehouse-a", now),
+			assertions: func(_ kargoapi.FreightReference, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`no Freight found from Warehouse "warehouse-b"`,
+				)
+			},
+		},
+		{
+			name:          "latest Freight from other Warehouse",
+			targetFreight: newFreight("freight-a", "warehouse-a", now),
+			objects: []client.Object{
+				newFreight("old-freight-b", "warehouse-b", now.Add(-time.Hour)),
+				newFreight("new-freight-b", "warehouse-b", now),
+			},
+			assertions: func(freight kargoapi.FreightReference, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.FreightConstituent{
+						{ID: "freight-a", Warehouse: "warehouse-a"},
+						{ID: "new-freight-b", Warehouse: "warehouse-b"},
+					},
+					freight.Constituents,
+				)
+			},
+		},
+		{
+			name: "current Freight from other Warehouse is superseded",
+			currentFreight: &kargoapi.FreightReference{
+				ID: "fake-composite",
+				Constituents: []kargoapi.FreightConstituent{
+					{ID: "old-freight-a", Warehouse: "warehouse-a"},
+					{ID: "old-freight-b", Warehouse: "warehouse-b"},
+				},
+			},
+			targetFreight: newFreight("freight-a", "warehouse-a", now),
+			objects: []client.Object{
+				newFreight("old-freight-b", "warehouse-b", now.Add(-time.Hour)),
+				newFreight("new-freight-b", "warehouse-b", now),
+			},
+			assertions: func(freight kargoapi.FreightReference, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.FreightConstituent{
+						{ID: "freight-a", Warehouse: "warehouse-a"},
+						{ID: "new-freight-b", Warehouse: "warehouse-b"},
+					},
+					freight.Constituents,
+				)
+			},
+		},
+	}
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					WithIndex(
+						&kargoapi.Freight{},
+						kubeclient.FreightByWarehouseIndexField,
+						func(obj client.Object) []string {
+							return []string{obj.(*kargoapi.Freight).GetWarehouse()} // nolint: forcetypeassert
+						},
+					).
+					Build(),
+			}
+			testCase.assertions(
+				r.composeTargetFreight(
+					context.Background(),
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-stage",
+						},
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								Warehouses: []string{"warehouse-a", "warehouse-b"},
+							},
+						},
+						Status: kargoapi.StageStatus{
+							CurrentFreight: testCase.currentFreight,
+						},
+					},
+					testCase.targetFreight,
+				),
+			)
+		})
+	}
+}
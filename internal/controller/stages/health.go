@@ -4,35 +4,89 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
+	"time"
+
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/git"
 )
 
+// unwatchedProgressingRequeueInterval is how soon a Stage should be reconciled
+// again when any of the Kubernetes workloads referenced by its health checks or
+// any of the Flux resources it updates are still progressing. These resources
+// are not watched, so without this, a Stage could remain Progressing for up to
+// the default requeue interval after they have settled.
+const unwatchedProgressingRequeueInterval = 10 * time.Second
+
 func (r *reconciler) checkHealth(
 	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
 	currentFreight kargoapi.FreightReference,
 	argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+	fluxUpdates []kargoapi.FluxUpdate,
+	healthChecks *kargoapi.HealthChecks,
 ) *kargoapi.Health {
-	if len(argoCDAppUpdates) == 0 {
+	var workloadChecks []kargoapi.WorkloadHealthCheck
+	if healthChecks != nil {
+		workloadChecks = healthChecks.Workloads
+	}
+	if len(argoCDAppUpdates) == 0 && len(fluxUpdates) == 0 &&
+		len(workloadChecks) == 0 {
 		return nil
 	}
 
 	h := kargoapi.Health{
 		// We'll start healthy and degrade as we find issues
-		Status:     kargoapi.HealthStateHealthy,
-		ArgoCDApps: make([]kargoapi.ArgoCDAppStatus, len(argoCDAppUpdates)),
-		Issues:     []string{},
+		Status: kargoapi.HealthStateHealthy,
+		Issues: []string{},
+	}
+
+	if len(argoCDAppUpdates) > 0 {
+		r.checkArgoCDAppsHealth(ctx, currentFreight, argoCDAppUpdates, &h)
+	}
+	if len(fluxUpdates) > 0 {
+		r.checkFluxResourcesHealth(ctx, currentFreight, fluxUpdates, &h)
+	}
+	if len(workloadChecks) > 0 {
+		r.checkWorkloadsHealth(ctx, stageMeta, currentFreight, workloadChecks, &h)
 	}
 
-	if r.argocdClient == nil && len(argoCDAppUpdates) > 0 {
-		h.Status = kargoapi.HealthStateUnknown
-		h.Issues = []string{
-			"Argo CD integration is disabled on this controller; cannot assess" +
+	return &h
+}
+
+// checkArgoCDAppsHealth assesses the health and sync status of the Argo CD
+// Applications referenced by the provided ArgoCDAppUpdates and records its
+// findings in the provided Health.
+func (r *reconciler) checkArgoCDAppsHealth(
+	ctx context.Context,
+	currentFreight kargoapi.FreightReference,
+	argoCDAppUpdates []kargoapi.ArgoCDAppUpdate,
+	h *kargoapi.Health,
+) {
+	h.ArgoCDApps = make([]kargoapi.ArgoCDAppStatus, len(argoCDAppUpdates))
+
+	if r.argocdClient == nil {
+		h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+		h.Issues = append(
+			h.Issues,
+			"Argo CD integration is disabled on this controller; cannot assess"+
 				" the health or sync status of Argo CD Applications",
-		}
-		return &h
+		)
+		return
 	}
 
 	for i, updates := range argoCDAppUpdates {
@@ -152,8 +206,602 @@ func (r *reconciler) checkHealth(
 		}
 
 	}
+}
 
-	return &h
+// checkFluxResourcesHealth assesses the health of the Flux resources
+// referenced by the provided FluxUpdates and records its findings in the
+// provided Health.
+func (r *reconciler) checkFluxResourcesHealth(
+	ctx context.Context,
+	currentFreight kargoapi.FreightReference,
+	fluxUpdates []kargoapi.FluxUpdate,
+	h *kargoapi.Health,
+) {
+	h.FluxResources = make([]kargoapi.FluxResourceStatus, len(fluxUpdates))
+
+	if r.fluxClient == nil {
+		h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+		h.Issues = append(
+			h.Issues,
+			"Flux integration is disabled on this controller; cannot assess"+
+				" the health of Flux resources",
+		)
+		return
+	}
+
+	for i, update := range fluxUpdates {
+		h.FluxResources[i] = kargoapi.FluxResourceStatus{
+			Kind:      update.Kind,
+			Namespace: update.Namespace,
+			Name:      update.Name,
+			Status:    kargoapi.HealthStateUnknown,
+		}
+
+		obj, err := r.getFluxResourceFn(
+			ctx,
+			r.fluxClient,
+			update.Kind,
+			update.Namespace,
+			update.Name,
+		)
+		if err != nil {
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"error finding Flux %s %q in namespace %q: %s",
+					update.Kind,
+					update.Name,
+					update.Namespace,
+					err,
+				),
+			)
+			continue
+		}
+		if obj == nil {
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"unable to find Flux %s %q in namespace %q",
+					update.Kind,
+					update.Name,
+					update.Namespace,
+				),
+			)
+			continue
+		}
+
+		stageHealth, msg := stageHealthForFluxResource(
+			update.Kind,
+			obj,
+			desiredFluxRevision(update, obj, currentFreight),
+		)
+		h.FluxResources[i].Status = stageHealth
+		h.FluxResources[i].Revision = flux.GetObservedRevision(update.Kind, obj)
+		h.FluxResources[i].Message = msg
+		h.Status = h.Status.Merge(stageHealth)
+		if stageHealth != kargoapi.HealthStateHealthy {
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"Flux %s %q in namespace %q is %s: %s",
+					update.Kind,
+					update.Name,
+					update.Namespace,
+					stageHealth,
+					msg,
+				),
+			)
+		}
+	}
+}
+
+// desiredFluxRevision returns the revision the provided Flux resource is
+// expected to have observed once it has incorporated the provided Freight. An
+// empty string is returned if no specific revision is expected.
+func desiredFluxRevision(
+	update kargoapi.FluxUpdate,
+	obj *unstructured.Unstructured,
+	currentFreight kargoapi.FreightReference,
+) string {
+	switch update.Kind {
+	case kargoapi.FluxResourceKindHelmRelease:
+		if update.HelmRelease == nil || update.HelmRelease.Chart == nil {
+			return ""
+		}
+		chartUpdate := update.HelmRelease.Chart
+		for _, chart := range currentFreight.Charts {
+			// path.Join accounts for the possibility that chart.Name is empty
+			if path.Join(chart.RepoURL, chart.Name) ==
+				path.Join(chartUpdate.RepoURL, chartUpdate.Name) {
+				return chart.Version
+			}
+		}
+	case kargoapi.FluxResourceKindGitRepository:
+		url, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+		url = git.NormalizeGitURL(url)
+		for _, commit := range currentFreight.Commits {
+			if git.NormalizeGitURL(commit.RepoURL) == url {
+				return commit.ID
+			}
+		}
+	case kargoapi.FluxResourceKindOCIRepository:
+		url, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+		url = strings.TrimPrefix(url, "oci://")
+		for _, image := range currentFreight.Images {
+			if image.RepoURL == url {
+				if update.OCIRepository != nil && update.OCIRepository.UseDigest {
+					return image.Digest
+				}
+				return image.Tag
+			}
+		}
+		for _, chart := range currentFreight.Charts {
+			// path.Join accounts for the possibility that chart.Name is empty
+			if path.Join(strings.TrimPrefix(chart.RepoURL, "oci://"), chart.Name) ==
+				url {
+				return chart.Version
+			}
+		}
+	}
+	return ""
+}
+
+// stageHealthForFluxResource evaluates the health of the provided Flux
+// resource using its Ready condition and, if a desired revision is specified,
+// the revision it has most recently observed.
+func stageHealthForFluxResource(
+	kind kargoapi.FluxResourceKind,
+	obj *unstructured.Unstructured,
+	desiredRevision string,
+) (kargoapi.HealthState, string) {
+	if obj.GetGeneration() > flux.GetObservedGeneration(obj) {
+		return kargoapi.HealthStateProgressing,
+			"waiting for spec update to be observed"
+	}
+	ready := flux.GetCondition(obj, flux.ConditionTypeReady)
+	if ready == nil {
+		return kargoapi.HealthStateProgressing, "waiting to be reconciled"
+	}
+	switch ready.Status {
+	case metav1.ConditionTrue:
+	case metav1.ConditionFalse:
+		reconciling := flux.GetCondition(obj, flux.ConditionTypeReconciling)
+		if reconciling != nil && reconciling.Status == metav1.ConditionTrue {
+			return kargoapi.HealthStateProgressing, ready.Message
+		}
+		return kargoapi.HealthStateUnhealthy, ready.Message
+	default:
+		return kargoapi.HealthStateProgressing, ready.Message
+	}
+	if observed := flux.GetObservedRevision(kind, obj); !flux.RevisionMatches(observed, desiredRevision) {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"observed revision %q does not yet match desired revision %q",
+			observed,
+			desiredRevision,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+// checkWorkloadsHealth assesses the rollout status of the Kubernetes workloads
+// referenced by the provided WorkloadHealthChecks and records its findings in
+// the provided Health. Workloads whose containers reference images from the
+// provided Freight are not considered healthy until they reference the
+// Freight's versions of those images. Workloads are only read from namespaces
+// the Stage represented by stageMeta is permitted to read.
+func (r *reconciler) checkWorkloadsHealth(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	freight kargoapi.FreightReference,
+	checks []kargoapi.WorkloadHealthCheck,
+	h *kargoapi.Health,
+) {
+	h.Workloads = []kargoapi.WorkloadStatus{}
+
+	if r.workloadsClient == nil {
+		h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+		h.Issues = append(
+			h.Issues,
+			"no Kubernetes client is available on this controller; cannot assess"+
+				" the health of Kubernetes workloads",
+		)
+		return
+	}
+
+	for _, check := range checks {
+		if err := r.authorizeWorkloadsNamespace(
+			ctx,
+			stageMeta,
+			check.Namespace,
+		); err != nil {
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"cannot assess the health of %s(s) in namespace %q: %s",
+					check.Kind,
+					check.Namespace,
+					err,
+				),
+			)
+			continue
+		}
+
+		workloads, err := r.getWorkloads(ctx, check)
+		if err != nil {
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"error finding %s(s) in namespace %q: %s",
+					check.Kind,
+					check.Namespace,
+					err,
+				),
+			)
+			continue
+		}
+
+		if len(workloads) == 0 {
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			if check.Name != "" {
+				h.Workloads = append(h.Workloads, kargoapi.WorkloadStatus{
+					Kind:      check.Kind,
+					Namespace: check.Namespace,
+					Name:      check.Name,
+					Status:    kargoapi.HealthStateUnknown,
+				})
+				h.Issues = append(
+					h.Issues,
+					fmt.Sprintf(
+						"unable to find %s %q in namespace %q",
+						check.Kind,
+						check.Name,
+						check.Namespace,
+					),
+				)
+			} else {
+				h.Issues = append(
+					h.Issues,
+					fmt.Sprintf(
+						"no %s in namespace %q matches selector %v",
+						check.Kind,
+						check.Namespace,
+						check.Selector,
+					),
+				)
+			}
+			continue
+		}
+
+		for _, workload := range workloads {
+			health, msg := stageHealthForWorkloadImages(workload, freight)
+			if health == kargoapi.HealthStateHealthy {
+				health, msg = stageHealthForWorkload(workload)
+			}
+			h.Workloads = append(h.Workloads, kargoapi.WorkloadStatus{
+				Kind:      check.Kind,
+				Namespace: workload.GetNamespace(),
+				Name:      workload.GetName(),
+				Status:    health,
+				Message:   msg,
+			})
+			h.Status = h.Status.Merge(health)
+			if health != kargoapi.HealthStateHealthy {
+				h.Issues = append(
+					h.Issues,
+					fmt.Sprintf(
+						"%s %q in namespace %q is %s: %s",
+						check.Kind,
+						workload.GetName(),
+						workload.GetNamespace(),
+						health,
+						msg,
+					),
+				)
+			}
+		}
+	}
+}
+
+// authorizeWorkloadsNamespace returns an error if the Kargo Stage represented
+// by stageMeta is not permitted to read Kubernetes workloads in the specified
+// namespace. A Stage may always read workloads in its own Project's namespace.
+// Any other namespace must permit it explicitly using the same annotation with
+// which Argo CD Applications and Flux resources permit mutation by a Stage.
+func (r *reconciler) authorizeWorkloadsNamespace(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	namespace string,
+) error {
+	if namespace == stageMeta.Namespace {
+		return nil
+	}
+	ns := &corev1.Namespace{}
+	if err := r.workloadsClient.Get(
+		ctx,
+		types.NamespacedName{Name: namespace},
+		ns,
+	); err != nil {
+		return errors.Wrapf(err, "error getting namespace %q", namespace)
+	}
+	return promotion.AuthorizeStageRead("Namespace", stageMeta, ns.ObjectMeta)
+}
+
+// getWorkloads returns the Kubernetes workloads referenced by the provided
+// WorkloadHealthCheck. If the check references a single workload by name and
+// that workload does not exist, an empty slice is returned.
+func (r *reconciler) getWorkloads(
+	ctx context.Context,
+	check kargoapi.WorkloadHealthCheck,
+) ([]client.Object, error) {
+	var obj client.Object
+	var list client.ObjectList
+	switch check.Kind {
+	case kargoapi.WorkloadKindDeployment:
+		obj, list = &appsv1.Deployment{}, &appsv1.DeploymentList{}
+	case kargoapi.WorkloadKindStatefulSet:
+		obj, list = &appsv1.StatefulSet{}, &appsv1.StatefulSetList{}
+	case kargoapi.WorkloadKindDaemonSet:
+		obj, list = &appsv1.DaemonSet{}, &appsv1.DaemonSetList{}
+	default:
+		return nil, errors.Errorf("unsupported workload kind %q", check.Kind)
+	}
+
+	if check.Name != "" {
+		if err := r.workloadsClient.Get(
+			ctx,
+			types.NamespacedName{
+				Namespace: check.Namespace,
+				Name:      check.Name,
+			},
+			obj,
+		); err != nil {
+			if kubeerr.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []client.Object{obj}, nil
+	}
+
+	if err := r.workloadsClient.List(
+		ctx,
+		list,
+		client.InNamespace(check.Namespace),
+		client.MatchingLabels(check.Selector),
+	); err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, errors.Wrap(err, "error extracting workloads from list")
+	}
+	workloads := make([]client.Object, 0, len(items))
+	for _, item := range items {
+		if workload, ok := item.(client.Object); ok {
+			workloads = append(workloads, workload)
+		}
+	}
+	return workloads, nil
+}
+
+// stageHealthForWorkload evaluates the rollout status of the provided
+// Kubernetes workload using the same rules as `kubectl rollout status`.
+func stageHealthForWorkload(obj client.Object) (kargoapi.HealthState, string) {
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		return stageHealthForDeployment(workload)
+	case *appsv1.StatefulSet:
+		return stageHealthForStatefulSet(workload)
+	case *appsv1.DaemonSet:
+		return stageHealthForDaemonSet(workload)
+	default:
+		return kargoapi.HealthStateUnknown,
+			fmt.Sprintf("unsupported workload type %T", obj)
+	}
+}
+
+// stageHealthForWorkloadImages assesses whether the containers of the provided
+// Kubernetes workload reference the versions of images specified by the
+// provided Freight. Containers referencing images that do not appear in the
+// Freight are disregarded. If any container references a different version of
+// an image that does appear in the Freight, the workload is still progressing
+// toward the Freight.
+func stageHealthForWorkloadImages(
+	obj client.Object,
+	freight kargoapi.FreightReference,
+) (kargoapi.HealthState, string) {
+	if len(freight.Images) == 0 {
+		return kargoapi.HealthStateHealthy, ""
+	}
+	var podSpec corev1.PodSpec
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		podSpec = workload.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		podSpec = workload.Spec.Template.Spec
+	case *appsv1.DaemonSet:
+		podSpec = workload.Spec.Template.Spec
+	default:
+		return kargoapi.HealthStateUnknown,
+			fmt.Sprintf("unsupported workload type %T", obj)
+	}
+	desiredImages := make(map[string]kargoapi.Image, len(freight.Images))
+	for _, image := range freight.Images {
+		repo, err := reference.ParseNormalizedNamed(image.RepoURL)
+		if err != nil {
+			return kargoapi.HealthStateUnknown, fmt.Sprintf(
+				"unable to parse image repository URL %q: %s",
+				image.RepoURL,
+				err,
+			)
+		}
+		desiredImages[repo.Name()] = image
+	}
+	containers := make(
+		[]corev1.Container,
+		0,
+		len(podSpec.InitContainers)+len(podSpec.Containers),
+	)
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+	for _, container := range containers {
+		ref, err := reference.ParseNormalizedNamed(container.Image)
+		if err != nil {
+			return kargoapi.HealthStateUnknown, fmt.Sprintf(
+				"unable to parse image %q of container %q: %s",
+				container.Image,
+				container.Name,
+				err,
+			)
+		}
+		desired, ok := desiredImages[ref.Name()]
+		if !ok {
+			continue
+		}
+		tag := "latest"
+		if tagged, ok := ref.(reference.Tagged); ok {
+			tag = tagged.Tag()
+		}
+		var digest string
+		if digested, ok := ref.(reference.Digested); ok {
+			digest = digested.Digest().String()
+			tag = ""
+		}
+		if (desired.Digest != "" && digest == desired.Digest) ||
+			(desired.Tag != "" && tag == desired.Tag) {
+			continue
+		}
+		desiredRef := desired.RepoURL + ":" + desired.Tag
+		if desired.Digest != "" {
+			desiredRef = desired.RepoURL + "@" + desired.Digest
+		}
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"container %q references image %q; waiting for %q",
+			container.Name,
+			container.Image,
+			desiredRef,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func stageHealthForDeployment(
+	d *appsv1.Deployment,
+) (kargoapi.HealthState, string) {
+	if d.Generation > d.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing,
+			"waiting for spec update to be observed"
+	}
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing &&
+			cond.Reason == "ProgressDeadlineExceeded" {
+			return kargoapi.HealthStateUnhealthy,
+				"exceeded its progress deadline"
+		}
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	if d.Status.UpdatedReplicas < replicas {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d out of %d new replicas have been updated",
+			d.Status.UpdatedReplicas,
+			replicas,
+		)
+	}
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d old replicas are pending termination",
+			d.Status.Replicas-d.Status.UpdatedReplicas,
+		)
+	}
+	if d.Status.AvailableReplicas < d.Status.UpdatedReplicas {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d updated replicas are available",
+			d.Status.AvailableReplicas,
+			d.Status.UpdatedReplicas,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func stageHealthForStatefulSet(
+	s *appsv1.StatefulSet,
+) (kargoapi.HealthState, string) {
+	if s.Generation > s.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing,
+			"waiting for spec update to be observed"
+	}
+	replicas := int32(1)
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+	if s.Status.ReadyReplicas < replicas {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d replicas are ready",
+			s.Status.ReadyReplicas,
+			replicas,
+		)
+	}
+	if s.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType &&
+		s.Spec.UpdateStrategy.Type != "" {
+		// Rollout status cannot be assessed for other update strategies
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if ru := s.Spec.UpdateStrategy.RollingUpdate; ru != nil &&
+		ru.Partition != nil && *ru.Partition > 0 {
+		if s.Status.UpdatedReplicas < replicas-*ru.Partition {
+			return kargoapi.HealthStateProgressing, fmt.Sprintf(
+				"%d of %d partitioned replicas have been updated",
+				s.Status.UpdatedReplicas,
+				replicas-*ru.Partition,
+			)
+		}
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if s.Status.UpdateRevision != s.Status.CurrentRevision {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d replicas have been updated to revision %s",
+			s.Status.UpdatedReplicas,
+			replicas,
+			s.Status.UpdateRevision,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func stageHealthForDaemonSet(
+	d *appsv1.DaemonSet,
+) (kargoapi.HealthState, string) {
+	if d.Generation > d.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing,
+			"waiting for spec update to be observed"
+	}
+	if d.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType &&
+		d.Spec.UpdateStrategy.Type != "" {
+		// Rollout status cannot be assessed for other update strategies
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if d.Status.UpdatedNumberScheduled < d.Status.DesiredNumberScheduled {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d out of %d new pods have been updated",
+			d.Status.UpdatedNumberScheduled,
+			d.Status.DesiredNumberScheduled,
+		)
+	}
+	if d.Status.NumberAvailable < d.Status.DesiredNumberScheduled {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d updated pods are available",
+			d.Status.NumberAvailable,
+			d.Status.DesiredNumberScheduled,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
 }
 
 func stageHealthForAppHealth(
@@ -6,6 +6,11 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -18,6 +23,8 @@ func TestCheckHealth(t *testing.T) {
 		name             string
 		freight          kargoapi.FreightReference
 		argoCDAppUpdates []kargoapi.ArgoCDAppUpdate
+		fluxUpdates      []kargoapi.FluxUpdate
+		healthChecks     *kargoapi.HealthChecks
 		reconciler       *reconciler
 		assertions       func(*kargoapi.Health)
 	}{
@@ -383,14 +390,387 @@ func TestCheckHealth(t *testing.T) {
 				require.Empty(t, health.Issues)
 			},
 		},
+		{
+			name:        "flux integration is not enabled",
+			fluxUpdates: []kargoapi.FluxUpdate{{}},
+			reconciler:  &reconciler{},
+			assertions: func(health *kargoapi.Health) {
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Len(t, health.Issues, 1)
+			},
+		},
+		{
+			name: "unable to find Flux resource",
+			fluxUpdates: []kargoapi.FluxUpdate{{
+				Kind:      kargoapi.FluxResourceKindHelmRelease,
+				Namespace: "fake-namespace",
+				Name:      "fake-release",
+			}},
+			reconciler: &reconciler{
+				fluxClient: fake.NewClientBuilder().Build(),
+				getFluxResourceFn: func(
+					context.Context,
+					client.Client,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.FluxResourceStatus{{
+						Kind:      kargoapi.FluxResourceKindHelmRelease,
+						Namespace: "fake-namespace",
+						Name:      "fake-release",
+						Status:    kargoapi.HealthStateUnknown,
+					}},
+					health.FluxResources,
+				)
+				require.Equal(
+					t,
+					[]string{
+						`unable to find Flux HelmRelease "fake-release" in namespace ` +
+							`"fake-namespace"`,
+					},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name: "Flux resource is healthy",
+			freight: kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{{
+					RepoURL: "https://charts.example.com",
+					Name:    "fake-chart",
+					Version: "1.2.3",
+				}},
+			},
+			fluxUpdates: []kargoapi.FluxUpdate{{
+				Kind:      kargoapi.FluxResourceKindHelmRelease,
+				Namespace: "fake-namespace",
+				Name:      "fake-release",
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{
+					Chart: &kargoapi.FluxHelmChartUpdate{
+						RepoURL: "https://charts.example.com",
+						Name:    "fake-chart",
+					},
+				},
+			}},
+			reconciler: &reconciler{
+				fluxClient: fake.NewClientBuilder().Build(),
+				getFluxResourceFn: func(
+					context.Context,
+					client.Client,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newFluxResource(1, 1, "True", "", "1.2.3"), nil
+				},
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.FluxResourceStatus{{
+						Kind:      kargoapi.FluxResourceKindHelmRelease,
+						Namespace: "fake-namespace",
+						Name:      "fake-release",
+						Status:    kargoapi.HealthStateHealthy,
+						Revision:  "1.2.3",
+					}},
+					health.FluxResources,
+				)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name: "no workloads client",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDeployment,
+					Namespace: "fake-namespace",
+					Name:      "fake-deployment",
+				}},
+			},
+			reconciler: &reconciler{},
+			assertions: func(health *kargoapi.Health) {
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Len(t, health.Issues, 1)
+			},
+		},
+		{
+			name: "namespace does not permit Stage",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDeployment,
+					Namespace: "other-namespace",
+					Name:      "fake-deployment",
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().WithObjects(
+					&corev1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "other-namespace",
+						},
+					},
+					&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "other-namespace",
+							Name:      "fake-deployment",
+						},
+					},
+				).Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Empty(t, health.Workloads)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "does not permit reads")
+			},
+		},
+		{
+			name: "namespace permits Stage",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDeployment,
+					Namespace: "other-namespace",
+					Name:      "fake-deployment",
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().WithObjects(
+					&corev1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "other-namespace",
+							Annotations: map[string]string{
+								"kargo.akuity.io/authorized-stage": "fake-namespace:fake-stage",
+							},
+						},
+					},
+					&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "other-namespace",
+							Name:      "fake-deployment",
+						},
+						Spec: appsv1.DeploymentSpec{
+							Replicas: ptr.To(int32(1)),
+						},
+						Status: appsv1.DeploymentStatus{
+							Replicas:          1,
+							UpdatedReplicas:   1,
+							AvailableReplicas: 1,
+						},
+					},
+				).Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Len(t, health.Workloads, 1)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name: "workload not yet running Freight's image",
+			freight: kargoapi.FreightReference{
+				Images: []kargoapi.Image{{
+					RepoURL: "nginx",
+					Tag:     "1.25.3",
+				}},
+			},
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDeployment,
+					Namespace: "fake-namespace",
+					Name:      "fake-deployment",
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().WithObjects(
+					&appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-deployment",
+						},
+						Spec: appsv1.DeploymentSpec{
+							Replicas: ptr.To(int32(1)),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Name:  "nginx",
+										Image: "nginx:1.25.2",
+									}},
+								},
+							},
+						},
+						Status: appsv1.DeploymentStatus{
+							Replicas:          1,
+							UpdatedReplicas:   1,
+							AvailableReplicas: 1,
+						},
+					},
+				).Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.WorkloadStatus{{
+						Kind:      kargoapi.WorkloadKindDeployment,
+						Namespace: "fake-namespace",
+						Name:      "fake-deployment",
+						Status:    kargoapi.HealthStateProgressing,
+						Message: `container "nginx" references image "nginx:1.25.2"; ` +
+							`waiting for "nginx:1.25.3"`,
+					}},
+					health.Workloads,
+				)
+			},
+		},
+		{
+			name: "named workload not found",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDeployment,
+					Namespace: "fake-namespace",
+					Name:      "fake-deployment",
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.WorkloadStatus{{
+						Kind:      kargoapi.WorkloadKindDeployment,
+						Namespace: "fake-namespace",
+						Name:      "fake-deployment",
+						Status:    kargoapi.HealthStateUnknown,
+					}},
+					health.Workloads,
+				)
+				require.Equal(
+					t,
+					[]string{
+						`unable to find Deployment "fake-deployment" in namespace ` +
+							`"fake-namespace"`,
+					},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name: "no workloads match selector",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindStatefulSet,
+					Namespace: "fake-namespace",
+					Selector:  map[string]string{"app": "fake"},
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Empty(t, health.Workloads)
+				require.Len(t, health.Issues, 1)
+			},
+		},
+		{
+			name: "workloads selected by label are merged",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{{
+					Kind:      kargoapi.WorkloadKindDaemonSet,
+					Namespace: "fake-namespace",
+					Selector:  map[string]string{"app": "fake"},
+				}},
+			},
+			reconciler: &reconciler{
+				workloadsClient: fake.NewClientBuilder().WithObjects(
+					&appsv1.DaemonSet{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "healthy",
+							Labels:    map[string]string{"app": "fake"},
+						},
+						Status: appsv1.DaemonSetStatus{
+							DesiredNumberScheduled: 2,
+							UpdatedNumberScheduled: 2,
+							NumberAvailable:        2,
+						},
+					},
+					&appsv1.DaemonSet{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "progressing",
+							Labels:    map[string]string{"app": "fake"},
+						},
+						Status: appsv1.DaemonSetStatus{
+							DesiredNumberScheduled: 2,
+							UpdatedNumberScheduled: 1,
+							NumberAvailable:        2,
+						},
+					},
+					&appsv1.DaemonSet{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "unselected",
+						},
+						Status: appsv1.DaemonSetStatus{
+							DesiredNumberScheduled: 2,
+						},
+					},
+				).Build(),
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Nil(t, health.ArgoCDApps)
+				require.Equal(
+					t,
+					[]kargoapi.WorkloadStatus{
+						{
+							Kind:      kargoapi.WorkloadKindDaemonSet,
+							Namespace: "fake-namespace",
+							Name:      "healthy",
+							Status:    kargoapi.HealthStateHealthy,
+						},
+						{
+							Kind:      kargoapi.WorkloadKindDaemonSet,
+							Namespace: "fake-namespace",
+							Name:      "progressing",
+							Status:    kargoapi.HealthStateProgressing,
+							Message:   "1 out of 2 new pods have been updated",
+						},
+					},
+					health.Workloads,
+				)
+				require.Len(t, health.Issues, 1)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.reconciler.checkHealth(
 					context.Background(),
+					metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
 					testCase.freight,
 					testCase.argoCDAppUpdates,
+					testCase.fluxUpdates,
+					testCase.healthChecks,
 				),
 			)
 		})
@@ -483,3 +863,473 @@ func TestStageHealthForAppSync(t *testing.T) {
 		})
 	}
 }
+
+func TestStageHealthForFluxResource(t *testing.T) {
+	testCases := []struct {
+		name            string
+		obj             *unstructured.Unstructured
+		desiredRevision string
+		expected        kargoapi.HealthState
+	}{
+		{
+			name:     "spec update not yet observed",
+			obj:      newFluxResource(2, 1, "True", "", "1.2.3"),
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:     "not yet reconciled",
+			obj:      newFluxResource(1, 1, "", "", ""),
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:     "not ready and reconciling",
+			obj:      newFluxResource(1, 1, "False", "True", ""),
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:     "not ready and not reconciling",
+			obj:      newFluxResource(1, 1, "False", "", ""),
+			expected: kargoapi.HealthStateUnhealthy,
+		},
+		{
+			name:     "readiness unknown",
+			obj:      newFluxResource(1, 1, "Unknown", "", ""),
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:            "ready but desired revision not yet observed",
+			obj:             newFluxResource(1, 1, "True", "", "1.2.2"),
+			desiredRevision: "1.2.3",
+			expected:        kargoapi.HealthStateProgressing,
+		},
+		{
+			name:            "ready and desired revision observed",
+			obj:             newFluxResource(1, 1, "True", "", "1.2.3"),
+			desiredRevision: "1.2.3",
+			expected:        kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			health, _ := stageHealthForFluxResource(
+				kargoapi.FluxResourceKindHelmRelease,
+				testCase.obj,
+				testCase.desiredRevision,
+			)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
+
+// newFluxResource returns a HelmRelease-like unstructured object with the
+// specified generations, Ready and Reconciling condition statuses (omitted
+// when empty), and last attempted revision.
+func newFluxResource(
+	generation int64,
+	observedGeneration int64,
+	ready string,
+	reconciling string,
+	revision string,
+) *unstructured.Unstructured {
+	conditions := []any{}
+	if ready != "" {
+		conditions = append(conditions, map[string]any{
+			"type":   "Ready",
+			"status": ready,
+		})
+	}
+	if reconciling != "" {
+		conditions = append(conditions, map[string]any{
+			"type":   "Reconciling",
+			"status": reconciling,
+		})
+	}
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"status": map[string]any{
+				"observedGeneration":    observedGeneration,
+				"lastAttemptedRevision": revision,
+				"conditions":            conditions,
+			},
+		},
+	}
+	obj.SetGeneration(generation)
+	return obj
+}
+
+func TestStageHealthForWorkloadImages(t *testing.T) {
+	testFreight := kargoapi.FreightReference{
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "nginx",
+				Tag:     "1.25.3",
+			},
+			{
+				RepoURL: "ghcr.io/example/app",
+				Tag:     "v2.0.0",
+				Digest:  "sha256:0000000000000000000000000000000000000000000000000000000000000002",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		freight    kargoapi.FreightReference
+		containers []corev1.Container
+		expected   kargoapi.HealthState
+	}{
+		{
+			name: "no images in Freight",
+			containers: []corev1.Container{{
+				Name:  "nginx",
+				Image: "nginx:1.25.2",
+			}},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name:    "containers reference unrelated images",
+			freight: testFreight,
+			containers: []corev1.Container{{
+				Name:  "redis",
+				Image: "redis:7",
+			}},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name:    "container references an old tag",
+			freight: testFreight,
+			containers: []corev1.Container{{
+				Name:  "nginx",
+				Image: "docker.io/library/nginx:1.25.2",
+			}},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:    "container references an image without a tag",
+			freight: testFreight,
+			containers: []corev1.Container{{
+				Name:  "nginx",
+				Image: "nginx",
+			}},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:    "container references an old digest",
+			freight: testFreight,
+			containers: []corev1.Container{{
+				Name:  "app",
+				Image: "ghcr.io/example/app@sha256:0000000000000000000000000000000000000000000000000000000000000001",
+			}},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name:    "container image cannot be parsed",
+			freight: testFreight,
+			containers: []corev1.Container{{
+				Name:  "bogus",
+				Image: "Not An Image",
+			}},
+			expected: kargoapi.HealthStateUnknown,
+		},
+		{
+			name:    "containers reference Freight's images",
+			freight: testFreight,
+			containers: []corev1.Container{
+				{
+					Name:  "nginx",
+					Image: "docker.io/library/nginx:1.25.3",
+				},
+				{
+					Name:  "app-by-tag",
+					Image: "ghcr.io/example/app:v2.0.0",
+				},
+				{
+					Name:  "app-by-digest",
+					Image: "ghcr.io/example/app@sha256:0000000000000000000000000000000000000000000000000000000000000002",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			health, _ := stageHealthForWorkloadImages(
+				&appsv1.Deployment{
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: testCase.containers,
+							},
+						},
+					},
+				},
+				testCase.freight,
+			)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
+
+func TestStageHealthForDeployment(t *testing.T) {
+	testCases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   kargoapi.HealthState
+	}{
+		{
+			name: "spec update not yet observed",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{
+					Conditions: []appsv1.DeploymentCondition{{
+						Type:   appsv1.DeploymentProgressing,
+						Reason: "ProgressDeadlineExceeded",
+					}},
+				},
+			},
+			expected: kargoapi.HealthStateUnhealthy,
+		},
+		{
+			name: "not all replicas updated",
+			deployment: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					UpdatedReplicas: 2,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "old replicas pending termination",
+			deployment: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					Replicas:        4,
+					UpdatedReplicas: 3,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "updated replicas not yet available",
+			deployment: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					Replicas:          3,
+					UpdatedReplicas:   3,
+					AvailableReplicas: 2,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "rolled out",
+			deployment: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					Replicas:          3,
+					UpdatedReplicas:   3,
+					AvailableReplicas: 3,
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			health, _ := stageHealthForDeployment(testCase.deployment)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
+
+func TestStageHealthForStatefulSet(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statefulSet *appsv1.StatefulSet
+		expected    kargoapi.HealthState
+	}{
+		{
+			name: "spec update not yet observed",
+			statefulSet: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "not all replicas ready",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas: 2,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "on delete update strategy",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.OnDeleteStatefulSetStrategyType,
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					CurrentRevision: "old",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "partitioned rollout in progress",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+							Partition: ptr.To(int32(1)),
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					UpdatedReplicas: 1,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "partitioned rollout complete",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+							Partition: ptr.To(int32(1)),
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					UpdatedReplicas: 2,
+					CurrentRevision: "old",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "revisions differ",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					CurrentRevision: "old",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "rolled out",
+			statefulSet: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.StatefulSetStatus{
+					ReadyReplicas:   3,
+					UpdatedReplicas: 3,
+					CurrentRevision: "new",
+					UpdateRevision:  "new",
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			health, _ := stageHealthForStatefulSet(testCase.statefulSet)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
+
+func TestStageHealthForDaemonSet(t *testing.T) {
+	testCases := []struct {
+		name      string
+		daemonSet *appsv1.DaemonSet
+		expected  kargoapi.HealthState
+	}{
+		{
+			name: "spec update not yet observed",
+			daemonSet: &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "on delete update strategy",
+			daemonSet: &appsv1.DaemonSet{
+				Spec: appsv1.DaemonSetSpec{
+					UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+						Type: appsv1.OnDeleteDaemonSetStrategyType,
+					},
+				},
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "not all pods updated",
+			daemonSet: &appsv1.DaemonSet{
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 2,
+					NumberAvailable:        3,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "not all pods available",
+			daemonSet: &appsv1.DaemonSet{
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 3,
+					NumberAvailable:        2,
+				},
+			},
+			expected: kargoapi.HealthStateProgressing,
+		},
+		{
+			name: "rolled out",
+			daemonSet: &appsv1.DaemonSet{
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 3,
+					NumberAvailable:        3,
+				},
+			},
+			expected: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			health, _ := stageHealthForDaemonSet(testCase.daemonSet)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
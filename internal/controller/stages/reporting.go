@@ -0,0 +1,191 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
+	_ "github.com/akuity/kargo/internal/gitprovider/github" // Register GitHub
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// GitProviderReportingMode specifies how, if at all, the arrival of Freight in
+// a Stage is reported back to the Git providers hosting the repositories that
+// the Freight's commits came from.
+type GitProviderReportingMode string
+
+const (
+	// GitProviderReportingModeCommitStatus reports the arrival of Freight in a
+	// Stage by setting a status on each of the Freight's commits.
+	GitProviderReportingModeCommitStatus GitProviderReportingMode = "CommitStatus"
+	// GitProviderReportingModeDeployment reports the arrival of Freight in a
+	// Stage by recording a deployment of each of the Freight's commits to an
+	// environment named <project>/<stage>.
+	GitProviderReportingModeDeployment GitProviderReportingMode = "Deployment"
+)
+
+// getFreightReportState returns the state that should be reported to Git
+// providers for the Stage's current Freight, based on the outcome of the
+// Stage's verification process, if any.
+func getFreightReportState(
+	verificationInfo *kargoapi.VerificationInfo,
+) gitprovider.CommitState {
+	if verificationInfo == nil {
+		return gitprovider.CommitStateSuccess
+	}
+	switch verificationInfo.Phase {
+	case kargoapi.VerificationPhaseSuccessful:
+		return gitprovider.CommitStateSuccess
+	case kargoapi.VerificationPhaseFailed, kargoapi.VerificationPhaseInconclusive:
+		return gitprovider.CommitStateFailure
+	case kargoapi.VerificationPhaseError:
+		return gitprovider.CommitStateError
+	default:
+		return gitprovider.CommitStatePending
+	}
+}
+
+// reportAssessedFreight reports the outcome of the Stage's assessment of its
+// current Freight to the Git providers the Freight's commits came from, if the
+// Stage has just finished that assessment, i.e. has moved from the Verifying
+// phase to the Steady phase. It must only be called once the Stage's new
+// status has been persisted, so that each such transition is reported exactly
+// once.
+func (r *reconciler) reportAssessedFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	oldPhase kargoapi.StagePhase,
+	newStatus kargoapi.StageStatus,
+) {
+	if oldPhase != kargoapi.StagePhaseVerifying ||
+		newStatus.Phase != kargoapi.StagePhaseSteady ||
+		newStatus.CurrentFreight == nil {
+		return
+	}
+	r.reportFreightFn(
+		ctx,
+		stage,
+		*newStatus.CurrentFreight,
+		getFreightReportState(newStatus.CurrentFreight.VerificationInfo),
+	)
+}
+
+// reportFreight reports the specified state of the specified Freight in the
+// specified Stage to the Git provider hosting the repository of each of the
+// Freight's commits. Reporting is best effort. Failures are logged, but never
+// returned, so that they cannot hold up reconciliation of the Stage.
+func (r *reconciler) reportFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightReference,
+	state gitprovider.CommitState,
+) {
+	mode := r.cfg.GitProviderReportingMode
+	if mode == "" {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx)
+	description := fmt.Sprintf(
+		"Freight %s in Stage %s: %s",
+		freight.ID,
+		stage.Name,
+		strings.ToLower(string(state)),
+	)
+	targetURL := r.getStageURL(stage)
+	for _, commit := range freight.Commits {
+		commitLogger := logger.WithField("repo", commit.RepoURL).
+			WithField("commit", commit.ID)
+		gpClient, err := r.getGitProviderFn(ctx, stage.Namespace, commit.RepoURL)
+		if err != nil {
+			commitLogger.Warnf("error getting git provider client: %s", err)
+			continue
+		}
+		if gpClient == nil {
+			commitLogger.Debug("found no credentials for git repo; not reporting")
+			continue
+		}
+		switch mode {
+		case GitProviderReportingModeCommitStatus:
+			err = gpClient.CreateCommitStatus(
+				ctx,
+				commit.RepoURL,
+				commit.ID,
+				gitprovider.CreateCommitStatusOpts{
+					Context:     fmt.Sprintf("kargo/%s/%s", stage.Namespace, stage.Name),
+					State:       state,
+					Description: description,
+					TargetURL:   targetURL,
+				},
+			)
+		case GitProviderReportingModeDeployment:
+			_, err = gpClient.CreateDeployment(
+				ctx,
+				commit.RepoURL,
+				gitprovider.CreateDeploymentOpts{
+					Ref:            commit.ID,
+					Environment:    fmt.Sprintf("%s/%s", stage.Namespace, stage.Name),
+					State:          state,
+					Description:    description,
+					EnvironmentURL: targetURL,
+				},
+			)
+		default:
+			err = errors.Errorf("unknown git provider reporting mode %q", mode)
+		}
+		if err != nil {
+			commitLogger.Warnf("error reporting Freight to git provider: %s", err)
+			continue
+		}
+		commitLogger.Debug("reported Freight to git provider")
+	}
+}
+
+// getStageURL returns the URL of the specified Stage in the Kargo UI, or an
+// empty string if the base URL of the Kargo UI is not known.
+func (r *reconciler) getStageURL(stage *kargoapi.Stage) string {
+	if r.cfg.UIBaseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s/project/%s/stage/%s",
+		strings.TrimSuffix(r.cfg.UIBaseURL, "/"),
+		stage.Namespace,
+		stage.Name,
+	)
+}
+
+// getGitProvider returns a client for the Git provider hosting the specified
+// repository, authenticated using the credentials for that repository. If no
+// such credentials are found, nil is returned.
+func (r *reconciler) getGitProvider(
+	ctx context.Context,
+	namespace string,
+	repoURL string,
+) (gitprovider.GitProviderService, error) {
+	creds, ok, err := r.credentialsDB.Get(
+		ctx,
+		namespace,
+		credentials.TypeGit,
+		repoURL,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for git repo %q",
+			repoURL,
+		)
+	}
+	if !ok {
+		return nil, nil
+	}
+	gpClient, err := gitprovider.NewGitProviderServiceFromURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return gpClient.WithAuthToken(creds.Password), nil
+}
@@ -0,0 +1,340 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestGetFreightReportState(t *testing.T) {
+	testCases := []struct {
+		name             string
+		verificationInfo *kargoapi.VerificationInfo
+		expected         gitprovider.CommitState
+	}{
+		{
+			name:     "no verification",
+			expected: gitprovider.CommitStateSuccess,
+		},
+		{
+			name: "verification successful",
+			verificationInfo: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseSuccessful,
+			},
+			expected: gitprovider.CommitStateSuccess,
+		},
+		{
+			name: "verification failed",
+			verificationInfo: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseFailed,
+			},
+			expected: gitprovider.CommitStateFailure,
+		},
+		{
+			name: "verification inconclusive",
+			verificationInfo: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseInconclusive,
+			},
+			expected: gitprovider.CommitStateFailure,
+		},
+		{
+			name: "verification errored",
+			verificationInfo: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseError,
+			},
+			expected: gitprovider.CommitStateError,
+		},
+		{
+			name: "verification running",
+			verificationInfo: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+			},
+			expected: gitprovider.CommitStatePending,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				getFreightReportState(testCase.verificationInfo),
+			)
+		})
+	}
+}
+
+func TestReportAssessedFreight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		oldPhase  kargoapi.StagePhase
+		newStatus kargoapi.StageStatus
+		expected  []gitprovider.CommitState
+	}{
+		{
+			name:     "Stage was not verifying",
+			oldPhase: kargoapi.StagePhaseSteady,
+			newStatus: kargoapi.StageStatus{
+				Phase:          kargoapi.StagePhaseSteady,
+				CurrentFreight: &kargoapi.FreightReference{ID: "fake-freight"},
+			},
+		},
+		{
+			name:     "Stage is still verifying",
+			oldPhase: kargoapi.StagePhaseVerifying,
+			newStatus: kargoapi.StageStatus{
+				Phase:          kargoapi.StagePhaseVerifying,
+				CurrentFreight: &kargoapi.FreightReference{ID: "fake-freight"},
+			},
+		},
+		{
+			name:     "Stage has finished verifying",
+			oldPhase: kargoapi.StagePhaseVerifying,
+			newStatus: kargoapi.StageStatus{
+				Phase: kargoapi.StagePhaseSteady,
+				CurrentFreight: &kargoapi.FreightReference{
+					ID: "fake-freight",
+					VerificationInfo: &kargoapi.VerificationInfo{
+						Phase: kargoapi.VerificationPhaseFailed,
+					},
+				},
+			},
+			expected: []gitprovider.CommitState{gitprovider.CommitStateFailure},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reported []gitprovider.CommitState
+			r := &reconciler{
+				reportFreightFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ kargoapi.FreightReference,
+					state gitprovider.CommitState,
+				) {
+					reported = append(reported, state)
+				},
+			}
+			r.reportAssessedFreight(
+				context.Background(),
+				&kargoapi.Stage{},
+				testCase.oldPhase,
+				testCase.newStatus,
+			)
+			require.Equal(t, testCase.expected, reported)
+		})
+	}
+}
+
+func TestReportFreight(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := kargoapi.FreightReference{
+		ID: "fake-freight",
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo-without-creds",
+				ID:      "fake-commit-1",
+			},
+			{
+				RepoURL: "https://github.com/example/repo-with-error",
+				ID:      "fake-commit-2",
+			},
+			{
+				RepoURL: "https://github.com/example/repo",
+				ID:      "fake-commit-3",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		mode       GitProviderReportingMode
+		assertions func(*fakeGitProvider)
+	}{
+		{
+			name: "reporting disabled",
+			assertions: func(gp *fakeGitProvider) {
+				require.Empty(t, gp.commitStatuses)
+				require.Empty(t, gp.deployments)
+			},
+		},
+		{
+			name: "commit status",
+			mode: GitProviderReportingModeCommitStatus,
+			assertions: func(gp *fakeGitProvider) {
+				require.Empty(t, gp.deployments)
+				require.Equal(
+					t,
+					map[string]gitprovider.CreateCommitStatusOpts{
+						"fake-commit-3": {
+							Context:     "kargo/fake-namespace/fake-stage",
+							State:       gitprovider.CommitStateSuccess,
+							Description: "Freight fake-freight in Stage fake-stage: success",
+							TargetURL: "https://kargo.example.com/project/" +
+								"fake-namespace/stage/fake-stage",
+						},
+					},
+					gp.commitStatuses,
+				)
+			},
+		},
+		{
+			name: "deployment",
+			mode: GitProviderReportingModeDeployment,
+			assertions: func(gp *fakeGitProvider) {
+				require.Empty(t, gp.commitStatuses)
+				require.Equal(
+					t,
+					[]gitprovider.CreateDeploymentOpts{
+						{
+							Ref:         "fake-commit-3",
+							Environment: "fake-namespace/fake-stage",
+							State:       gitprovider.CommitStateSuccess,
+							Description: "Freight fake-freight in Stage fake-stage: success",
+							EnvironmentURL: "https://kargo.example.com/project/" +
+								"fake-namespace/stage/fake-stage",
+						},
+					},
+					gp.deployments,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gp := &fakeGitProvider{}
+			r := &reconciler{
+				cfg: ReconcilerConfig{
+					GitProviderReportingMode: testCase.mode,
+					UIBaseURL:                "https://kargo.example.com/",
+				},
+				getGitProviderFn: func(
+					_ context.Context,
+					_ string,
+					repoURL string,
+				) (gitprovider.GitProviderService, error) {
+					switch repoURL {
+					case "https://github.com/example/repo-without-creds":
+						return nil, nil
+					case "https://github.com/example/repo-with-error":
+						return nil, errors.New("something went wrong")
+					}
+					return gp, nil
+				},
+			}
+			r.reportFreight(
+				context.Background(),
+				testStage,
+				testFreight,
+				gitprovider.CommitStateSuccess,
+			)
+			testCase.assertions(gp)
+		})
+	}
+}
+
+func TestGetGitProvider(t *testing.T) {
+	testCases := []struct {
+		name          string
+		credentialsDB credentials.Database
+		assertions    func(gitprovider.GitProviderService, error)
+	}{
+		{
+			name: "error getting credentials",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(gp gitprovider.GitProviderService, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Nil(t, gp)
+			},
+		},
+		{
+			name:          "no credentials found",
+			credentialsDB: &credentials.FakeDB{},
+			assertions: func(gp gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				require.Nil(t, gp)
+			},
+		},
+		{
+			name: "success",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{Password: "fake-token"}, true, nil
+				},
+			},
+			assertions: func(gp gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, gp)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				credentialsDB: testCase.credentialsDB,
+			}
+			testCase.assertions(
+				r.getGitProvider(
+					context.Background(),
+					"fake-namespace",
+					"https://github.com/example/repo",
+				),
+			)
+		})
+	}
+}
+
+// fakeGitProvider is a gitprovider.GitProviderService that records the commit
+// statuses and deployments it is asked to create.
+type fakeGitProvider struct {
+	gitprovider.GitProviderService
+	commitStatuses map[string]gitprovider.CreateCommitStatusOpts
+	deployments    []gitprovider.CreateDeploymentOpts
+}
+
+func (f *fakeGitProvider) CreateCommitStatus(
+	_ context.Context,
+	_ string,
+	sha string,
+	opts gitprovider.CreateCommitStatusOpts,
+) error {
+	if f.commitStatuses == nil {
+		f.commitStatuses = map[string]gitprovider.CreateCommitStatusOpts{}
+	}
+	f.commitStatuses[sha] = opts
+	return nil
+}
+
+func (f *fakeGitProvider) CreateDeployment(
+	_ context.Context,
+	_ string,
+	opts gitprovider.CreateDeploymentOpts,
+) (*gitprovider.Deployment, error) {
+	f.deployments = append(f.deployments, opts)
+	return &gitprovider.Deployment{}, nil
+}
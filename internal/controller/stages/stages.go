@@ -8,6 +8,8 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,7 +25,10 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
@@ -34,6 +39,13 @@ type ReconcilerConfig struct {
 	ShardName                    string `envconfig:"SHARD_NAME"`
 	AnalysisRunsNamespace        string `envconfig:"ROLLOUTS_ANALYSIS_RUNS_NAMESPACE"`
 	RolloutsControllerInstanceID string `envconfig:"ROLLOUTS_CONTROLLER_INSTANCE_ID"`
+	// GitProviderReportingMode specifies how, if at all, the arrival of Freight
+	// in a Stage is reported back to the Git providers hosting the repositories
+	// that the Freight's commits came from. Reporting is disabled when empty.
+	GitProviderReportingMode GitProviderReportingMode `envconfig:"GIT_PROVIDER_REPORTING_MODE"`
+	// UIBaseURL is the base URL of the Kargo UI. When specified, reports sent
+	// to Git providers link to the relevant Stage in the UI.
+	UIBaseURL string `envconfig:"UI_BASE_URL"`
 }
 
 func ReconcilerConfigFromEnv() ReconcilerConfig {
@@ -44,9 +56,13 @@ func ReconcilerConfigFromEnv() ReconcilerConfig {
 
 // reconciler reconciles Stage resources.
 type reconciler struct {
-	kargoClient    client.Client
-	argocdClient   client.Client
-	rolloutsClient client.Client
+	kargoClient     client.Client
+	argocdClient    client.Client
+	rolloutsClient  client.Client
+	fluxClient      client.Client
+	workloadsClient client.Client
+
+	credentialsDB credentials.Database
 
 	cfg ReconcilerConfig
 
@@ -70,8 +86,11 @@ type reconciler struct {
 
 	checkHealthFn func(
 		context.Context,
+		metav1.ObjectMeta,
 		kargoapi.FreightReference,
 		[]kargoapi.ArgoCDAppUpdate,
+		[]kargoapi.FluxUpdate,
+		*kargoapi.HealthChecks,
 	) *kargoapi.Health
 
 	getArgoCDAppFn func(
@@ -81,6 +100,14 @@ type reconciler struct {
 		name string,
 	) (*argocd.Application, error)
 
+	getFluxResourceFn func(
+		ctx context.Context,
+		client client.Client,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error)
+
 	// Freight verification:
 
 	startVerificationFn func(
@@ -179,12 +206,14 @@ type reconciler struct {
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) ([]kargoapi.Freight, error)
 
 	getLatestVerifiedFreightFn func(
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) (*kargoapi.Freight, error)
 
 	getLatestApprovedFreightFn func(
@@ -199,6 +228,21 @@ type reconciler struct {
 		...client.ListOption,
 	) error
 
+	// Reporting to Git providers:
+
+	reportFreightFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight kargoapi.FreightReference,
+		state gitprovider.CommitState,
+	)
+
+	getGitProviderFn func(
+		ctx context.Context,
+		namespace string,
+		repoURL string,
+	) (gitprovider.GitProviderService, error)
+
 	// Stage deletion:
 
 	clearVerificationsFn func(context.Context, *kargoapi.Stage) error
@@ -215,6 +259,9 @@ func SetupReconcilerWithManager(
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
 	rolloutsMgr manager.Manager,
+	fluxClient client.Client,
+	workloadsClient client.Client,
+	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) error {
 	// Index Promotions in non-terminal states by Stage
@@ -315,6 +362,9 @@ func SetupReconcilerWithManager(
 				kargoMgr.GetClient(),
 				argocdClient,
 				rolloutsClient,
+				fluxClient,
+				workloadsClient,
+				credentialsDB,
 				cfg,
 				shardRequirement,
 			),
@@ -429,6 +479,9 @@ func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
 	rolloutsClient client.Client,
+	fluxClient client.Client,
+	workloadsClient client.Client,
+	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 	shardRequirement *labels.Requirement,
 ) *reconciler {
@@ -436,6 +489,9 @@ func newReconciler(
 		kargoClient:      kargoClient,
 		argocdClient:     argocdClient,
 		rolloutsClient:   rolloutsClient,
+		fluxClient:       fluxClient,
+		workloadsClient:  workloadsClient,
+		credentialsDB:    credentialsDB,
 		cfg:              cfg,
 		shardRequirement: shardRequirement,
 	}
@@ -446,6 +502,7 @@ func newReconciler(
 	// Health checks:
 	r.checkHealthFn = r.checkHealth
 	r.getArgoCDAppFn = argocd.GetApplication
+	r.getFluxResourceFn = flux.GetResource
 	// Freight verification:
 	r.startVerificationFn = r.startVerification
 	r.getVerificationInfoFn = r.getVerificationInfo
@@ -470,6 +527,9 @@ func newReconciler(
 	r.getLatestVerifiedFreightFn = r.getLatestVerifiedFreight
 	r.getLatestApprovedFreightFn = r.getLatestApprovedFreight
 	r.listFreightFn = r.kargoClient.List
+	// Reporting to Git providers:
+	r.reportFreightFn = r.reportFreight
+	r.getGitProviderFn = r.getGitProvider
 	// Stage deletion:
 	r.clearVerificationsFn = r.clearVerifications
 	r.clearApprovalsFn = r.clearApprovals
@@ -506,6 +566,7 @@ func (r *reconciler) Reconcile(
 	}
 	logger.Debug("found Stage")
 
+	oldPhase := stage.Status.Phase
 	var newStatus kargoapi.StageStatus
 	if stage.DeletionTimestamp != nil {
 		newStatus, err = r.syncStageDelete(ctx, stage)
@@ -534,6 +595,9 @@ func (r *reconciler) Reconcile(
 	})
 	if updateErr != nil {
 		logger.Errorf("error updating Stage status: %s", updateErr)
+	} else if err == nil && stage.DeletionTimestamp == nil &&
+		stage.Spec.PromotionMechanisms != nil {
+		r.reportAssessedFreight(ctx, stage, oldPhase, newStatus)
 	}
 	clearRefreshErr := kargoapi.ClearStageRefresh(ctx, r.kargoClient, stage)
 	if clearRefreshErr != nil {
@@ -560,7 +624,85 @@ func (r *reconciler) Reconcile(
 	// Everything succeeded, look for new changes on the defined interval.
 	//
 	// TODO: Make this configurable
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	requeueAfter := 5 * time.Minute
+
+	// If any Freight is still soaking in an upstream Stage, make sure we look
+	// again as soon as it becomes available to this Stage.
+	if stage.DeletionTimestamp == nil {
+		soakTimeRemaining, err := r.getMinSoakTimeRemaining(ctx, stage)
+		if err != nil {
+			logger.Errorf("error checking for Freight soaking upstream: %s", err)
+		} else if soakTimeRemaining > 0 && soakTimeRemaining < requeueAfter {
+			requeueAfter = soakTimeRemaining
+		}
+	}
+
+	// If the Stage is locked until a specific time, make sure we look again as
+	// soon as the lock expires.
+	if lock := stage.Spec.Lock; lock.IsActive(time.Now()) && lock.Until != nil {
+		if untilExpiry := time.Until(lock.Until.Time); untilExpiry < requeueAfter {
+			requeueAfter = untilExpiry
+		}
+	}
+
+	// Neither Kubernetes workloads referenced by the Stage's health checks nor
+	// Flux resources are watched, so if any of them are still progressing, make
+	// sure we look again soon.
+	if health := newStatus.Health; health != nil &&
+		health.Status == kargoapi.HealthStateProgressing &&
+		(len(health.Workloads) > 0 || len(health.FluxResources) > 0) &&
+		unwatchedProgressingRequeueInterval < requeueAfter {
+		requeueAfter = unwatchedProgressingRequeueInterval
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// getMinSoakTimeRemaining returns the shortest amount of time remaining before
+// any Freight currently soaking in one of the specified Stage's upstream Stages
+// has soaked for long enough to become available to the Stage. Zero is
+// returned if no Freight is currently soaking upstream.
+func (r *reconciler) getMinSoakTimeRemaining(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (time.Duration, error) {
+	if stage.Spec.Subscriptions == nil {
+		return 0, nil
+	}
+	now := time.Now()
+	var minRemaining time.Duration
+	for _, stageSub := range stage.Spec.Subscriptions.UpstreamStages {
+		if stageSub.MinSoakDuration == nil {
+			continue
+		}
+		var freight kargoapi.FreightList
+		if err := r.listFreightFn(
+			ctx,
+			&freight,
+			&client.ListOptions{
+				Namespace: stage.Namespace,
+				FieldSelector: fields.OneTermEqualSelector(
+					kubeclient.FreightByVerifiedStagesIndexField,
+					stageSub.Name,
+				),
+			},
+		); err != nil {
+			return 0, errors.Wrapf(
+				err,
+				"error listing Freight verified in Stage %q in namespace %q",
+				stageSub.Name,
+				stage.Namespace,
+			)
+		}
+		for i := range freight.Items {
+			remaining :=
+				kargoapi.GetFreightSoakTimeRemaining(&freight.Items[i], stageSub, now)
+			if remaining > 0 && (minRemaining == 0 || remaining < minRemaining) {
+				minRemaining = remaining
+			}
+		}
+	}
+	return minRemaining, nil
 }
 
 func (r *reconciler) syncControlFlowStage(
@@ -585,27 +727,29 @@ func (r *reconciler) syncControlFlowStage(
 	// in a control flow Stage (e.g. require that it was verified in ALL upstreams
 	// Stages)
 	var availableFreight []kargoapi.Freight
-	if stage.Spec.Subscriptions.Warehouse != "" {
-		var freight kargoapi.FreightList
-		if err := r.listFreightFn(
-			ctx,
-			&freight,
-			&client.ListOptions{
-				Namespace: stage.Namespace,
-				FieldSelector: fields.OneTermEqualSelector(
-					kubeclient.FreightByWarehouseIndexField,
-					stage.Spec.Subscriptions.Warehouse,
-				),
-			},
-		); err != nil {
-			return status, errors.Wrapf(
-				err,
-				"error listing Freight from Warehouse %q in namespace %q",
-				stage.Spec.Subscriptions.Warehouse,
-				stage.Namespace,
-			)
+	if warehouses := stage.Spec.Subscriptions.GetWarehouses(); len(warehouses) > 0 {
+		for _, warehouse := range warehouses {
+			var freight kargoapi.FreightList
+			if err := r.listFreightFn(
+				ctx,
+				&freight,
+				&client.ListOptions{
+					Namespace: stage.Namespace,
+					FieldSelector: fields.OneTermEqualSelector(
+						kubeclient.FreightByWarehouseIndexField,
+						warehouse,
+					),
+				},
+			); err != nil {
+				return status, errors.Wrapf(
+					err,
+					"error listing Freight from Warehouse %q in namespace %q",
+					warehouse,
+					stage.Namespace,
+				)
+			}
+			availableFreight = append(availableFreight, freight.Items...)
 		}
-		availableFreight = freight.Items
 	} else {
 		// Get all Freight verified in upstream Stages. Merely being approved for an
 		// upstream Stage is not enough. If Freight is only approved for a Stage,
@@ -618,6 +762,7 @@ func (r *reconciler) syncControlFlowStage(
 			ctx,
 			stage.Namespace,
 			stage.Spec.Subscriptions.UpstreamStages,
+			stage.Spec.Subscriptions.UpstreamStagesMode,
 		); err != nil {
 			return status, errors.Wrapf(
 				err,
@@ -636,7 +781,9 @@ func (r *reconciler) syncControlFlowStage(
 			if newStatus.VerifiedIn == nil {
 				newStatus.VerifiedIn = map[string]kargoapi.VerifiedStage{}
 			}
-			newStatus.VerifiedIn[stage.Name] = kargoapi.VerifiedStage{}
+			newStatus.VerifiedIn[stage.Name] = kargoapi.VerifiedStage{
+				VerifiedAt: &metav1.Time{Time: time.Now()},
+			}
 			if err := r.patchFreightStatusFn(ctx, &af, newStatus); err != nil {
 				return status, errors.Wrapf(
 					err,
@@ -692,8 +839,11 @@ func (r *reconciler) syncNormalStage(
 		// Check health
 		status.Health = r.checkHealthFn(
 			ctx,
+			stage.ObjectMeta,
 			*status.CurrentFreight,
 			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
+			stage.Spec.PromotionMechanisms.FluxUpdates,
+			stage.Spec.HealthChecks,
 		)
 		if status.Health != nil {
 			freightLogger.WithField("health", status.Health.Status).
@@ -747,26 +897,31 @@ func (r *reconciler) syncNormalStage(
 			(stage.Spec.Verification == nil ||
 				(status.CurrentFreight.VerificationInfo != nil &&
 					status.CurrentFreight.VerificationInfo.Phase == kargoapi.VerificationPhaseSuccessful)) {
-			if err := r.verifyFreightInStageFn(
-				ctx,
-				stage.Namespace,
-				status.CurrentFreight.ID,
-				stage.Name,
-			); err != nil {
-				return status, errors.Wrapf(
-					err,
-					"error marking Freight %q in namespace %q as verified in Stage %q",
-					status.CurrentFreight.ID,
+			// For composite Freight, each constituent piece of Freight is
+			// individually marked as verified.
+			for _, freightID := range status.CurrentFreight.FreightIDs() {
+				if err := r.verifyFreightInStageFn(
+					ctx,
 					stage.Namespace,
+					freightID,
 					stage.Name,
-				)
+				); err != nil {
+					return status, errors.Wrapf(
+						err,
+						"error marking Freight %q in namespace %q as verified in Stage %q",
+						freightID,
+						stage.Namespace,
+						stage.Name,
+					)
+				}
 			}
 		}
 	}
 
 	// Stop here if we have no chance of finding any Freight to promote.
 	if stage.Spec.Subscriptions == nil ||
-		(stage.Spec.Subscriptions.Warehouse == "" && len(stage.Spec.Subscriptions.UpstreamStages) == 0) {
+		(len(stage.Spec.Subscriptions.GetWarehouses()) == 0 &&
+			len(stage.Spec.Subscriptions.UpstreamStages) == 0) {
 		logger.Warn(
 			"Stage has no subscriptions. This may indicate an issue with resource" +
 				"validation logic.",
@@ -774,6 +929,11 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
+	if stage.Spec.Lock.IsActive(time.Now()) {
+		logger.Debug("Stage is locked; auto-promotion is not permitted")
+		return status, nil
+	}
+
 	logger.Debug("checking if auto-promotion is permitted...")
 	if permitted, err :=
 		r.isAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
@@ -812,7 +972,7 @@ func (r *reconciler) syncNormalStage(
 
 	// Only proceed if nextFreight isn't the one we already have
 	if stage.Status.CurrentFreight != nil &&
-		stage.Status.CurrentFreight.ID == latestFreight.Name {
+		stage.Status.CurrentFreight.Includes(latestFreight.Name) {
 		logger.Debug("Stage already has latest available Freight")
 		return status, nil
 	}
@@ -1039,13 +1199,15 @@ func (r *reconciler) verifyFreightInStage(
 		newStatus.VerifiedIn = map[string]kargoapi.VerifiedStage{}
 	}
 
-	// Only try to mark as verified in this Stage if not already the case.
+	// Only try to mark as verified in this Stage if not already the case
 	if _, ok := newStatus.VerifiedIn[stageName]; ok {
 		logger.Debug("Freight already marked as verified in Stage")
 		return nil
 	}
 
-	newStatus.VerifiedIn[stageName] = kargoapi.VerifiedStage{}
+	newStatus.VerifiedIn[stageName] = kargoapi.VerifiedStage{
+		VerifiedAt: &metav1.Time{Time: time.Now()},
+	}
 	if err = r.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
 		return err
 	}
@@ -1130,10 +1292,15 @@ func (r *reconciler) getLatestAvailableFreight(
 		return latestFreight, nil
 	}
 
+	if len(stage.Spec.Subscriptions.Warehouses) > 0 {
+		return r.getLatestAvailableCompositeFreight(ctx, namespace, stage)
+	}
+
 	latestVerifiedFreight, err := r.getLatestVerifiedFreightFn(
 		ctx,
 		namespace,
 		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(
@@ -1181,6 +1348,44 @@ func (r *reconciler) getLatestAvailableFreight(
 	return latestApprovedFreight, nil
 }
 
+// getLatestAvailableCompositeFreight returns the latest Freight from any of
+// the multiple Warehouses a Stage subscribes to that is not already a
+// constituent of the Stage's current (composite) Freight. Nil is returned if
+// all such Freight is already in the Stage or if any of the Warehouses has
+// not yet produced Freight, since a complete composite could not be formed.
+// Promoting any one such Freight suffices, since the resulting Promotion
+// composes it with the latest Freight from every other Warehouse.
+func (r *reconciler) getLatestAvailableCompositeFreight(
+	ctx context.Context,
+	namespace string,
+	stage *kargoapi.Stage,
+) (*kargoapi.Freight, error) {
+	logger := logging.LoggerFromContext(ctx)
+	var newFreight *kargoapi.Freight
+	for _, warehouse := range stage.Spec.Subscriptions.Warehouses {
+		latestFreight, err :=
+			r.getLatestFreightFromWarehouseFn(ctx, namespace, warehouse)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error checking Warehouse %q in namespace %q for Freight",
+				warehouse,
+				namespace,
+			)
+		}
+		if latestFreight == nil {
+			logger.WithField("warehouse", warehouse).
+				Debug("no Freight found from Warehouse")
+			return nil, nil
+		}
+		if newFreight == nil && (stage.Status.CurrentFreight == nil ||
+			!stage.Status.CurrentFreight.Includes(latestFreight.Name)) {
+			newFreight = latestFreight
+		}
+	}
+	return newFreight, nil
+}
+
 func (r *reconciler) getLatestFreightFromWarehouse(
 	ctx context.Context,
 	namespace string,
@@ -1220,10 +1425,12 @@ func (r *reconciler) getAllVerifiedFreight(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) ([]kargoapi.Freight, error) {
 	// Start by building a de-duped map of Freight verified in any upstream
 	// Stage(s)
 	verifiedFreight := map[string]kargoapi.Freight{}
+	now := time.Now()
 	for _, stageSub := range stageSubs {
 		var freight kargoapi.FreightList
 		if err := r.listFreightFn(
@@ -1245,6 +1452,17 @@ func (r *reconciler) getAllVerifiedFreight(
 			)
 		}
 		for _, freight := range freight.Items {
+			if mode == kargoapi.UpstreamStagesModeAll {
+				// The Stage requires Freight to have been verified (and soaked) in
+				// all upstream Stages. Exclude any that has not been.
+				if !kargoapi.IsFreightVerifiedUpstream(&freight, stageSubs, mode, now) {
+					continue
+				}
+			} else if kargoapi.GetFreightSoakTimeRemaining(&freight, stageSub, now) > 0 {
+				// Exclude Freight that has not yet soaked in this upstream Stage for
+				// long enough.
+				continue
+			}
 			verifiedFreight[freight.Name] = freight
 		}
 	}
@@ -1265,9 +1483,10 @@ func (r *reconciler) getLatestVerifiedFreight(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) (*kargoapi.Freight, error) {
 	verifiedFreight, err :=
-		r.getAllVerifiedFreightFn(ctx, namespace, stageSubs)
+		r.getAllVerifiedFreightFn(ctx, namespace, stageSubs, mode)
 	if err != nil {
 		return nil, err
 	}
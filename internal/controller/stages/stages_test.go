@@ -15,6 +15,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 )
 
 func TestNewReconciler(t *testing.T) {
@@ -29,12 +30,18 @@ func TestNewReconciler(t *testing.T) {
 		kubeClient,
 		kubeClient,
 		kubeClient,
+		kubeClient,
+		kubeClient,
+		&credentials.FakeDB{},
 		testCfg,
 		requirement,
 	)
 	require.Equal(t, testCfg, r.cfg)
 	require.NotNil(t, r.kargoClient)
 	require.NotNil(t, r.argocdClient)
+	require.NotNil(t, r.fluxClient)
+	require.NotNil(t, r.workloadsClient)
+	require.NotNil(t, r.credentialsDB)
 	// Assert that all overridable behaviors were initialized to a default:
 	// Loop guard:
 	require.NotNil(t, r.hasNonTerminalPromotionsFn)
@@ -42,6 +49,7 @@ func TestNewReconciler(t *testing.T) {
 	// Health checks:
 	require.NotNil(t, r.checkHealthFn)
 	require.NotNil(t, r.getArgoCDAppFn)
+	require.NotNil(t, r.getFluxResourceFn)
 	// Freight verification:
 	require.NotNil(t, r.startVerificationFn)
 	require.NotNil(t, r.getVerificationInfoFn)
@@ -64,6 +72,9 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.getLatestVerifiedFreightFn)
 	require.NotNil(t, r.getLatestApprovedFreightFn)
 	require.NotNil(t, r.listFreightFn)
+	// Reporting to Git providers:
+	require.NotNil(t, r.reportFreightFn)
+	require.NotNil(t, r.getGitProviderFn)
 	// Stage deletion:
 	require.NotNil(t, r.clearVerificationsFn)
 	require.NotNil(t, r.clearApprovalsFn)
@@ -132,6 +143,7 @@ func TestSyncControlFlowStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -336,8 +348,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -392,8 +407,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -443,8 +461,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -469,6 +490,57 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Stage is locked",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Lock: &kargoapi.StageLock{
+						Reason: "incident",
+					},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					// This should never be called for a locked Stage
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name: "error checking if auto-promotion is permitted",
 			stage: &kargoapi.Stage{
@@ -486,8 +558,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -536,8 +611,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -580,8 +658,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -637,8 +718,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -690,8 +774,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -745,8 +832,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -810,8 +900,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return nil
 				},
@@ -891,8 +984,11 @@ func TestSyncNormalStage(t *testing.T) {
 				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
 				checkHealthFn: func(
 					context.Context,
+					metav1.ObjectMeta,
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
+					[]kargoapi.FluxUpdate,
+					*kargoapi.HealthChecks,
 				) *kargoapi.Health {
 					return &kargoapi.Health{
 						Status: kargoapi.HealthStateHealthy,
@@ -1362,6 +1458,29 @@ func TestVerifyFreightInStage(t *testing.T) {
 		},
 		{
 			name: "Freight already verified in Stage",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {
+									VerifiedAt: &metav1.Time{Time: time.Now()},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Freight already verified in Stage at unknown time",
 			reconciler: &reconciler{
 				getFreightFn: func(
 					context.Context,
@@ -1376,6 +1495,15 @@ func TestVerifyFreightInStage(t *testing.T) {
 						},
 					}, nil
 				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					// The unknown verification time must not be backfilled
+					require.Fail(t, "Freight status should not be patched")
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -1554,10 +1682,11 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 func TestGetLatestAvailableFreight(t *testing.T) {
 	now := time.Now().UTC()
 	testCases := []struct {
-		name       string
-		subs       *kargoapi.Subscriptions
-		reconciler *reconciler
-		assertions func(*kargoapi.Freight, error)
+		name           string
+		subs           *kargoapi.Subscriptions
+		currentFreight *kargoapi.FreightReference
+		reconciler     *reconciler
+		assertions     func(*kargoapi.Freight, error)
 	}{
 		{
 			name: "error getting latest Freight from Warehouse",
@@ -1617,6 +1746,92 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 				require.NotNil(t, freight)
 			},
 		},
+		{
+			name: "found no Freight from one of multiple Warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) (*kargoapi.Freight, error) {
+					if warehouse == "fake-warehouse" {
+						return &kargoapi.Freight{}, nil
+					}
+					return nil, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "latest Freight from multiple Warehouses already in Stage",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			currentFreight: &kargoapi.FreightReference{
+				ID: "fake-composite",
+				Constituents: []kargoapi.FreightConstituent{
+					{ID: "fake-warehouse-freight", Warehouse: "fake-warehouse"},
+					{
+						ID:        "another-fake-warehouse-freight",
+						Warehouse: "another-fake-warehouse",
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: warehouse + "-freight",
+						},
+					}, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "success getting latest Freight from multiple Warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			currentFreight: &kargoapi.FreightReference{
+				ID: "fake-composite",
+				Constituents: []kargoapi.FreightConstituent{
+					{ID: "fake-warehouse-freight", Warehouse: "fake-warehouse"},
+					{ID: "old-freight", Warehouse: "another-fake-warehouse"},
+				},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: warehouse + "-freight",
+						},
+					}, nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Equal(t, "another-fake-warehouse-freight", freight.Name)
+			},
+		},
 		{
 			name: "error getting latest Freight verified in upstream Stages",
 			subs: &kargoapi.Subscriptions{
@@ -1627,6 +1842,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -1651,6 +1867,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1682,6 +1899,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1708,6 +1926,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -1734,6 +1953,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1760,6 +1980,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -1801,6 +2022,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -1843,6 +2065,9 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 						Spec: &kargoapi.StageSpec{
 							Subscriptions: testCase.subs,
 						},
+						Status: kargoapi.StageStatus{
+							CurrentFreight: testCase.currentFreight,
+						},
 					},
 				),
 			)
@@ -1944,6 +2169,7 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
+		mode       kargoapi.UpstreamStagesMode
 		assertions func([]kargoapi.Freight, error)
 	}{
 		{
@@ -2017,6 +2243,43 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "success with all upstream Stages required",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "another-fake-freight",
+							},
+						},
+					}
+					return nil
+				},
+			},
+			mode: kargoapi.UpstreamStagesModeAll,
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -2029,6 +2292,7 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 							Name: "fake-stage",
 						},
 					},
+					testCase.mode,
 				),
 			)
 		})
@@ -2048,6 +2312,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -2064,6 +2329,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -2080,6 +2346,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return []kargoapi.Freight{
 						{
@@ -2116,6 +2383,162 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Background(),
 					"fake-namespace",
 					[]kargoapi.StageSubscription{},
+					kargoapi.UpstreamStagesModeAny,
+				),
+			)
+		})
+	}
+}
+
+func TestGetMinSoakTimeRemaining(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{
+						Name:            "fake-stage",
+						MinSoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		reconciler *reconciler
+		assertions func(time.Duration, error)
+	}{
+		{
+			name: "no minimum soak durations",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						UpstreamStages: []kargoapi.StageSubscription{
+							{Name: "fake-stage"},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{},
+			assertions: func(remaining time.Duration, err error) {
+				require.NoError(t, err)
+				require.Zero(t, remaining)
+			},
+		},
+		{
+			name:  "error listing Freight",
+			stage: testStage,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ time.Duration, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name:  "verification time unknown",
+			stage: testStage,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(remaining time.Duration, err error) {
+				require.NoError(t, err)
+				// Treated as having already soaked; no status is patched
+				require.Zero(t, remaining)
+			},
+		},
+		{
+			name:  "success",
+			stage: testStage,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							// Already soaked
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &metav1.Time{
+											Time: time.Now().Add(-2 * time.Hour),
+										},
+									},
+								},
+							},
+						},
+						{
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &metav1.Time{
+											Time: time.Now().Add(-30 * time.Minute),
+										},
+									},
+								},
+							},
+						},
+						{
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &metav1.Time{
+											Time: time.Now().Add(-10 * time.Minute),
+										},
+									},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(remaining time.Duration, err error) {
+				require.NoError(t, err)
+				require.InDelta(
+					t,
+					30*time.Minute,
+					remaining,
+					float64(time.Second),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.getMinSoakTimeRemaining(
+					context.Background(),
+					testCase.stage,
 				),
 			)
 		})
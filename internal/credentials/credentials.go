@@ -14,9 +14,9 @@ import (
 )
 
 const (
-	// kargoSecretTypeLabelKey is the key for a label used to identify the type
+	// SecretTypeLabelKey is the key for a label used to identify the type
 	// of credentials stored in a Secret.
-	kargoSecretTypeLabelKey = "kargo.akuity.io/secret-type" // nolint: gosec
+	SecretTypeLabelKey = "kargo.akuity.io/secret-type" // nolint: gosec
 	// repositorySecretTypeLabelValue denotes that a secret contains credentials
 	// for a repository that is an exact match on the normalized URL.
 	repositorySecretTypeLabelValue = "repository"
@@ -119,7 +119,7 @@ func (k *kubernetesDatabase) Get(
 		k.kargoClient,
 		namespace,
 		labels.Set(map[string]string{
-			kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+			SecretTypeLabelKey: repositorySecretTypeLabelValue,
 		}).AsSelector(),
 		credType,
 		repoURL,
@@ -135,7 +135,7 @@ func (k *kubernetesDatabase) Get(
 			k.kargoClient,
 			namespace,
 			labels.Set(map[string]string{
-				kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+				SecretTypeLabelKey: repoCredsSecretTypeLabelValue,
 			}).AsSelector(),
 			credType,
 			repoURL,
@@ -154,7 +154,7 @@ func (k *kubernetesDatabase) Get(
 				k.kargoClient,
 				globalCredsNamespace,
 				labels.Set(map[string]string{
-					kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+					SecretTypeLabelKey: repositorySecretTypeLabelValue,
 				}).AsSelector(),
 				credType,
 				repoURL,
@@ -172,7 +172,7 @@ func (k *kubernetesDatabase) Get(
 				k.kargoClient,
 				globalCredsNamespace,
 				labels.Set(map[string]string{
-					kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+					SecretTypeLabelKey: repoCredsSecretTypeLabelValue,
 				}).AsSelector(),
 				credType,
 				repoURL,
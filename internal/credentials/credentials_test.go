@@ -39,7 +39,7 @@ func TestGet(t *testing.T) {
 			Name:      "in-namespace-exact",
 			Namespace: testNamespace,
 			Labels: map[string]string{
-				kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+				SecretTypeLabelKey: repositorySecretTypeLabelValue,
 			},
 		},
 		Data: map[string][]byte{
@@ -54,7 +54,7 @@ func TestGet(t *testing.T) {
 			Name:      "in-namespace-prefix",
 			Namespace: testNamespace,
 			Labels: map[string]string{
-				kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+				SecretTypeLabelKey: repoCredsSecretTypeLabelValue,
 			},
 		},
 		Data: map[string][]byte{
@@ -69,7 +69,7 @@ func TestGet(t *testing.T) {
 			Name:      "in-global-exact",
 			Namespace: testGlobalNamespaces[0],
 			Labels: map[string]string{
-				kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+				SecretTypeLabelKey: repositorySecretTypeLabelValue,
 			},
 		},
 		Data: map[string][]byte{
@@ -84,7 +84,7 @@ func TestGet(t *testing.T) {
 			Name:      "in-global-prefix",
 			Namespace: testGlobalNamespaces[0],
 			Labels: map[string]string{
-				kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+				SecretTypeLabelKey: repoCredsSecretTypeLabelValue,
 			},
 		},
 		Data: map[string][]byte{
@@ -99,7 +99,7 @@ func TestGet(t *testing.T) {
 			Name:      "insecure-http-endpoint",
 			Namespace: testNamespace,
 			Labels: map[string]string{
-				kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+				SecretTypeLabelKey: repositorySecretTypeLabelValue,
 			},
 		},
 		Data: map[string][]byte{
@@ -167,3 +167,86 @@ func (g *GitHubProvider) IsPullRequestMerged(ctx context.Context, repoURL string
 	}
 	return merged, nil
 }
+
+func (g *GitHubProvider) ClosePullRequest(ctx context.Context, repoURL string, id int64) error {
+	owner, repo, err := parseGitHubURL(repoURL)
+	if err != nil {
+		return err
+	}
+	_, _, err = g.client.PullRequests.Edit(ctx, owner, repo, int(id), &github.PullRequest{
+		State: github.String("closed"),
+	})
+	return err
+}
+
+func (g *GitHubProvider) CreateCommitStatus(
+	ctx context.Context,
+	repoURL string,
+	sha string,
+	opts gitprovider.CreateCommitStatusOpts,
+) error {
+	owner, repo, err := parseGitHubURL(repoURL)
+	if err != nil {
+		return err
+	}
+	_, _, err = g.client.Repositories.CreateStatus(ctx, owner, repo, sha, &github.RepoStatus{
+		Context:     &opts.Context,
+		State:       github.String(convertCommitState(opts.State)),
+		Description: &opts.Description,
+		TargetURL:   &opts.TargetURL,
+	})
+	return err
+}
+
+func (g *GitHubProvider) CreateDeployment(
+	ctx context.Context,
+	repoURL string,
+	opts gitprovider.CreateDeploymentOpts,
+) (*gitprovider.Deployment, error) {
+	owner, repo, err := parseGitHubURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	ghDeployment, _, err := g.client.Repositories.CreateDeployment(ctx, owner, repo, &github.DeploymentRequest{
+		Ref:         &opts.Ref,
+		Environment: &opts.Environment,
+		Description: &opts.Description,
+		// The deployment has already happened by the time it's recorded, so GitHub
+		// must neither attempt to merge the default branch into Ref nor wait for
+		// Ref's commit statuses to succeed.
+		AutoMerge:        github.Bool(false),
+		RequiredContexts: &[]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	deployment := &gitprovider.Deployment{
+		ID:     ptr.Deref(ghDeployment.ID, 0),
+		URL:    ptr.Deref(ghDeployment.URL, ""),
+		Object: ghDeployment,
+	}
+	// https://docs.github.com/en/rest/deployments/statuses?apiVersion=2022-11-28#create-a-deployment-status
+	_, _, err = g.client.Repositories.CreateDeploymentStatus(ctx, owner, repo, deployment.ID, &github.DeploymentStatusRequest{
+		State:          github.String(convertCommitState(opts.State)),
+		Description:    &opts.Description,
+		Environment:    &opts.Environment,
+		EnvironmentURL: &opts.EnvironmentURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func convertCommitState(state gitprovider.CommitState) string {
+	switch state {
+	case gitprovider.CommitStateSuccess:
+		return "success"
+	case gitprovider.CommitStateFailure:
+		return "failure"
+	case gitprovider.CommitStateError:
+		return "error"
+	default:
+		return "pending"
+	}
+}
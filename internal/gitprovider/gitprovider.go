@@ -21,6 +21,16 @@ type GitProviderService interface { // nolint: revive
 
 	// IsPullRequestMerged returns whether or not the pull request was merged
 	IsPullRequestMerged(ctx context.Context, repoURL string, number int64) (bool, error)
+
+	// ClosePullRequest closes an open pull request without merging it
+	ClosePullRequest(ctx context.Context, repoURL string, number int64) error
+
+	// CreateCommitStatus sets the status of a commit as reported by a given context
+	CreateCommitStatus(ctx context.Context, repoURL string, sha string, opts CreateCommitStatusOpts) error
+
+	// CreateDeployment records a deployment of a commit to an environment, along
+	// with the deployment's status
+	CreateDeployment(ctx context.Context, repoURL string, opts CreateDeploymentOpts) (*Deployment, error)
 }
 
 type CreatePullRequestOpts struct {
@@ -44,6 +54,45 @@ const (
 	PullRequestStateClosed PullRequestState = "Closed"
 )
 
+type CreateCommitStatusOpts struct {
+	// Context is a label that differentiates this status from the statuses
+	// reported by other systems or for other purposes
+	Context     string
+	State       CommitState
+	Description string
+	// TargetURL is the URL the status links to
+	TargetURL string
+}
+
+type CreateDeploymentOpts struct {
+	// Ref is the commit SHA, branch, or tag that was deployed
+	Ref         string
+	Environment string
+	State       CommitState
+	Description string
+	// EnvironmentURL is the URL the deployment's environment links to
+	EnvironmentURL string
+}
+
+// CommitState is the state of a commit status or of a deployment.
+type CommitState string
+
+const (
+	CommitStatePending CommitState = "Pending"
+	CommitStateSuccess CommitState = "Success"
+	CommitStateFailure CommitState = "Failure"
+	CommitStateError   CommitState = "Error"
+)
+
+type Deployment struct {
+	// ID is the provider-assigned identifier of the deployment
+	ID int64 `json:"id"`
+	// URL is the url to the deployment
+	URL string `json:"url"`
+	// Object is the underlying object from the provider
+	Object any `json:"-"`
+}
+
 type PullRequest struct {
 	// Number is the numeric pull request number (not an ID)
 	// Pull requests numbers are unique only within a repository
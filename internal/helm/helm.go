@@ -5,18 +5,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os/exec"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
-	"oras.land/oras-go/pkg/registry"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
+	orasregistry "oras.land/oras-go/pkg/registry"
 	"oras.land/oras-go/pkg/registry/remote"
 	"oras.land/oras-go/pkg/registry/remote/auth"
-
-	libExec "github.com/akuity/kargo/internal/exec"
 )
 
 // SelectChartVersion connects to the Helm chart repository specified by
@@ -140,7 +142,7 @@ func getChartVersionsFromOCIRepo(
 	repoURL string,
 	creds *Credentials,
 ) ([]string, error) {
-	ref, err := registry.ParseReference(strings.TrimPrefix(repoURL, "oci://"))
+	ref, err := orasregistry.ParseReference(strings.TrimPrefix(repoURL, "oci://"))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error parsing repository URL %q", repoURL)
 	}
@@ -198,18 +200,35 @@ func getLatestVersion(versions []string, constraintStr string) (string, error) {
 	return "", nil
 }
 
+// UpdateChartDependencies updates the dependencies of the chart at chartPath,
+// equivalent to running `helm dependency update`, but without shelling out to
+// the helm binary. Helm's configuration, including repository and registry
+// credentials, is read from, and its cache is written to, the usual locations
+// relative to homePath, which should be a directory private to the caller.
 func UpdateChartDependencies(homePath, chartPath string) error {
-	cmd := exec.Command("helm", "dependency", "update", chartPath)
-	homeEnvVar := fmt.Sprintf("HOME=%s", homePath)
-	if cmd.Env == nil {
-		cmd.Env = []string{homeEnvVar}
-	} else {
-		cmd.Env = append(cmd.Env, homeEnvVar)
+	configPath := filepath.Join(homePath, ".config", "helm")
+	registryClient, err := registry.NewClient(
+		registry.ClientOptCredentialsFile(
+			filepath.Join(configPath, "registry", "config.json"),
+		),
+		registry.ClientOptWriter(io.Discard),
+	)
+	if err != nil {
+		return errors.Wrap(err, "error creating Helm registry client")
+	}
+	mgr := &downloader.Manager{
+		Out:       io.Discard,
+		ChartPath: chartPath,
+		Getters: getter.All(&cli.EnvSettings{
+			PluginsDirectory: filepath.Join(homePath, ".local", "share", "helm", "plugins"),
+		}),
+		RegistryClient:   registryClient,
+		RepositoryConfig: filepath.Join(configPath, "repositories.yaml"),
+		RepositoryCache:  filepath.Join(homePath, ".cache", "helm", "repository"),
 	}
-	_, err := libExec.Exec(cmd)
 	return errors.Wrapf(
-		err,
-		"error running `helm dependency update` for chart at %q",
+		mgr.Update(),
+		"error updating dependencies for chart at %q",
 		chartPath,
 	)
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -166,3 +168,63 @@ func TestGetLatestVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateChartDependencies(t *testing.T) {
+	writeFile := func(t *testing.T, path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	testCases := []struct {
+		name       string
+		setup      func(t *testing.T, dir string) string
+		assertions func(t *testing.T, chartPath string, err error)
+	}{
+		{
+			name: "chart does not exist",
+			setup: func(_ *testing.T, dir string) string {
+				return filepath.Join(dir, "missing")
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error updating dependencies for chart")
+			},
+		},
+		{
+			name: "success",
+			setup: func(t *testing.T, dir string) string {
+				writeFile(
+					t,
+					filepath.Join(dir, "child", "Chart.yaml"),
+					"apiVersion: v2\nname: child\nversion: 0.1.0\n",
+				)
+				writeFile(
+					t,
+					filepath.Join(dir, "parent", "Chart.yaml"),
+					`apiVersion: v2
+name: parent
+version: 0.1.0
+dependencies:
+- name: child
+  version: 0.1.0
+  repository: file://../child
+`,
+				)
+				return filepath.Join(dir, "parent")
+			},
+			assertions: func(t *testing.T, chartPath string, err error) {
+				require.NoError(t, err)
+				require.FileExists(t, filepath.Join(chartPath, "Chart.lock"))
+				require.FileExists(t, filepath.Join(chartPath, "charts", "child-0.1.0.tgz"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			chartPath := testCase.setup(t, t.TempDir())
+			testCase.assertions(
+				t,
+				chartPath,
+				UpdateChartDependencies(t.TempDir(), chartPath),
+			)
+		})
+	}
+}
@@ -71,6 +71,18 @@ type Response struct {
 }
 
 // Execute a `kargo-render render` command and return the response.
+//
+// Unlike Kustomize and Helm, Kargo Render is still invoked as an external
+// process. This means:
+//
+//   - The controller image must continue to be built on top of the Kargo
+//     Render image.
+//   - The repository password is handed to the kargo-render process through
+//     its environment, where it is visible to anything able to inspect that
+//     process.
+//
+// TODO: Render in-process, as is already done for Kustomize and Helm, once
+// the Kargo Render module can be added as a dependency of this module.
 func RenderManifests(req Request) (Response, error) { // nolint: revive
 	res := Response{}
 	resBytes, err := libExec.Exec(buildRenderCmd(req))
@@ -190,11 +190,8 @@ func IndexFreightByWarehouse(ctx context.Context, mgr ctrl.Manager) error {
 
 func indexFreightByWarehouse(obj client.Object) []string {
 	freight := obj.(*kargoapi.Freight) // nolint: forcetypeassert
-	for _, ownerRef := range freight.OwnerReferences {
-		if ownerRef.APIVersion == kargoapi.GroupVersion.String() &&
-			ownerRef.Kind == "Warehouse" {
-			return []string{ownerRef.Name}
-		}
+	if warehouse := freight.GetWarehouse(); warehouse != "" {
+		return []string{warehouse}
 	}
 	return nil
 }
@@ -260,10 +257,8 @@ func IndexStagesByFreight(ctx context.Context, mgr ctrl.Manager) error {
 
 func indexStagesByFreight(obj client.Object) []string {
 	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
-	if stage.Status.CurrentFreight != nil {
-		if id := stage.Status.CurrentFreight.ID; id != "" {
-			return []string{id}
-		}
+	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID != "" {
+		return stage.Status.CurrentFreight.FreightIDs()
 	}
 	return nil
 }
@@ -300,10 +295,7 @@ func IndexStagesByWarehouse(ctx context.Context, mgr ctrl.Manager) error {
 
 func indexStagesByWarehouse(obj client.Object) []string {
 	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
-	if stage.Spec.Subscriptions.Warehouse != "" {
-		return []string{stage.Spec.Subscriptions.Warehouse}
-	}
-	return nil
+	return stage.Spec.Subscriptions.GetWarehouses()
 }
 
 func IndexServiceAccountsByOIDCEmail(ctx context.Context, mgr ctrl.Manager) error {
@@ -1,27 +1,104 @@
 package kustomize
 
 import (
-	"os/exec"
+	"os"
+	"path/filepath"
+	"strings"
 
-	libExec "github.com/akuity/kargo/internal/exec"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/kustomize/api/konfig"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
-// SetImage runs `kustomize edit set image ...` in the specified directory.
-// The specified directory must already exist and contain a kustomization.yaml
-// file.
+// SetImage is the in-process equivalent of running
+// `kustomize edit set image ...` in the specified directory. The specified
+// directory must already exist and contain a kustomization.yaml file.
 func SetImage(dir, fqImageRef string) error {
-	_, err := libExec.Exec(buildSetImageCmd(dir, fqImageRef))
-	return err
+	path, err := findKustomization(dir)
+	if err != nil {
+		return err
+	}
+	node, err := kyaml.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "error reading Kustomization file %q", path)
+	}
+	if err = setImage(node, fqImageRef); err != nil {
+		return errors.Wrapf(
+			err,
+			"error setting image %q in Kustomization file %q",
+			fqImageRef,
+			path,
+		)
+	}
+	return errors.Wrapf(
+		kyaml.WriteFile(node, path),
+		"error writing Kustomization file %q",
+		path,
+	)
+}
+
+// findKustomization returns the path to the Kustomization file in the
+// specified directory, trying each file name recognized by Kustomize in turn.
+func findKustomization(dir string) (string, error) {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !os.IsNotExist(err) {
+			return "", errors.Wrapf(err, "error checking for %q", path)
+		}
+	}
+	return "", errors.Errorf("no Kustomization file found in %q", dir)
 }
 
-func buildSetImageCmd(dir, fqImageRef string) *exec.Cmd {
-	cmd := exec.Command( // nolint: gosec
-		"kustomize",
-		"edit",
-		"set",
-		"image",
-		fqImageRef,
+// setImage updates the images field of the provided Kustomization such that
+// the image identified by fqImageRef is pinned to the tag or digest also
+// specified by fqImageRef. If no entry for the image exists yet, one is added.
+func setImage(kustomization *kyaml.RNode, fqImageRef string) error {
+	name, tag, digest := splitImageRef(fqImageRef)
+	if tag == "" && digest == "" {
+		return errors.New("image reference specifies neither a tag nor a digest")
+	}
+	images, err := kustomization.Pipe(kyaml.LookupCreate(kyaml.SequenceNode, "images"))
+	if err != nil {
+		return errors.Wrap(err, "error finding images field")
+	}
+	image, err := images.Pipe(kyaml.MatchElement("name", name))
+	if err != nil {
+		return errors.Wrapf(err, "error finding image %q", name)
+	}
+	if image == nil {
+		image = kyaml.NewMapRNode(&map[string]string{"name": name})
+		if err = images.PipeE(kyaml.Append(image.YNode())); err != nil {
+			return errors.Wrapf(err, "error adding image %q", name)
+		}
+	}
+	// Tags and digests are mutually exclusive
+	setField, clearField, value := "newTag", "digest", tag
+	if digest != "" {
+		setField, clearField, value = "digest", "newTag", digest
+	}
+	if err = image.PipeE(kyaml.Clear(clearField)); err != nil {
+		return errors.Wrapf(err, "error clearing %s of image %q", clearField, name)
+	}
+	return errors.Wrapf(
+		image.PipeE(kyaml.SetField(setField, kyaml.NewStringRNode(value))),
+		"error setting %s of image %q",
+		setField,
+		name,
 	)
-	cmd.Dir = dir
-	return cmd
+}
+
+// splitImageRef splits an image reference of the form <name>:<tag> or
+// <name>@<digest> into its component parts.
+func splitImageRef(fqImageRef string) (name, tag, digest string) {
+	if i := strings.Index(fqImageRef, "@"); i >= 0 {
+		return fqImageRef[:i], "", fqImageRef[i+1:]
+	}
+	// A colon only separates a tag if it follows the last slash. Otherwise, it
+	// separates a registry's host name from its port.
+	if i := strings.LastIndex(fqImageRef, ":"); i > strings.LastIndex(fqImageRef, "/") {
+		return fqImageRef[:i], fqImageRef[i+1:], ""
+	}
+	return fqImageRef, "", ""
 }
@@ -1,28 +1,141 @@
 package kustomize
 
 import (
-	"strings"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
-func TestBuildSetImageCmd(t *testing.T) {
-	const testDir = "/some-dir"
-	const testImageRef = "some-image:some-tag"
-	cmd := buildSetImageCmd(testDir, testImageRef)
-	require.NotNil(t, cmd)
-	require.True(t, strings.HasSuffix(cmd.Path, "kustomize"))
-	require.Equal(
-		t,
-		[]string{
-			"kustomize",
-			"edit",
-			"set",
-			"image",
-			testImageRef,
-		},
-		cmd.Args,
-	)
-	require.Equal(t, testDir, cmd.Dir)
+func TestSetImage(t *testing.T) {
+	testCases := []struct {
+		name          string
+		kustomization string
+		fqImageRef    string
+		assertions    func(string, error)
+	}{
+		{
+			name:       "no Kustomization file",
+			fqImageRef: "fake-image:fake-tag",
+			assertions: func(_ string, err error) {
+				require.ErrorContains(t, err, "no Kustomization file found")
+			},
+		},
+		{
+			name:          "image reference without tag or digest",
+			kustomization: "resources:\n- deployment.yaml\n",
+			fqImageRef:    "fake-image",
+			assertions: func(_ string, err error) {
+				require.ErrorContains(t, err, "neither a tag nor a digest")
+			},
+		},
+		{
+			name: "adds new image with tag",
+			kustomization: `# A comment that should be preserved
+resources:
+- deployment.yaml
+`,
+			fqImageRef: "localhost:5000/fake-image:1.0",
+			assertions: func(result string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# A comment that should be preserved
+resources:
+- deployment.yaml
+images:
+- name: localhost:5000/fake-image
+  newTag: "1.0"
+`,
+					result,
+				)
+			},
+		},
+		{
+			name: "replaces tag of existing image with digest",
+			kustomization: `images:
+- name: fake-image
+  newName: another-fake-image
+  newTag: fake-tag
+- name: unrelated-image
+  newTag: fake-tag
+`,
+			fqImageRef: "fake-image@sha256:fake-digest",
+			assertions: func(result string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`images:
+- name: fake-image
+  newName: another-fake-image
+  digest: sha256:fake-digest
+- name: unrelated-image
+  newTag: fake-tag
+`,
+					result,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "kustomization.yaml")
+			if testCase.kustomization != "" {
+				require.NoError(
+					t,
+					os.WriteFile(path, []byte(testCase.kustomization), 0600),
+				)
+			}
+			err := SetImage(dir, testCase.fqImageRef)
+			var result []byte
+			if err == nil {
+				result, err = os.ReadFile(path)
+				require.NoError(t, err)
+			}
+			testCase.assertions(string(result), err)
+		})
+	}
+}
+
+func TestSplitImageRef(t *testing.T) {
+	testCases := []struct {
+		fqImageRef string
+		name       string
+		tag        string
+		digest     string
+	}{
+		{
+			fqImageRef: "fake-image",
+			name:       "fake-image",
+		},
+		{
+			fqImageRef: "fake-image:fake-tag",
+			name:       "fake-image",
+			tag:        "fake-tag",
+		},
+		{
+			fqImageRef: "localhost:5000/fake-image",
+			name:       "localhost:5000/fake-image",
+		},
+		{
+			fqImageRef: "localhost:5000/fake-image:fake-tag",
+			name:       "localhost:5000/fake-image",
+			tag:        "fake-tag",
+		},
+		{
+			fqImageRef: "localhost:5000/fake-image@sha256:fake-digest",
+			name:       "localhost:5000/fake-image",
+			digest:     "sha256:fake-digest",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.fqImageRef, func(t *testing.T) {
+			name, tag, digest := splitImageRef(testCase.fqImageRef)
+			require.Equal(t, testCase.name, name)
+			require.Equal(t, testCase.tag, tag)
+			require.Equal(t, testCase.digest, digest)
+		})
+	}
 }
@@ -0,0 +1,240 @@
+// Package lint validates Kargo resources loaded from manifests without access
+// to a cluster. It applies the same validations as Kargo's admission webhooks,
+// along with checks of the references between resources.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/webhook/project"
+	"github.com/akuity/kargo/internal/webhook/stage"
+	"github.com/akuity/kargo/internal/webhook/warehouse"
+)
+
+// Severity is the severity of a Finding.
+type Severity string
+
+const (
+	// SeverityError indicates that a resource would be rejected by Kargo.
+	SeverityError Severity = "error"
+	// SeverityWarning indicates that a resource would be accepted by Kargo, but
+	// probably does not behave as intended.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule identifies the check that produced a Finding.
+type Rule string
+
+const (
+	// RuleParse is violated by documents that cannot be parsed.
+	RuleParse Rule = "parse"
+	// RuleDuplicate is violated by resources that are defined more than once.
+	RuleDuplicate Rule = "duplicate"
+	// RuleSpec is violated by resources with an invalid spec.
+	RuleSpec Rule = "spec"
+	// RuleSubscriptionCycle is violated by Stages whose subscriptions form a
+	// cycle.
+	RuleSubscriptionCycle Rule = "subscription-cycle"
+	// RuleMissingReference is violated by resources that reference other
+	// resources that are not defined.
+	RuleMissingReference Rule = "missing-reference"
+)
+
+// Rules maps every Rule to a short description of it.
+var Rules = map[Rule]string{
+	RuleParse:             "Documents must be parseable Kargo resources",
+	RuleDuplicate:         "Resources must not be defined more than once",
+	RuleSpec:              "Resources must have a valid spec",
+	RuleSubscriptionCycle: "Stage subscriptions must not form a cycle",
+	RuleMissingReference:  "Referenced resources should be defined",
+}
+
+// Finding is a problem found with a resource.
+type Finding struct {
+	File      string   `json:"file"`
+	Line      int      `json:"line"`
+	Kind      string   `json:"kind,omitempty"`
+	Namespace string   `json:"namespace,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Field     string   `json:"field,omitempty"`
+	Rule      Rule     `json:"rule"`
+	Severity  Severity `json:"severity"`
+	Message   string   `json:"message"`
+}
+
+// Lint validates the specified resources and returns any Findings, ordered by
+// file and line. Since resources may be linted without the rest of their
+// Project, references to resources that are not among those specified only
+// produce warnings.
+func Lint(objects []Object) []Finding {
+	var findings []Finding
+
+	type key struct {
+		kind      string
+		namespace string
+		name      string
+	}
+	seen := make(map[key]Object, len(objects))
+	warehousesByNamespace := map[string][]kargoapi.Warehouse{}
+	stagesByNamespace := map[string][]kargoapi.Stage{}
+	for _, obj := range objects {
+		k := key{
+			kind:      kindOf(obj),
+			namespace: obj.Object.GetNamespace(),
+			name:      obj.Object.GetName(),
+		}
+		if first, ok := seen[k]; ok {
+			findings = append(findings, newFinding(
+				obj,
+				"",
+				RuleDuplicate,
+				SeverityError,
+				fmt.Sprintf("already defined in %s:%d", first.File, first.Line),
+			))
+			continue
+		}
+		seen[k] = obj
+		switch o := obj.Object.(type) {
+		case *kargoapi.Stage:
+			stagesByNamespace[o.Namespace] = append(stagesByNamespace[o.Namespace], *o)
+		case *kargoapi.Warehouse:
+			warehousesByNamespace[o.Namespace] =
+				append(warehousesByNamespace[o.Namespace], *o)
+		}
+	}
+
+	specPath := field.NewPath("spec")
+	for _, obj := range objects {
+		var errs field.ErrorList
+		switch o := obj.Object.(type) {
+		case *kargoapi.Project:
+			errs = project.ValidateSpec(specPath, o.Spec)
+			if o.Spec == nil {
+				break
+			}
+			stages := stagesByNamespace[o.Name]
+			for i, policy := range o.Spec.PromotionPolicies {
+				if !hasStage(stages, policy.Stage) {
+					findings = append(findings, newFinding(
+						obj,
+						specPath.Child("promotionPolicies").Index(i).Child("stage").String(),
+						RuleMissingReference,
+						SeverityWarning,
+						fmt.Sprintf("Stage %q is not defined", policy.Stage),
+					))
+				}
+			}
+		case *kargoapi.Promotion:
+			if o.Spec == nil {
+				errs = field.ErrorList{field.Required(specPath, "")}
+				break
+			}
+			if !hasStage(stagesByNamespace[o.Namespace], o.Spec.Stage) {
+				findings = append(findings, newFinding(
+					obj,
+					specPath.Child("stage").String(),
+					RuleMissingReference,
+					SeverityWarning,
+					fmt.Sprintf("Stage %q is not defined", o.Spec.Stage),
+				))
+			}
+		case *kargoapi.Stage:
+			if o.Spec == nil {
+				errs = field.ErrorList{field.Required(specPath, "")}
+				break
+			}
+			errs = stage.ValidateSpec(specPath, o.Spec)
+			subsPath := specPath.Child("subscriptions")
+			warnings, graphErrs := stage.ValidateSubsGraph(
+				subsPath,
+				o,
+				warehousesByNamespace[o.Namespace],
+				stagesByNamespace[o.Namespace],
+			)
+			for _, warning := range warnings {
+				findings = append(findings, newFinding(
+					obj,
+					subsPath.String(),
+					RuleMissingReference,
+					SeverityWarning,
+					warning,
+				))
+			}
+			for _, err := range graphErrs {
+				findings = append(findings, newFinding(
+					obj,
+					err.Field,
+					RuleSubscriptionCycle,
+					SeverityError,
+					err.ErrorBody(),
+				))
+			}
+		case *kargoapi.Warehouse:
+			if o.Spec == nil {
+				errs = field.ErrorList{field.Required(specPath, "")}
+				break
+			}
+			errs = warehouse.ValidateSpec(specPath, o.Spec)
+		}
+		for _, err := range errs {
+			findings = append(findings, newFinding(
+				obj,
+				err.Field,
+				RuleSpec,
+				SeverityError,
+				err.ErrorBody(),
+			))
+		}
+	}
+
+	SortFindings(findings)
+	return findings
+}
+
+// SortFindings sorts Findings by file and line, preserving the relative order
+// of Findings at the same location.
+func SortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+}
+
+func newFinding(
+	obj Object,
+	fieldPath string,
+	rule Rule,
+	severity Severity,
+	message string,
+) Finding {
+	return Finding{
+		File:      obj.File,
+		Line:      obj.Line,
+		Kind:      kindOf(obj),
+		Namespace: obj.Object.GetNamespace(),
+		Name:      obj.Object.GetName(),
+		Field:     fieldPath,
+		Rule:      rule,
+		Severity:  severity,
+		Message:   message,
+	}
+}
+
+func kindOf(obj Object) string {
+	return obj.Object.GetObjectKind().GroupVersionKind().Kind
+}
+
+func hasStage(stages []kargoapi.Stage, name string) bool {
+	for _, s := range stages {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
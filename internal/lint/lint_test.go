@@ -0,0 +1,165 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestLint(t *testing.T) {
+	newObject := func(line int, obj kargoapi.Stage) Object {
+		obj.TypeMeta = metav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Stage",
+		}
+		return Object{File: "fake.yaml", Line: line, Object: &obj}
+	}
+	validMechanisms := &kargoapi.PromotionMechanisms{
+		GitRepoUpdates: []kargoapi.GitRepoUpdate{{RepoURL: "https://github.com/x/y"}},
+	}
+	testCases := []struct {
+		name       string
+		objects    []Object
+		assertions func([]Finding)
+	}{
+		{
+			name: "valid",
+			objects: []Object{
+				{
+					File: "fake.yaml",
+					Line: 1,
+					Object: &kargoapi.Warehouse{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: kargoapi.GroupVersion.String(),
+							Kind:       "Warehouse",
+						},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "images"},
+						Spec:       &kargoapi.WarehouseSpec{},
+					},
+				},
+				newObject(10, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "test"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions:       &kargoapi.Subscriptions{Warehouse: "images"},
+						PromotionMechanisms: validMechanisms,
+					},
+				}),
+			},
+			assertions: func(findings []Finding) {
+				require.Empty(t, findings)
+			},
+		},
+		{
+			name: "invalid spec",
+			objects: []Object{
+				newObject(1, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "test"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions:       &kargoapi.Subscriptions{},
+						PromotionMechanisms: validMechanisms,
+					},
+				}),
+				newObject(2, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "uat"},
+				}),
+			},
+			assertions: func(findings []Finding) {
+				require.Len(t, findings, 2)
+				require.Equal(t, RuleSpec, findings[0].Rule)
+				require.Equal(t, SeverityError, findings[0].Severity)
+				require.Equal(t, "Stage", findings[0].Kind)
+				require.Equal(t, "spec.subscriptions", findings[0].Field)
+				require.Equal(t, "spec", findings[1].Field)
+			},
+		},
+		{
+			name: "duplicate",
+			objects: []Object{
+				newObject(1, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "test"},
+				}),
+				newObject(5, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "test"},
+				}),
+			},
+			assertions: func(findings []Finding) {
+				var found bool
+				for _, f := range findings {
+					if f.Rule == RuleDuplicate {
+						found = true
+						require.Equal(t, 5, f.Line)
+						require.Equal(t, "already defined in fake.yaml:1", f.Message)
+					}
+				}
+				require.True(t, found)
+			},
+		},
+		{
+			name: "cycle and missing references",
+			objects: []Object{
+				newObject(1, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "test"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{{Name: "uat"}},
+						},
+						PromotionMechanisms: validMechanisms,
+					},
+				}),
+				newObject(2, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "uat"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{{Name: "test"}},
+						},
+						PromotionMechanisms: validMechanisms,
+					},
+				}),
+				newObject(3, kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "prod"},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouse: "missing",
+						},
+						PromotionMechanisms: validMechanisms,
+					},
+				}),
+				{
+					File: "fake.yaml",
+					Line: 4,
+					Object: &kargoapi.Promotion{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: kargoapi.GroupVersion.String(),
+							Kind:       "Promotion",
+						},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "promo"},
+						Spec: &kargoapi.PromotionSpec{
+							Stage:   "missing",
+							Freight: "abc123",
+						},
+					},
+				},
+			},
+			assertions: func(findings []Finding) {
+				require.Len(t, findings, 4)
+				require.Equal(t, RuleSubscriptionCycle, findings[0].Rule)
+				require.Equal(t, SeverityError, findings[0].Severity)
+				require.Equal(t, "spec.subscriptions.upstreamStages[0].name", findings[0].Field)
+				require.Equal(t, RuleSubscriptionCycle, findings[1].Rule)
+				require.Equal(t, RuleMissingReference, findings[2].Rule)
+				require.Equal(t, SeverityWarning, findings[2].Severity)
+				require.Equal(t, "prod", findings[2].Name)
+				require.Equal(t, RuleMissingReference, findings[3].Rule)
+				require.Equal(t, "spec.stage", findings[3].Field)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(Lint(testCase.objects))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Object is a Kargo resource loaded from a manifest.
+type Object struct {
+	// File is the path of the file the resource was loaded from.
+	File string
+	// Line is the line of the file at which the resource's document begins.
+	Line int
+	// Object is the resource itself.
+	Object client.Object
+}
+
+// document is a single YAML document within a file.
+type document struct {
+	file string
+	line int
+	data []byte
+}
+
+// Load loads Kargo resources from the specified files and directories.
+// Directories are searched for files with a .yaml, .yml or .json extension.
+// Subdirectories are only searched if recursive is true. Documents that are not
+// Kargo resources are ignored, since Kargo resources are frequently kept
+// alongside other manifests. Documents that cannot be parsed are returned as
+// Findings rather than errors, so that a single bad document does not prevent
+// the rest from being linted.
+func Load(paths []string, recursive bool) ([]Object, []Finding, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error reading %q", path)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		if err = filepath.WalkDir(
+			path,
+			func(p string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.IsDir() {
+					if p != path && !recursive {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				switch strings.ToLower(filepath.Ext(p)) {
+				case ".yaml", ".yml", ".json":
+					files = append(files, p)
+				}
+				return nil
+			},
+		); err != nil {
+			return nil, nil, errors.Wrapf(err, "error walking directory %q", path)
+		}
+	}
+
+	var objects []Object
+	var findings []Finding
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error reading file %q", file)
+		}
+		for _, doc := range splitDocuments(file, data) {
+			obj, err := decodeDocument(doc.data)
+			if err != nil {
+				findings = append(findings, Finding{
+					File:     doc.file,
+					Line:     doc.line,
+					Rule:     RuleParse,
+					Severity: SeverityError,
+					Message:  err.Error(),
+				})
+				continue
+			}
+			if obj != nil {
+				objects = append(objects, Object{
+					File:   doc.file,
+					Line:   doc.line,
+					Object: obj,
+				})
+			}
+		}
+	}
+	return objects, findings, nil
+}
+
+// splitDocuments splits the contents of a YAML file into its documents.
+// Documents containing nothing but whitespace and comments are omitted.
+func splitDocuments(file string, data []byte) []document {
+	var docs []document
+	var buf bytes.Buffer
+	start := 1
+	empty := true
+	flush := func() {
+		if !empty {
+			docs = append(docs, document{
+				file: file,
+				line: start,
+				data: bytes.Clone(buf.Bytes()),
+			})
+		}
+		buf.Reset()
+		empty = true
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "---") &&
+			strings.TrimSpace(strings.TrimPrefix(line, "---")) == "" {
+			flush()
+			start = lineNo + 1
+			continue
+		}
+		if trimmed := strings.TrimSpace(line); empty {
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+				start = lineNo + 1
+				continue
+			}
+			empty = false
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	flush()
+	return docs
+}
+
+// decodeDocument decodes a single YAML or JSON document. It returns nil if the
+// document is not a Kargo resource of a kind that can be linted. Unknown fields
+// are treated as errors, since they are almost always typos.
+func decodeDocument(data []byte) (client.Object, error) {
+	var typeMeta metav1.TypeMeta
+	if err := sigyaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, errors.Wrap(err, "error parsing document")
+	}
+	if typeMeta.APIVersion != kargoapi.GroupVersion.String() {
+		return nil, nil
+	}
+	var obj client.Object
+	switch typeMeta.Kind {
+	case "Project":
+		obj = &kargoapi.Project{}
+	case "Promotion":
+		obj = &kargoapi.Promotion{}
+	case "Stage":
+		obj = &kargoapi.Stage{}
+	case "Warehouse":
+		obj = &kargoapi.Warehouse{}
+	default:
+		return nil, nil
+	}
+	if err := sigyaml.UnmarshalStrict(data, obj); err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s", typeMeta.Kind)
+	}
+	return obj, nil
+}
@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "pipeline.yaml"),
+		[]byte(`# A Warehouse
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: images
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+spec:
+  subscriptionz: {}
+`),
+		0600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "README.md"),
+		[]byte("kind: Stage"),
+		0600,
+	))
+	nested := filepath.Join(dir, "nested")
+	require.NoError(t, os.Mkdir(nested, 0700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(nested, "project.json"),
+		[]byte(`{"apiVersion":"kargo.akuity.io/v1alpha1","kind":"Project","metadata":{"name":"demo"}}`),
+		0600,
+	))
+
+	t.Run("non-recursive", func(t *testing.T) {
+		objects, findings, err := Load([]string{dir}, false)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.IsType(t, &kargoapi.Warehouse{}, objects[0].Object)
+		require.Equal(t, 2, objects[0].Line)
+		require.Len(t, findings, 1)
+		require.Equal(t, RuleParse, findings[0].Rule)
+		require.Equal(t, 12, findings[0].Line)
+		require.Contains(t, findings[0].Message, `unknown field "subscriptionz"`)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		objects, _, err := Load([]string{dir}, true)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		// Directories are walked in lexical order
+		require.IsType(t, &kargoapi.Project{}, objects[0].Object)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, _, err := Load([]string{filepath.Join(dir, "missing")}, false)
+		require.Error(t, err)
+	})
+}
+
+func TestSplitDocuments(t *testing.T) {
+	docs := splitDocuments("fake.yaml", []byte(`---
+# Leading comment
+
+a: 1
+---
+# Nothing but a comment
+---
+b: 2
+`))
+	require.Equal(
+		t,
+		[]document{
+			{file: "fake.yaml", line: 4, data: []byte("a: 1\n")},
+			{file: "fake.yaml", line: 8, data: []byte("b: 2\n")},
+		},
+		docs,
+	)
+}
@@ -91,7 +91,7 @@ func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w := &webhook{
 		cfg: cfg,
 	}
-	w.validateSpecFn = w.validateSpec
+	w.validateSpecFn = ValidateSpec
 	w.ensureNamespaceFn = w.ensureNamespace
 	w.ensureSecretPermissionsFn = w.ensureSecretPermissions
 	w.getNamespaceFn = kubeClient.Get
@@ -155,20 +155,22 @@ func (w *webhook) ValidateDelete(
 	return nil, nil
 }
 
-func (w *webhook) validateSpec(
+// ValidateSpec validates a ProjectSpec beyond what its declarative validations
+// are capable of. It requires no access to a cluster.
+func ValidateSpec(
 	f *field.Path,
 	spec *kargoapi.ProjectSpec,
 ) field.ErrorList {
 	if spec == nil { // nil spec is valid
 		return nil
 	}
-	return w.validatePromotionPolicies(
+	return validatePromotionPolicies(
 		f.Child("promotionPolicies"),
 		spec.PromotionPolicies,
 	)
 }
 
-func (w *webhook) validatePromotionPolicies(
+func validatePromotionPolicies(
 	f *field.Path,
 	promotionPolicies []kargoapi.PromotionPolicy,
 ) field.ErrorList {
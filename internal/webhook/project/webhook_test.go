@@ -152,12 +152,11 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.spec,
-				w.validateSpec(field.NewPath("spec"), testCase.spec),
+				ValidateSpec(field.NewPath("spec"), testCase.spec),
 			)
 		})
 	}
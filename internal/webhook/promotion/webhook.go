@@ -2,6 +2,7 @@ package promotion
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	authzv1 "k8s.io/api/authorization/v1"
@@ -42,6 +43,12 @@ type webhook struct {
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+
 	validateProjectFn func(
 		context.Context,
 		client.Client,
@@ -78,6 +85,7 @@ func newWebhook(kubeClient client.Client) *webhook {
 		client: kubeClient,
 	}
 	w.getStageFn = kargoapi.GetStage
+	w.getFreightFn = kargoapi.GetFreight
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
 	w.admissionRequestFromContextFn = admission.RequestFromContext
@@ -125,7 +133,93 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, promotionGroupKind, promo); err != nil {
 		return nil, err
 	}
-	return nil, w.authorizeFn(ctx, promo, "create")
+	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
+		return nil, err
+	}
+
+	// Promotions to a locked Stage are rejected unless they are forced
+	stage, err := w.getStageFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error finding Stage %q in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+	if stage != nil && stage.Spec.Lock.IsActive(time.Now()) &&
+		promo.Annotations[kargoapi.AnnotationKeyForce] != "true" {
+		return nil, apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.Errorf(
+				"Stage %q in namespace %q is locked; set annotation %q to %q "+
+					"to force promotion",
+				promo.Spec.Stage,
+				promo.Namespace,
+				kargoapi.AnnotationKeyForce,
+				"true",
+			),
+		)
+	}
+
+	// Promotions of Freight that is not available to the Stage are rejected
+	if stage == nil || stage.Spec == nil || stage.Spec.Subscriptions == nil {
+		return nil, nil
+	}
+	freight, err := w.getFreightFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+	if freight == nil {
+		return nil, apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.NotFound(
+					field.NewPath("spec", "freight"),
+					promo.Spec.Freight,
+				),
+			},
+		)
+	}
+	if !kargoapi.IsFreightAvailable(
+		freight,
+		stage.Name,
+		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
+	) {
+		return nil, apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.Errorf(
+				"Freight %q is not available to Stage %q in namespace %q",
+				promo.Spec.Freight,
+				promo.Spec.Stage,
+				promo.Namespace,
+			),
+		)
+	}
+	return nil, nil
 }
 
 func (w *webhook) ValidateUpdate(
@@ -2,11 +2,19 @@ package stage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -24,7 +33,21 @@ var (
 	}
 )
 
+type WebhookConfig struct {
+	// KargoNamespace is the namespace in which Kargo is installed. It is used
+	// to recognize requests made by the Kargo API server.
+	KargoNamespace string `envconfig:"KARGO_NAMESPACE" required:"true"`
+}
+
+func WebhookConfigFromEnv() WebhookConfig {
+	cfg := WebhookConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type webhook struct {
+	cfg WebhookConfig
+
 	client client.Client
 
 	// The following behaviors are overridable for testing purposes:
@@ -36,13 +59,30 @@ type webhook struct {
 		client.Object,
 	) error
 
-	validateCreateOrUpdateFn func(*kargoapi.Stage) (admission.Warnings, error)
+	validateCreateOrUpdateFn func(
+		context.Context,
+		*kargoapi.Stage,
+	) (admission.Warnings, error)
 
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
+
+	validateSubsGraphFn func(
+		context.Context,
+		*field.Path,
+		*kargoapi.Stage,
+	) (admission.Warnings, field.ErrorList, error)
+
+	validateHookServiceAccountsFn func(
+		context.Context,
+		*field.Path,
+		*kargoapi.Stage,
+	) (field.ErrorList, error)
+
+	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 }
 
-func SetupWebhookWithManager(mgr ctrl.Manager) error {
-	w := newWebhook(mgr.GetClient())
+func SetupWebhookWithManager(mgr ctrl.Manager, cfg WebhookConfig) error {
+	w := newWebhook(mgr.GetClient(), cfg)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Stage{}).
 		WithDefaulter(w).
@@ -50,19 +90,63 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
-func newWebhook(kubeClient client.Client) *webhook {
+func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w := &webhook{
+		cfg:    cfg,
 		client: kubeClient,
 	}
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
-	w.validateSpecFn = w.validateSpec
+	w.validateSpecFn = ValidateSpec
+	w.validateSubsGraphFn = w.validateSubsGraph
+	w.validateHookServiceAccountsFn = w.validateHookServiceAccounts
+	w.admissionRequestFromContextFn = admission.RequestFromContext
 	return w
 }
 
-func (w *webhook) Default(_ context.Context, obj runtime.Object) error {
+func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
 	controllerutil.AddFinalizer(stage, kargoapi.FinalizerName)
+	return w.defaultLockedBy(ctx, stage)
+}
+
+// defaultLockedBy records the user who made the admission request as the one
+// who locked the Stage whenever its lock is placed or changed, so that
+// LockedBy cannot be forged. Requests made by the Kargo API server are the
+// exception, because the API server acts on behalf of its own users and
+// records their identity itself.
+func (w *webhook) defaultLockedBy(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	if stage.Spec == nil || stage.Spec.Lock == nil {
+		return nil
+	}
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrap(err, "error getting admission request from context"),
+		)
+	}
+	if len(req.OldObject.Raw) > 0 {
+		oldStage := &kargoapi.Stage{}
+		if err = json.Unmarshal(req.OldObject.Raw, oldStage); err != nil {
+			return apierrors.NewInternalError(
+				errors.Wrap(err, "error decoding existing Stage"),
+			)
+		}
+		if oldStage.Spec != nil &&
+			equality.Semantic.DeepEqual(oldStage.Spec.Lock, stage.Spec.Lock) {
+			return nil // The lock is unchanged
+		}
+	}
+	apiServerUsername := fmt.Sprintf(
+		"system:serviceaccount:%s:kargo-api",
+		w.cfg.KargoNamespace,
+	)
+	if req.UserInfo.Username != apiServerUsername {
+		stage.Spec.Lock.LockedBy = req.UserInfo.Username
+	}
 	return nil
 }
 
@@ -75,77 +159,461 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return nil, err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
+// ValidateDelete never rejects the deletion of a Stage, but warns about any
+// downstream Stages that subscribe to it, since they will stop receiving
+// Freight.
 func (w *webhook) ValidateDelete(
-	context.Context,
-	runtime.Object,
+	ctx context.Context,
+	obj runtime.Object,
 ) (admission.Warnings, error) {
-	// No-op
-	return nil, nil
+	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
+	stages := kargoapi.StageList{}
+	if err := w.client.List(
+		ctx,
+		&stages,
+		client.InNamespace(stage.Namespace),
+	); err != nil {
+		// Dependents are only worth a warning, so this is not reason enough to
+		// block the deletion.
+		return admission.Warnings{
+			fmt.Sprintf(
+				"unable to determine which Stages subscribe to Stage %q: %s",
+				stage.Name,
+				err,
+			),
+		}, nil
+	}
+	var dependents []string
+	for _, s := range stages.Items {
+		if s.Spec == nil || s.Spec.Subscriptions == nil {
+			continue
+		}
+		for _, upstream := range s.Spec.Subscriptions.UpstreamStages {
+			if upstream.Name == stage.Name {
+				dependents = append(dependents, s.Name)
+				break
+			}
+		}
+	}
+	if len(dependents) == 0 {
+		return nil, nil
+	}
+	return admission.Warnings{
+		fmt.Sprintf(
+			"Stage %q is subscribed to by Stage(s) %s, which will no longer "+
+				"receive Freight from it",
+			stage.Name,
+			strings.Join(dependents, ", "),
+		),
+	}, nil
 }
 
 func (w *webhook) validateCreateOrUpdate(
+	ctx context.Context,
 	s *kargoapi.Stage,
 ) (admission.Warnings, error) {
 	if errs := w.validateSpecFn(field.NewPath("spec"), s.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
 	}
-	return nil, nil
+	warnings, errs, err := w.validateSubsGraphFn(
+		ctx,
+		field.NewPath("spec", "subscriptions"),
+		s,
+	)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
+	}
+	if errs, err = w.validateHookServiceAccountsFn(
+		ctx,
+		field.NewPath("spec", "hooks"),
+		s,
+	); err != nil {
+		return warnings, apierrors.NewInternalError(err)
+	}
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
+	}
+	return warnings, nil
+}
+
+// validateHookServiceAccounts validates that every ServiceAccount named by the
+// Jobs templates of the Stage's hooks exists in the Stage's namespace and
+// permits its use by the Stage by way of the kargo.akuity.io/authorized-stage
+// annotation. Hook Jobs are created by Kargo, so without this, anyone able to
+// create or update a Stage could run arbitrary workloads under any identity in
+// the Project's namespace. A Job template that names no ServiceAccount runs as
+// the namespace's default ServiceAccount, which must permit its use by the
+// Stage just the same.
+func (w *webhook) validateHookServiceAccounts(
+	ctx context.Context,
+	f *field.Path,
+	s *kargoapi.Stage,
+) (field.ErrorList, error) {
+	if s.Spec == nil || s.Spec.Hooks == nil {
+		return nil, nil
+	}
+	var errs field.ErrorList
+	validate := func(hf *field.Path, hooks []kargoapi.PromotionHook) error {
+		for i, hook := range hooks {
+			podSpec := hook.JobTemplate.Spec.Template.Spec
+			psf := hf.Index(i).Child("jobTemplate", "spec", "template", "spec")
+			saf := psf.Child("serviceAccountName")
+			saName := podSpec.ServiceAccountName
+			if saName == "" {
+				saName = podSpec.DeprecatedServiceAccount
+				saf = psf.Child("serviceAccount")
+			}
+			if saName == "" {
+				saName = "default"
+				saf = psf.Child("serviceAccountName")
+			}
+			sa := &corev1.ServiceAccount{}
+			if err := w.client.Get(
+				ctx,
+				types.NamespacedName{
+					Namespace: s.Namespace,
+					Name:      saName,
+				},
+				sa,
+			); err != nil {
+				if apierrors.IsNotFound(err) {
+					errs = append(
+						errs,
+						field.Invalid(
+							saf,
+							saName,
+							fmt.Sprintf(
+								"ServiceAccount %q does not exist in namespace %q",
+								saName,
+								s.Namespace,
+							),
+						),
+					)
+					continue
+				}
+				return errors.Wrapf(
+					err,
+					"get ServiceAccount %q in namespace %q",
+					saName,
+					s.Namespace,
+				)
+			}
+			if err := promotion.AuthorizeStageUse(
+				"ServiceAccount",
+				s.ObjectMeta,
+				sa.ObjectMeta,
+			); err != nil {
+				errs = append(errs, field.Forbidden(saf, err.Error()))
+			}
+		}
+		return nil
+	}
+	if err := validate(f.Child("prePromotion"), s.Spec.Hooks.PrePromotion); err != nil {
+		return nil, err
+	}
+	if err := validate(f.Child("postPromotion"), s.Spec.Hooks.PostPromotion); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+// validateSubsGraph lists the Warehouses and Stages in the Stage's Project
+// that are needed to validate its subscriptions using ValidateSubsGraph.
+func (w *webhook) validateSubsGraph(
+	ctx context.Context,
+	f *field.Path,
+	s *kargoapi.Stage,
+) (admission.Warnings, field.ErrorList, error) {
+	if s.Spec == nil || s.Spec.Subscriptions == nil {
+		return nil, nil, nil
+	}
+	warehouses := kargoapi.WarehouseList{}
+	if len(s.Spec.Subscriptions.GetWarehouses()) > 0 {
+		if err := w.client.List(
+			ctx,
+			&warehouses,
+			client.InNamespace(s.Namespace),
+		); err != nil {
+			return nil, nil, errors.Wrap(err, "list warehouses")
+		}
+	}
+	stages := kargoapi.StageList{}
+	if len(s.Spec.Subscriptions.UpstreamStages) > 0 ||
+		len(s.Spec.Subscriptions.Warehouses) > 0 {
+		if err := w.client.List(
+			ctx,
+			&stages,
+			client.InNamespace(s.Namespace),
+		); err != nil {
+			return nil, nil, errors.Wrap(err, "list stages")
+		}
+	}
+	warnings, errs := ValidateSubsGraph(f, s, warehouses.Items, stages.Items)
+	return warnings, errs, nil
+}
+
+// ValidateSubsGraph validates the Stage's subscriptions against the other
+// Warehouses and Stages in its Project. Subscribing to itself, or to an
+// upstream Stage that directly or indirectly subscribes to it, is an error,
+// since Freight could never flow through the resulting cycle. Stages that
+// subscribe to multiple Warehouses are promoted to composite Freight that
+// downstream Stages cannot be promoted to, so subscribing to such a Stage, or
+// subscribing to multiple Warehouses while other Stages subscribe to this one,
+// is also an error. Subscribing to a Warehouse or Stage that does not exist (yet) is only worth a warning, since
+// resources are frequently created out of order.
+func ValidateSubsGraph(
+	f *field.Path,
+	s *kargoapi.Stage,
+	warehouses []kargoapi.Warehouse,
+	stages []kargoapi.Stage,
+) ([]string, field.ErrorList) {
+	if s.Spec == nil || s.Spec.Subscriptions == nil {
+		return nil, nil
+	}
+	subs := s.Spec.Subscriptions
+
+	var warnings []string
+	existingWarehouses := make(map[string]struct{}, len(warehouses))
+	for _, warehouse := range warehouses {
+		existingWarehouses[warehouse.Name] = struct{}{}
+	}
+	for _, warehouse := range subs.GetWarehouses() {
+		if _, ok := existingWarehouses[warehouse]; !ok {
+			warnings = append(
+				warnings,
+				fmt.Sprintf(
+					"Warehouse %q does not exist in namespace %q; Stage %q will not "+
+						"receive Freight until it is created",
+					warehouse,
+					s.Namespace,
+					s.Name,
+				),
+			)
+		}
+	}
+
+	// Map every other Stage to the names of its upstream Stages. The stored
+	// version of the Stage being validated is omitted, since any path of
+	// subscriptions that reaches it already constitutes a cycle.
+	upstreamsByStage := make(map[string][]string, len(stages))
+	compositeStages := map[string]struct{}{}
+	for _, stage := range stages {
+		if stage.Name == s.Name {
+			continue
+		}
+		var upstreams []string
+		if stage.Spec != nil && stage.Spec.Subscriptions != nil {
+			for _, upstream := range stage.Spec.Subscriptions.UpstreamStages {
+				upstreams = append(upstreams, upstream.Name)
+			}
+			if len(stage.Spec.Subscriptions.Warehouses) > 0 {
+				compositeStages[stage.Name] = struct{}{}
+			}
+		}
+		upstreamsByStage[stage.Name] = upstreams
+	}
+
+	var errs field.ErrorList
+	if len(subs.Warehouses) > 0 {
+		var subscribers []string
+		for stage, upstreams := range upstreamsByStage {
+			for _, upstream := range upstreams {
+				if upstream == s.Name {
+					subscribers = append(subscribers, stage)
+					break
+				}
+			}
+		}
+		if len(subscribers) > 0 {
+			sort.Strings(subscribers)
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("warehouses"),
+					subs.Warehouses,
+					fmt.Sprintf(
+						"a Stage that other Stages subscribe to cannot subscribe to "+
+							"multiple Warehouses; subscribed to by: %s",
+						strings.Join(subscribers, ", "),
+					),
+				),
+			)
+		}
+	}
+	for i, upstream := range subs.UpstreamStages {
+		uf := f.Child("upstreamStages").Index(i).Child("name")
+		if upstream.Name == s.Name {
+			errs = append(
+				errs,
+				field.Invalid(uf, upstream.Name, "a Stage cannot subscribe to itself"),
+			)
+			continue
+		}
+		if _, ok := upstreamsByStage[upstream.Name]; !ok {
+			warnings = append(
+				warnings,
+				fmt.Sprintf(
+					"upstream Stage %q does not exist in namespace %q; Stage %q will "+
+						"not receive Freight until it is created",
+					upstream.Name,
+					s.Namespace,
+					s.Name,
+				),
+			)
+			continue
+		}
+		if _, ok := compositeStages[upstream.Name]; ok {
+			errs = append(
+				errs,
+				field.Invalid(
+					uf,
+					upstream.Name,
+					fmt.Sprintf(
+						"upstream Stage %q subscribes to multiple Warehouses and "+
+							"cannot be subscribed to",
+						upstream.Name,
+					),
+				),
+			)
+			continue
+		}
+		if path := findSubscriptionPath(
+			upstreamsByStage,
+			upstream.Name,
+			s.Name,
+		); path != nil {
+			errs = append(
+				errs,
+				field.Invalid(
+					uf,
+					upstream.Name,
+					fmt.Sprintf(
+						"subscribing to upstream Stage %q would create a cycle: %s",
+						upstream.Name,
+						strings.Join(append([]string{s.Name}, path...), " -> "),
+					),
+				),
+			)
+		}
+	}
+	return warnings, errs
+}
+
+// findSubscriptionPath returns the names of the Stages along a path of
+// subscriptions leading from the Stage named from to the Stage named to, both
+// inclusive. It returns nil if no such path exists. Stages are visited at most
+// once, so cycles that do not include the Stage named to are tolerated.
+func findSubscriptionPath(
+	upstreamsByStage map[string][]string,
+	from string,
+	to string,
+) []string {
+	visited := map[string]struct{}{}
+	var visit func(stage string) []string
+	visit = func(stage string) []string {
+		if stage == to {
+			return []string{stage}
+		}
+		if _, ok := visited[stage]; ok {
+			return nil
+		}
+		visited[stage] = struct{}{}
+		for _, upstream := range upstreamsByStage[stage] {
+			if path := visit(upstream); path != nil {
+				return append([]string{stage}, path...)
+			}
+		}
+		return nil
+	}
+	return visit(from)
 }
 
-func (w *webhook) validateSpec(
+// ValidateSpec validates a StageSpec beyond what its declarative validations
+// are capable of. It requires no access to a cluster.
+func ValidateSpec(
 	f *field.Path,
 	spec *kargoapi.StageSpec,
 ) field.ErrorList {
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
-	return append(
+	errs := validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs = append(
 		errs,
-		w.validatePromotionMechanisms(
+		validatePromotionMechanisms(
 			f.Child("promotionMechanisms"),
 			spec.PromotionMechanisms)...,
 	)
+	errs = append(
+		errs,
+		validateHealthChecks(f.Child("healthChecks"), spec.HealthChecks)...,
+	)
+	return append(errs, validateHooks(f.Child("hooks"), spec.Hooks)...)
 }
 
-func (w *webhook) validateSubs(
+func validateSubs(
 	f *field.Path,
 	subs *kargoapi.Subscriptions,
 ) field.ErrorList {
 	if subs == nil { // nil subs is caught by declarative validations
 		return nil
 	}
-	// Can subscribe to Warehouse XOR upstream Stages
-	if (subs.Warehouse == "" && len(subs.UpstreamStages) == 0) ||
-		(subs.Warehouse != "" && len(subs.UpstreamStages) > 0) {
+	// Can subscribe to a Warehouse XOR multiple Warehouses XOR upstream Stages
+	var defined int
+	if subs.Warehouse != "" {
+		defined++
+	}
+	if len(subs.Warehouses) > 0 {
+		defined++
+	}
+	if len(subs.UpstreamStages) > 0 {
+		defined++
+	}
+	if defined != 1 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				subs,
 				fmt.Sprintf(
-					"exactly one of %s.warehouse or %s.upstreamStages must be defined",
+					"exactly one of %s.warehouse, %s.warehouses, or "+
+						"%s.upstreamStages must be defined",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return nil
+	var errs field.ErrorList
+	seen := make(map[string]struct{}, len(subs.Warehouses))
+	for i, warehouse := range subs.Warehouses {
+		if _, ok := seen[warehouse]; ok {
+			errs = append(
+				errs,
+				field.Duplicate(f.Child("warehouses").Index(i), warehouse),
+			)
+		}
+		seen[warehouse] = struct{}{}
+	}
+	return errs
 }
 
-func (w *webhook) validatePromotionMechanisms(
+func validatePromotionMechanisms(
 	f *field.Path,
 	promoMechs *kargoapi.PromotionMechanisms,
 ) field.ErrorList {
@@ -154,38 +622,111 @@ func (w *webhook) validatePromotionMechanisms(
 	}
 	// Must define at least one mechanism
 	if len(promoMechs.GitRepoUpdates) == 0 &&
-		len(promoMechs.ArgoCDAppUpdates) == 0 {
+		len(promoMechs.ArgoCDAppUpdates) == 0 &&
+		len(promoMechs.FluxUpdates) == 0 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				promoMechs,
 				fmt.Sprintf(
-					"at least one of %s.gitRepoUpdates or %s.argoCDAppUpdates must "+
-						"be non-empty",
+					"at least one of %s.gitRepoUpdates, %s.argoCDAppUpdates, or "+
+						"%s.fluxUpdates must be non-empty",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return w.validateGitRepoUpdates(
+	errs := validateGitRepoUpdates(
 		f.Child("gitRepoUpdates"),
 		promoMechs.GitRepoUpdates,
 	)
+	return append(
+		errs,
+		validateFluxUpdates(f.Child("fluxUpdates"), promoMechs.FluxUpdates)...,
+	)
+}
+
+func validateFluxUpdates(
+	f *field.Path,
+	updates []kargoapi.FluxUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		errs = append(errs, validateFluxUpdate(f.Index(i), update)...)
+	}
+	return errs
+}
+
+func validateFluxUpdate(
+	f *field.Path,
+	update kargoapi.FluxUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	if update.HelmRelease != nil &&
+		update.Kind != kargoapi.FluxResourceKindHelmRelease {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("helmRelease"),
+				update.HelmRelease,
+				fmt.Sprintf(
+					"%s.helmRelease may only be defined when %s.kind is %s",
+					f.String(),
+					f.String(),
+					kargoapi.FluxResourceKindHelmRelease,
+				),
+			),
+		)
+	}
+	if update.OCIRepository != nil &&
+		update.Kind != kargoapi.FluxResourceKindOCIRepository {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("ociRepository"),
+				update.OCIRepository,
+				fmt.Sprintf(
+					"%s.ociRepository may only be defined when %s.kind is %s",
+					f.String(),
+					f.String(),
+					kargoapi.FluxResourceKindOCIRepository,
+				),
+			),
+		)
+	}
+	if update.Kustomization != nil &&
+		update.Kind != kargoapi.FluxResourceKindKustomization {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("kustomization"),
+				update.Kustomization,
+				fmt.Sprintf(
+					"%s.kustomization may only be defined when %s.kind is %s",
+					f.String(),
+					f.String(),
+					kargoapi.FluxResourceKindKustomization,
+				),
+			),
+		)
+	}
+	return errs
 }
 
-func (w *webhook) validateGitRepoUpdates(
+func validateGitRepoUpdates(
 	f *field.Path,
 	updates []kargoapi.GitRepoUpdate,
 ) field.ErrorList {
 	var errs field.ErrorList
 	for i, update := range updates {
-		errs = append(errs, w.validateGitRepoUpdate(f.Index(i), update)...)
+		errs = append(errs, validateGitRepoUpdate(f.Index(i), update)...)
 	}
 	return errs
 }
 
-func (w *webhook) validateGitRepoUpdate(
+func validateGitRepoUpdate(
 	f *field.Path,
 	update kargoapi.GitRepoUpdate,
 ) field.ErrorList {
@@ -214,10 +755,10 @@ func (w *webhook) validateGitRepoUpdate(
 			),
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	return validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
 }
 
-func (w *webhook) validateHelmPromotionMechanism(
+func validateHelmPromotionMechanism(
 	f *field.Path,
 	promoMech *kargoapi.HelmPromotionMechanism,
 ) field.ErrorList {
@@ -240,3 +781,175 @@ func (w *webhook) validateHelmPromotionMechanism(
 	}
 	return nil
 }
+
+func validateHealthChecks(
+	f *field.Path,
+	healthChecks *kargoapi.HealthChecks,
+) field.ErrorList {
+	if healthChecks == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for i, check := range healthChecks.Workloads {
+		// Must identify workloads by name XOR selector
+		if (check.Name == "" && len(check.Selector) == 0) ||
+			(check.Name != "" && len(check.Selector) > 0) {
+			wf := f.Child("workloads").Index(i)
+			errs = append(
+				errs,
+				field.Invalid(
+					wf,
+					check,
+					fmt.Sprintf(
+						"exactly one of %s.name or %s.selector must be defined",
+						wf.String(),
+						wf.String(),
+					),
+				),
+			)
+		}
+	}
+	return errs
+}
+
+func validateHooks(
+	f *field.Path,
+	hooks *kargoapi.PromotionHooks,
+) field.ErrorList {
+	if hooks == nil {
+		return nil
+	}
+	errs := validateHookList(f.Child("prePromotion"), hooks.PrePromotion)
+	return append(
+		errs,
+		validateHookList(f.Child("postPromotion"), hooks.PostPromotion)...,
+	)
+}
+
+func validateHookList(
+	f *field.Path,
+	hooks []kargoapi.PromotionHook,
+) field.ErrorList {
+	var errs field.ErrorList
+	seen := make(map[string]struct{}, len(hooks))
+	for i, hook := range hooks {
+		hf := f.Index(i)
+		if _, ok := seen[hook.Name]; ok {
+			errs = append(errs, field.Duplicate(hf.Child("name"), hook.Name))
+		}
+		seen[hook.Name] = struct{}{}
+		psf := hf.Child("jobTemplate", "spec", "template", "spec")
+		if len(hook.JobTemplate.Spec.Template.Spec.Containers) == 0 {
+			errs = append(
+				errs,
+				field.Required(
+					psf.Child("containers"),
+					"at least one container must be defined",
+				),
+			)
+		}
+		errs = append(
+			errs,
+			validateHookPodSpec(psf, &hook.JobTemplate.Spec.Template.Spec)...,
+		)
+	}
+	return errs
+}
+
+// validateHookPodSpec forbids the use of Pod features that would permit a hook
+// Job to access Secrets or the host it runs on. Hook Jobs are created by Kargo
+// rather than by the user defining the hook, so without this, anyone able to
+// create or update a Stage could read any Secret in the Project's namespace
+// (including Kargo's own repository credentials) or escape to the node.
+func validateHookPodSpec(f *field.Path, podSpec *corev1.PodSpec) field.ErrorList {
+	var errs field.ErrorList
+	if podSpec.HostNetwork {
+		errs = append(errs, field.Forbidden(f.Child("hostNetwork"), "host networking is not permitted"))
+	}
+	if podSpec.HostPID {
+		errs = append(errs, field.Forbidden(f.Child("hostPID"), "host PID namespace is not permitted"))
+	}
+	if podSpec.HostIPC {
+		errs = append(errs, field.Forbidden(f.Child("hostIPC"), "host IPC namespace is not permitted"))
+	}
+	for i, vol := range podSpec.Volumes {
+		vf := f.Child("volumes").Index(i)
+		switch {
+		case vol.HostPath != nil:
+			errs = append(errs, field.Forbidden(vf.Child("hostPath"), "hostPath volumes are not permitted"))
+		case vol.Secret != nil:
+			errs = append(errs, field.Forbidden(vf.Child("secret"), "Secret volumes are not permitted"))
+		case vol.CSI != nil:
+			errs = append(errs, field.Forbidden(vf.Child("csi"), "CSI volumes are not permitted"))
+		case vol.Projected != nil:
+			for j, source := range vol.Projected.Sources {
+				if source.Secret != nil {
+					errs = append(
+						errs,
+						field.Forbidden(
+							vf.Child("projected", "sources").Index(j).Child("secret"),
+							"projected Secrets are not permitted",
+						),
+					)
+				}
+			}
+		}
+	}
+	validateContainer := func(cf *field.Path, container *corev1.Container) {
+		for i, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				errs = append(
+					errs,
+					field.Forbidden(
+						cf.Child("envFrom").Index(i).Child("secretRef"),
+						"referencing Secrets is not permitted",
+					),
+				)
+			}
+		}
+		for i, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				errs = append(
+					errs,
+					field.Forbidden(
+						cf.Child("env").Index(i).Child("valueFrom", "secretKeyRef"),
+						"referencing Secrets is not permitted",
+					),
+				)
+			}
+		}
+		if sc := container.SecurityContext; sc != nil {
+			if sc.Privileged != nil && *sc.Privileged {
+				errs = append(
+					errs,
+					field.Forbidden(
+						cf.Child("securityContext", "privileged"),
+						"privileged containers are not permitted",
+					),
+				)
+			}
+			if sc.Capabilities != nil && len(sc.Capabilities.Add) > 0 {
+				errs = append(
+					errs,
+					field.Forbidden(
+						cf.Child("securityContext", "capabilities", "add"),
+						"adding capabilities is not permitted",
+					),
+				)
+			}
+		}
+	}
+	for i := range podSpec.InitContainers {
+		validateContainer(f.Child("initContainers").Index(i), &podSpec.InitContainers[i])
+	}
+	for i := range podSpec.Containers {
+		validateContainer(f.Child("containers").Index(i), &podSpec.Containers[i])
+	}
+	for i := range podSpec.EphemeralContainers {
+		validateContainer(
+			f.Child("ephemeralContainers").Index(i),
+			(*corev1.Container)(&podSpec.EphemeralContainers[i].EphemeralContainerCommon),
+		)
+	}
+	return errs
+}
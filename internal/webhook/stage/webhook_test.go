@@ -2,12 +2,18 @@ package stage
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -18,11 +24,13 @@ import (
 
 func TestNewWebhook(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
-	w := newWebhook(kubeClient)
+	w := newWebhook(kubeClient, WebhookConfig{})
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.validateSubsGraphFn)
+	require.NotNil(t, w.admissionRequestFromContextFn)
 }
 
 func TestDefault(t *testing.T) {
@@ -36,6 +44,135 @@ func TestDefault(t *testing.T) {
 	)
 }
 
+func TestDefaultLockedBy(t *testing.T) {
+	testLock := &kargoapi.StageLock{
+		LockedBy: "someone-else",
+		Reason:   "fake-reason",
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		req        admission.Request
+		assertions func(*kargoapi.Stage, error)
+	}{
+		{
+			name: "not locked",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{},
+			},
+			assertions: func(stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Nil(t, stage.Spec.Lock)
+			},
+		},
+		{
+			name: "lock placed",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Lock: testLock.DeepCopy(),
+				},
+			},
+			req: newAdmissionRequest(t, "fake-user", nil),
+			assertions: func(stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-user", stage.Spec.Lock.LockedBy)
+			},
+		},
+		{
+			name: "lock changed",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Lock: testLock.DeepCopy(),
+				},
+			},
+			req: newAdmissionRequest(
+				t,
+				"fake-user",
+				&kargoapi.Stage{
+					Spec: &kargoapi.StageSpec{
+						Lock: &kargoapi.StageLock{
+							LockedBy: "fake-user",
+						},
+					},
+				},
+			),
+			assertions: func(stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-user", stage.Spec.Lock.LockedBy)
+			},
+		},
+		{
+			name: "lock unchanged",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Lock: testLock.DeepCopy(),
+				},
+			},
+			req: newAdmissionRequest(
+				t,
+				"fake-user",
+				&kargoapi.Stage{
+					Spec: &kargoapi.StageSpec{
+						Lock: testLock.DeepCopy(),
+					},
+				},
+			),
+			assertions: func(stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "someone-else", stage.Spec.Lock.LockedBy)
+			},
+		},
+		{
+			name: "lock placed by Kargo API server",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Lock: testLock.DeepCopy(),
+				},
+			},
+			req: newAdmissionRequest(
+				t,
+				"system:serviceaccount:kargo:kargo-api",
+				nil,
+			),
+			assertions: func(stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "someone-else", stage.Spec.Lock.LockedBy)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				cfg: WebhookConfig{
+					KargoNamespace: "kargo",
+				},
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return testCase.req, nil
+				},
+			}
+			err := w.Default(context.Background(), testCase.stage)
+			testCase.assertions(testCase.stage, err)
+		})
+	}
+}
+
+func newAdmissionRequest(
+	t *testing.T,
+	username string,
+	oldStage *kargoapi.Stage,
+) admission.Request {
+	req := admission.Request{}
+	req.UserInfo.Username = username
+	if oldStage != nil {
+		raw, err := json.Marshal(oldStage)
+		require.NoError(t, err)
+		req.OldObject.Raw = raw
+	}
+	return req
+}
+
 func TestValidateCreate(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -71,6 +208,7 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, errors.New("something went wrong")
@@ -93,6 +231,7 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, nil
@@ -124,6 +263,7 @@ func TestValidateUpdate(t *testing.T) {
 			name: "error validating stage",
 			webhook: &webhook{
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, errors.New("something went wrong")
@@ -138,6 +278,7 @@ func TestValidateUpdate(t *testing.T) {
 			name: "success",
 			webhook: &webhook{
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, nil
@@ -161,9 +302,65 @@ func TestValidateUpdate(t *testing.T) {
 }
 
 func TestValidateDelete(t *testing.T) {
-	w := &webhook{}
-	_, err := w.ValidateDelete(context.Background(), nil)
-	require.NoError(t, err)
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(admission.Warnings, error)
+	}{
+		{
+			name: "no dependents",
+			assertions: func(warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+			},
+		},
+		{
+			name: "dependents",
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "uat",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{
+								{Name: "test"},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Len(t, warnings, 1)
+				require.Contains(t, warnings[0], `Stage(s) uat`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			testCase.assertions(
+				w.ValidateDelete(
+					context.Background(),
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "test",
+						},
+					},
+				),
+			)
+		})
+	}
 }
 
 func TestValidateCreateOrUpdate(t *testing.T) {
@@ -186,6 +383,106 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "error validating subscription graph",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
+				validateSubsGraphFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList, error) {
+					return nil, nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "invalid subscription graph",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
+				validateSubsGraphFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList, error) {
+					return nil, field.ErrorList{{}}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "error validating hook ServiceAccounts",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
+				validateSubsGraphFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList, error) {
+					return nil, nil, nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (field.ErrorList, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "invalid hook ServiceAccounts",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
+				validateSubsGraphFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList, error) {
+					return nil, nil, nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (field.ErrorList, error) {
+					return field.ErrorList{{}}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -195,6 +492,20 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 				) field.ErrorList {
 					return nil
 				},
+				validateSubsGraphFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList, error) {
+					return nil, nil, nil
+				},
+				validateHookServiceAccountsFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (field.ErrorList, error) {
+					return nil, nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -203,7 +514,10 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err := testCase.webhook.validateCreateOrUpdate(&kargoapi.Stage{})
+			_, err := testCase.webhook.validateCreateOrUpdate(
+				context.Background(),
+				&kargoapi.Stage{},
+			)
 			testCase.assertions(err)
 		})
 	}
@@ -245,7 +559,8 @@ func TestValidateSpec(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "spec.subscriptions",
 							BadValue: spec.Subscriptions,
-							Detail: "exactly one of spec.subscriptions.warehouse or " +
+							Detail: "exactly one of spec.subscriptions.warehouse, " +
+								"spec.subscriptions.warehouses, or " +
 								"spec.subscriptions.upstreamStages must be defined",
 						},
 						{
@@ -253,8 +568,9 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.promotionMechanisms",
 							BadValue: spec.PromotionMechanisms,
 							Detail: "at least one of " +
-								"spec.promotionMechanisms.gitRepoUpdates or " +
-								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
+								"spec.promotionMechanisms.gitRepoUpdates, " +
+								"spec.promotionMechanisms.argoCDAppUpdates, or " +
+								"spec.promotionMechanisms.fluxUpdates must be non-empty",
 						},
 					},
 					errs,
@@ -274,12 +590,11 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.spec,
-				w.validateSpec(
+				ValidateSpec(
 					field.NewPath("spec"),
 					testCase.spec,
 				),
@@ -312,7 +627,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subscriptions",
 							BadValue: subs,
-							Detail: "exactly one of subscriptions.warehouse or " +
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or " +
 								"subscriptions.upstreamStages must be defined",
 						},
 					},
@@ -337,7 +653,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subscriptions",
 							BadValue: subs,
-							Detail: "exactly one of subscriptions.warehouse or " +
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or " +
 								"subscriptions.upstreamStages must be defined",
 						},
 					},
@@ -347,60 +664,42 @@ func TestValidateSubs(t *testing.T) {
 		},
 
 		{
-			name: "success",
+			name: "has warehouse sub and warehouses subs",
 			subs: &kargoapi.Subscriptions{
-				Warehouse: "test-warehouse",
+				Warehouse:  "test-warehouse",
+				Warehouses: []string{"another-warehouse"},
 			},
-			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
-				require.Nil(t, errs)
+			assertions: func(subs *kargoapi.Subscriptions, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "subscriptions",
+							BadValue: subs,
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or " +
+								"subscriptions.upstreamStages must be defined",
+						},
+					},
+					errs,
+				)
 			},
 		},
-	}
-	w := &webhook{}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				testCase.subs,
-				w.validateSubs(
-					field.NewPath("subscriptions"),
-					testCase.subs,
-				),
-			)
-		})
-	}
-}
 
-func TestValidatePromotionMechanisms(t *testing.T) {
-	testCases := []struct {
-		name       string
-		promoMechs *kargoapi.PromotionMechanisms
-		assertions func(*kargoapi.PromotionMechanisms, field.ErrorList)
-	}{
 		{
-			name: "nil",
-			assertions: func(_ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
-				require.Nil(t, errs)
+			name: "duplicate warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"test-warehouse", "test-warehouse"},
 			},
-		},
-
-		{
-			name: "invalid",
-			// Does not define any mechanisms
-			promoMechs: &kargoapi.PromotionMechanisms{},
-			assertions: func(
-				promoMechs *kargoapi.PromotionMechanisms,
-				errs field.ErrorList,
-			) {
-				require.NotNil(t, errs)
+			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
 				require.Equal(
 					t,
 					field.ErrorList{
 						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "promotionMechanisms",
-							BadValue: promoMechs,
-							Detail: "at least one of promotionMechanisms.gitRepoUpdates or " +
-								"promotionMechanisms.argoCDAppUpdates must be non-empty",
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "subscriptions.warehouses[1]",
+							BadValue: "test-warehouse",
 						},
 					},
 					errs,
@@ -409,27 +708,481 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 		},
 
 		{
-			name: "valid",
-			promoMechs: &kargoapi.PromotionMechanisms{
-				GitRepoUpdates: []kargoapi.GitRepoUpdate{
-					{
-						Kustomize: &kargoapi.KustomizePromotionMechanism{},
-					},
-				},
+			name: "success with multiple warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"test-warehouse", "another-warehouse"},
 			},
-			assertions: func(_ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "success",
+			subs: &kargoapi.Subscriptions{
+				Warehouse: "test-warehouse",
+			},
+			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
 				require.Nil(t, errs)
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.promoMechs,
-				w.validatePromotionMechanisms(
-					field.NewPath("promotionMechanisms"),
-					testCase.promoMechs,
+				testCase.subs,
+				validateSubs(
+					field.NewPath("subscriptions"),
+					testCase.subs,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateSubsGraph(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		subs := &kargoapi.Subscriptions{}
+		for _, upstream := range upstreams {
+			subs.UpstreamStages = append(
+				subs.UpstreamStages,
+				kargoapi.StageSubscription{Name: upstream},
+			)
+		}
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+			},
+			Spec: &kargoapi.StageSpec{Subscriptions: subs},
+		}
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		stage      *kargoapi.Stage
+		assertions func(admission.Warnings, field.ErrorList, error)
+	}{
+		{
+			name: "subscription to existing warehouse",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-warehouse",
+					},
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "test",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+				require.Empty(t, warnings)
+			},
+		},
+		{
+			name: "subscription to missing warehouses",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "test",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouses: []string{"foo", "bar"},
+					},
+				},
+			},
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+				require.Len(t, warnings, 2)
+				require.Contains(t, warnings[0], `Warehouse "foo" does not exist`)
+				require.Contains(t, warnings[1], `Warehouse "bar" does not exist`)
+			},
+		},
+		{
+			name:  "subscription to missing upstream stage",
+			stage: newStage("uat", "test"),
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+				require.Len(t, warnings, 1)
+				require.Contains(t, warnings[0], `upstream Stage "test" does not exist`)
+			},
+		},
+		{
+			name:  "self-subscription",
+			stage: newStage("test", "test"),
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+				require.Equal(
+					t,
+					field.ErrorList{
+						field.Invalid(
+							field.NewPath("spec", "subscriptions", "upstreamStages").
+								Index(0).Child("name"),
+							"test",
+							"a Stage cannot subscribe to itself",
+						),
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "cycle",
+			objects: []client.Object{
+				newStage("test", "prod"),
+				newStage("uat", "test"),
+				newStage("prod"),
+			},
+			// Updating prod to subscribe to uat closes the loop
+			stage: newStage("prod", "uat"),
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"spec.subscriptions.upstreamStages[0].name",
+					errs[0].Field,
+				)
+				require.Contains(t, errs[0].Detail, "prod -> uat -> test -> prod")
+			},
+		},
+		{
+			name: "subscription to stage with multiple warehouses",
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "test",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouses: []string{"foo", "bar"},
+						},
+					},
+				},
+			},
+			stage: newStage("uat", "test"),
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"spec.subscriptions.upstreamStages[0].name",
+					errs[0].Field,
+				)
+				require.Contains(t, errs[0].Detail, "subscribes to multiple Warehouses")
+			},
+		},
+		{
+			name: "multiple warehouses with subscribers",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "foo",
+					},
+				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "bar",
+					},
+				},
+				newStage("uat", "test"),
+				newStage("prod", "uat"),
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "test",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouses: []string{"foo", "bar"},
+					},
+				},
+			},
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.subscriptions.warehouses", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "subscribed to by: uat")
+				require.NotContains(t, errs[0].Detail, "prod")
+			},
+		},
+		{
+			name: "unrelated cycle is tolerated",
+			objects: []client.Object{
+				newStage("a", "b"),
+				newStage("b", "a"),
+				newStage("test"),
+			},
+			stage: newStage("uat", "test", "a"),
+			assertions: func(warnings admission.Warnings, errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			testCase.assertions(
+				w.validateSubsGraph(
+					context.Background(),
+					field.NewPath("spec", "subscriptions"),
+					testCase.stage,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHookServiceAccounts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	newStage := func(serviceAccountName string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+			Spec: &kargoapi.StageSpec{
+				Hooks: &kargoapi.PromotionHooks{
+					PostPromotion: []kargoapi.PromotionHook{{
+						Name: "smoke-test",
+						JobTemplate: batchv1.JobTemplateSpec{
+							Spec: batchv1.JobSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										ServiceAccountName: serviceAccountName,
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		}
+	}
+	newServiceAccount := func(name string, annotations map[string]string) *corev1.ServiceAccount {
+		return &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fake-namespace",
+				Name:        name,
+				Annotations: annotations,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		stage      *kargoapi.Stage
+		assertions func(field.ErrorList, error)
+	}{
+		{
+			name:  "no hooks",
+			stage: &kargoapi.Stage{Spec: &kargoapi.StageSpec{}},
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:    "default ServiceAccount does not permit use by Stage",
+			objects: []client.Object{newServiceAccount("default", nil)},
+			stage:   newStage(""),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+				require.Equal(
+					t,
+					"spec.hooks.postPromotion[0].jobTemplate.spec.template.spec.serviceAccountName",
+					errs[0].Field,
+				)
+			},
+		},
+		{
+			name: "default ServiceAccount permits use by Stage",
+			objects: []client.Object{
+				newServiceAccount(
+					"default",
+					map[string]string{
+						"kargo.akuity.io/authorized-stage": "fake-namespace:fake-stage",
+					},
+				),
+			},
+			stage: newStage(""),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:  "ServiceAccount does not exist",
+			stage: newStage("fake-sa"),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"spec.hooks.postPromotion[0].jobTemplate.spec.template.spec.serviceAccountName",
+					errs[0].Field,
+				)
+				require.Contains(t, errs[0].Detail, "does not exist")
+			},
+		},
+		{
+			name:    "ServiceAccount does not permit use by Stage",
+			objects: []client.Object{newServiceAccount("fake-sa", nil)},
+			stage:   newStage("fake-sa"),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+				require.Contains(t, errs[0].Detail, "does not permit use")
+			},
+		},
+		{
+			name: "ServiceAccount permits use by another Stage",
+			objects: []client.Object{
+				newServiceAccount(
+					"fake-sa",
+					map[string]string{
+						"kargo.akuity.io/authorized-stage": "fake-namespace:other-stage",
+					},
+				),
+			},
+			stage: newStage("fake-sa"),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+			},
+		},
+		{
+			name: "ServiceAccount permits use by Stage",
+			objects: []client.Object{
+				newServiceAccount(
+					"fake-sa",
+					map[string]string{
+						"kargo.akuity.io/authorized-stage": "fake-namespace:*",
+					},
+				),
+			},
+			stage: newStage("fake-sa"),
+			assertions: func(errs field.ErrorList, err error) {
+				require.NoError(t, err)
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			testCase.assertions(
+				w.validateHookServiceAccounts(
+					context.Background(),
+					field.NewPath("spec", "hooks"),
+					testCase.stage,
+				),
+			)
+		})
+	}
+}
+
+func TestValidatePromotionMechanisms(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMechs *kargoapi.PromotionMechanisms
+		assertions func(*kargoapi.PromotionMechanisms, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(_ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			// Does not define any mechanisms
+			promoMechs: &kargoapi.PromotionMechanisms{},
+			assertions: func(
+				promoMechs *kargoapi.PromotionMechanisms,
+				errs field.ErrorList,
+			) {
+				require.NotNil(t, errs)
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "promotionMechanisms",
+							BadValue: promoMechs,
+							Detail: "at least one of promotionMechanisms.gitRepoUpdates, " +
+								"promotionMechanisms.argoCDAppUpdates, or " +
+								"promotionMechanisms.fluxUpdates must be non-empty",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{
+						Kustomize: &kargoapi.KustomizePromotionMechanism{},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.promoMechs,
+				validatePromotionMechanisms(
+					field.NewPath("promotionMechanisms"),
+					testCase.promoMechs,
 				),
 			)
 		})
@@ -477,12 +1230,11 @@ func TestValidateGitRepoUpdates(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.update,
-				w.validateGitRepoUpdates(
+				validateGitRepoUpdates(
 					field.NewPath("gitRepoUpdates"),
 					[]kargoapi.GitRepoUpdate{
 						testCase.update,
@@ -534,12 +1286,11 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.update,
-				w.validateGitRepoUpdate(
+				validateGitRepoUpdate(
 					field.NewPath("gitRepoUpdate"),
 					testCase.update,
 				),
@@ -597,12 +1348,11 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.promoMech,
-				w.validateHelmPromotionMechanism(
+				validateHelmPromotionMechanism(
 					field.NewPath("helm"),
 					testCase.promoMech,
 				),
@@ -610,3 +1360,332 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFluxUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.FluxUpdate
+		assertions func(kargoapi.FluxUpdate, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			// Defines updates that do not correspond to the kind of resource
+			update: kargoapi.FluxUpdate{
+				Kind:          kargoapi.FluxResourceKindGitRepository,
+				HelmRelease:   &kargoapi.FluxHelmReleaseUpdate{},
+				OCIRepository: &kargoapi.FluxOCIRepositoryUpdate{},
+				Kustomization: &kargoapi.FluxKustomizationUpdate{},
+			},
+			assertions: func(update kargoapi.FluxUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "fluxUpdates[0].helmRelease",
+							BadValue: update.HelmRelease,
+							Detail: "fluxUpdates[0].helmRelease may only be defined when " +
+								"fluxUpdates[0].kind is HelmRelease",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "fluxUpdates[0].ociRepository",
+							BadValue: update.OCIRepository,
+							Detail: "fluxUpdates[0].ociRepository may only be defined when " +
+								"fluxUpdates[0].kind is OCIRepository",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "fluxUpdates[0].kustomization",
+							BadValue: update.Kustomization,
+							Detail: "fluxUpdates[0].kustomization may only be defined when " +
+								"fluxUpdates[0].kind is Kustomization",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			update: kargoapi.FluxUpdate{
+				Kind:        kargoapi.FluxResourceKindHelmRelease,
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{},
+			},
+			assertions: func(_ kargoapi.FluxUpdate, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.update,
+				validateFluxUpdate(
+					field.NewPath("fluxUpdates").Index(0),
+					testCase.update,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHealthChecks(t *testing.T) {
+	testCases := []struct {
+		name         string
+		healthChecks *kargoapi.HealthChecks
+		assertions   func(*kargoapi.HealthChecks, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(_ *kargoapi.HealthChecks, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{
+					{
+						// Defines neither name nor selector
+						Kind:      kargoapi.WorkloadKindDeployment,
+						Namespace: "fake-namespace",
+					},
+					{
+						// Defines both name and selector
+						Kind:      kargoapi.WorkloadKindDeployment,
+						Namespace: "fake-namespace",
+						Name:      "fake-name",
+						Selector:  map[string]string{"app": "fake"},
+					},
+				},
+			},
+			assertions: func(
+				healthChecks *kargoapi.HealthChecks,
+				errs field.ErrorList,
+			) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "healthChecks.workloads[0]",
+							BadValue: healthChecks.Workloads[0],
+							Detail: "exactly one of healthChecks.workloads[0].name or " +
+								"healthChecks.workloads[0].selector must be defined",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "healthChecks.workloads[1]",
+							BadValue: healthChecks.Workloads[1],
+							Detail: "exactly one of healthChecks.workloads[1].name or " +
+								"healthChecks.workloads[1].selector must be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			healthChecks: &kargoapi.HealthChecks{
+				Workloads: []kargoapi.WorkloadHealthCheck{
+					{
+						Kind:      kargoapi.WorkloadKindDeployment,
+						Namespace: "fake-namespace",
+						Name:      "fake-name",
+					},
+					{
+						Kind:      kargoapi.WorkloadKindStatefulSet,
+						Namespace: "fake-namespace",
+						Selector:  map[string]string{"app": "fake"},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.HealthChecks, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.healthChecks,
+				validateHealthChecks(
+					field.NewPath("healthChecks"),
+					testCase.healthChecks,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHooks(t *testing.T) {
+	validJobTemplate := batchv1.JobTemplateSpec{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "fake-container"}},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		hooks      *kargoapi.PromotionHooks
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			hooks: &kargoapi.PromotionHooks{
+				PrePromotion: []kargoapi.PromotionHook{
+					{
+						// Defines no containers
+						Name: "fake-hook",
+					},
+				},
+				PostPromotion: []kargoapi.PromotionHook{
+					{
+						Name:        "fake-hook",
+						JobTemplate: validJobTemplate,
+					},
+					{
+						// Duplicates the name of another post-promotion hook
+						Name:        "fake-hook",
+						JobTemplate: validJobTemplate,
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "hooks.prePromotion[0].jobTemplate.spec.template.spec.containers",
+							BadValue: "",
+							Detail:   "at least one container must be defined",
+						},
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "hooks.postPromotion[1].name",
+							BadValue: "fake-hook",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "forbidden Pod features",
+			hooks: &kargoapi.PromotionHooks{
+				PrePromotion: []kargoapi.PromotionHook{
+					{
+						Name: "fake-hook",
+						JobTemplate: batchv1.JobTemplateSpec{
+							Spec: batchv1.JobSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										HostNetwork: true,
+										Volumes: []corev1.Volume{
+											{
+												Name: "creds",
+												VolumeSource: corev1.VolumeSource{
+													Secret: &corev1.SecretVolumeSource{
+														SecretName: "fake-secret",
+													},
+												},
+											},
+											{
+												Name: "host",
+												VolumeSource: corev1.VolumeSource{
+													HostPath: &corev1.HostPathVolumeSource{
+														Path: "/",
+													},
+												},
+											},
+										},
+										Containers: []corev1.Container{{
+											Name: "fake-container",
+											EnvFrom: []corev1.EnvFromSource{{
+												SecretRef: &corev1.SecretEnvSource{},
+											}},
+											Env: []corev1.EnvVar{{
+												Name: "PASSWORD",
+												ValueFrom: &corev1.EnvVarSource{
+													SecretKeyRef: &corev1.SecretKeySelector{},
+												},
+											}},
+											SecurityContext: &corev1.SecurityContext{
+												Privileged: ptr.To(true),
+											},
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				fields := make([]string, len(errs))
+				for i, err := range errs {
+					require.Equal(t, field.ErrorTypeForbidden, err.Type)
+					fields[i] = err.Field
+				}
+				const psf = "hooks.prePromotion[0].jobTemplate.spec.template.spec"
+				require.Equal(
+					t,
+					[]string{
+						psf + ".hostNetwork",
+						psf + ".volumes[0].secret",
+						psf + ".volumes[1].hostPath",
+						psf + ".containers[0].envFrom[0].secretRef",
+						psf + ".containers[0].env[0].valueFrom.secretKeyRef",
+						psf + ".containers[0].securityContext.privileged",
+					},
+					fields,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			hooks: &kargoapi.PromotionHooks{
+				PrePromotion: []kargoapi.PromotionHook{
+					{
+						Name:        "fake-hook",
+						JobTemplate: validJobTemplate,
+					},
+				},
+				PostPromotion: []kargoapi.PromotionHook{
+					{
+						Name:        "fake-hook",
+						JobTemplate: validJobTemplate,
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				validateHooks(field.NewPath("hooks"), testCase.hooks),
+			)
+		})
+	}
+}
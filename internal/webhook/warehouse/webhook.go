@@ -3,6 +3,7 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Masterminds/semver"
@@ -55,7 +56,7 @@ func newWebhook(kubeClient client.Client) *webhook {
 	}
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
-	w.validateSpecFn = w.validateSpec
+	w.validateSpecFn = ValidateSpec
 	return w
 }
 
@@ -84,12 +85,52 @@ func (w *webhook) ValidateUpdate(
 	return w.validateCreateOrUpdateFn(warehouse)
 }
 
+// ValidateDelete never rejects the deletion of a Warehouse, but warns about any
+// Stages that subscribe to it, since they will stop receiving Freight.
 func (w *webhook) ValidateDelete(
-	context.Context,
-	runtime.Object,
+	ctx context.Context,
+	obj runtime.Object,
 ) (admission.Warnings, error) {
-	// No-op
-	return nil, nil
+	warehouse := obj.(*kargoapi.Warehouse) // nolint: forcetypeassert
+	stages := kargoapi.StageList{}
+	if err := w.client.List(
+		ctx,
+		&stages,
+		client.InNamespace(warehouse.Namespace),
+	); err != nil {
+		// Dependents are only worth a warning, so this is not reason enough to
+		// block the deletion.
+		return admission.Warnings{
+			fmt.Sprintf(
+				"unable to determine which Stages subscribe to Warehouse %q: %s",
+				warehouse.Name,
+				err,
+			),
+		}, nil
+	}
+	var dependents []string
+	for _, stage := range stages.Items {
+		if stage.Spec == nil {
+			continue
+		}
+		for _, name := range stage.Spec.Subscriptions.GetWarehouses() {
+			if name == warehouse.Name {
+				dependents = append(dependents, stage.Name)
+				break
+			}
+		}
+	}
+	if len(dependents) == 0 {
+		return nil, nil
+	}
+	return admission.Warnings{
+		fmt.Sprintf(
+			"Warehouse %q is subscribed to by Stage(s) %s, which will no longer "+
+				"receive Freight from it",
+			warehouse.Name,
+			strings.Join(dependents, ", "),
+		),
+	}, nil
 }
 
 func (w *webhook) validateCreateOrUpdate(
@@ -102,17 +143,19 @@ func (w *webhook) validateCreateOrUpdate(
 	return nil, nil
 }
 
-func (w *webhook) validateSpec(
+// ValidateSpec validates a WarehouseSpec beyond what its declarative validations
+// are capable of. It requires no access to a cluster.
+func ValidateSpec(
 	f *field.Path,
 	spec *kargoapi.WarehouseSpec,
 ) field.ErrorList {
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	return validateSubs(f.Child("subscriptions"), spec.Subscriptions)
 }
 
-func (w *webhook) validateSubs(
+func validateSubs(
 	f *field.Path,
 	subs []kargoapi.RepoSubscription,
 ) field.ErrorList {
@@ -121,12 +164,12 @@ func (w *webhook) validateSubs(
 	}
 	var errs field.ErrorList
 	for i, sub := range subs {
-		errs = append(errs, w.validateSub(f.Index(i), sub)...)
+		errs = append(errs, validateSub(f.Index(i), sub)...)
 	}
 	return errs
 }
 
-func (w *webhook) validateSub(
+func validateSub(
 	f *field.Path,
 	sub kargoapi.RepoSubscription,
 ) field.ErrorList {
@@ -134,14 +177,15 @@ func (w *webhook) validateSub(
 	var repoTypes int
 	if sub.Git != nil {
 		repoTypes++
+		errs = append(errs, validateGitSub(f.Child("git"), *sub.Git)...)
 	}
 	if sub.Image != nil {
 		repoTypes++
-		errs = append(errs, w.validateImageSub(f.Child("image"), *sub.Image)...)
+		errs = append(errs, validateImageSub(f.Child("image"), *sub.Image)...)
 	}
 	if sub.Chart != nil {
 		repoTypes++
-		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart)...)
+		errs = append(errs, validateChartSub(f.Child("chart"), *sub.Chart)...)
 	}
 	if repoTypes != 1 {
 		errs = append(
@@ -161,7 +205,24 @@ func (w *webhook) validateSub(
 	return errs
 }
 
-func (w *webhook) validateImageSub(
+func validateGitSub(
+	f *field.Path,
+	sub kargoapi.GitSubscription,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateRegex(f.Child("allowTags"), sub.AllowTags); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func validateImageSub(
 	f *field.Path,
 	sub kargoapi.ImageSubscription,
 ) field.ErrorList {
@@ -172,6 +233,9 @@ func (w *webhook) validateImageSub(
 	); err != nil {
 		errs = field.ErrorList{err}
 	}
+	if err := validateRegex(f.Child("allowTags"), sub.AllowTags); err != nil {
+		errs = append(errs, err)
+	}
 	if sub.Platform != "" {
 		if !image.ValidatePlatformConstraint(sub.Platform) {
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
@@ -180,7 +244,7 @@ func (w *webhook) validateImageSub(
 	return errs
 }
 
-func (w *webhook) validateChartSub(
+func validateChartSub(
 	f *field.Path,
 	sub kargoapi.ChartSubscription,
 ) field.ErrorList {
@@ -229,3 +293,13 @@ func validateSemverConstraint(
 	}
 	return nil
 }
+
+func validateRegex(f *field.Path, regex string) *field.Error {
+	if regex == "" {
+		return nil
+	}
+	if _, err := regexp.Compile(regex); err != nil {
+		return field.Invalid(f, regex, err.Error())
+	}
+	return nil
+}
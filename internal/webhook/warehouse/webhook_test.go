@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -149,9 +151,85 @@ func TestValidateUpdate(t *testing.T) {
 }
 
 func TestValidateDelete(t *testing.T) {
-	w := &webhook{}
-	_, err := w.ValidateDelete(context.Background(), nil)
-	require.NoError(t, err, nil)
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(admission.Warnings, error)
+	}{
+		{
+			name: "no dependents",
+			assertions: func(warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+			},
+		},
+		{
+			name: "dependents",
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "test",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouse: "fake-warehouse",
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "uat",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouses: []string{"another-warehouse", "fake-warehouse"},
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "prod",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							UpstreamStages: []kargoapi.StageSubscription{{Name: "uat"}},
+						},
+					},
+				},
+			},
+			assertions: func(warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Len(t, warnings, 1)
+				require.Contains(t, warnings[0], "Stage(s) test, uat,")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			testCase.assertions(
+				w.ValidateDelete(
+					context.Background(),
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-warehouse",
+						},
+					},
+				),
+			)
+		})
+	}
 }
 
 func TestValidateCreateOrUpdate(t *testing.T) {
@@ -270,12 +348,11 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.spec,
-				w.validateSpec(
+				ValidateSpec(
 					field.NewPath("spec"),
 					testCase.spec,
 				),
@@ -351,12 +428,11 @@ func TestValidateSubs(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.subs,
-				w.validateSubs(field.NewPath("subs"), testCase.subs),
+				validateSubs(field.NewPath("subs"), testCase.subs),
 			)
 		})
 	}
@@ -420,12 +496,54 @@ func TestValidateSub(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				testCase.sub,
-				w.validateSub(field.NewPath("sub"), testCase.sub),
+				validateSub(field.NewPath("sub"), testCase.sub),
+			)
+		})
+	}
+}
+
+func TestValidateGitSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.GitSubscription{
+				SemverConstraint: "bogus",
+				AllowTags:        "(",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "git.semverConstraint", errs[0].Field)
+				require.Equal(t, "git.allowTags", errs[1].Field)
+				require.Equal(t, "(", errs[1].BadValue)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.GitSubscription{
+				SemverConstraint: "^1.0.0",
+				AllowTags:        "^v[0-9]+",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				validateGitSub(
+					field.NewPath("git"),
+					testCase.sub,
+				),
 			)
 		})
 	}
@@ -470,11 +588,10 @@ func TestValidateImageSub(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				w.validateImageSub(
+				validateImageSub(
 					field.NewPath("image"),
 					testCase.sub,
 				),
@@ -546,11 +663,10 @@ func TestValidateChartSub(t *testing.T) {
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				w.validateChartSub(
+				validateChartSub(
 					field.NewPath("chart"),
 					testCase.sub,
 				),
@@ -608,3 +724,44 @@ func TestValidateSemverConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRegex(t *testing.T) {
+	testCases := []struct {
+		name       string
+		regex      string
+		assertions func(*field.Error)
+	}{
+		{
+			name: "empty string",
+			assertions: func(err *field.Error) {
+				require.Nil(t, err)
+			},
+		},
+
+		{
+			name:  "invalid",
+			regex: "[a-z",
+			assertions: func(err *field.Error) {
+				require.NotNil(t, err)
+				require.Equal(t, field.ErrorTypeInvalid, err.Type)
+				require.Equal(t, "allowTags", err.Field)
+				require.Equal(t, "[a-z", err.BadValue)
+			},
+		},
+
+		{
+			name:  "valid",
+			regex: "^v[0-9]+$",
+			assertions: func(err *field.Error) {
+				require.Nil(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				validateRegex(field.NewPath("allowTags"), testCase.regex),
+			)
+		})
+	}
+}
@@ -43,6 +43,15 @@ const (
 	KargoServiceGetPublicConfigProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPublicConfig"
 	// KargoServiceAdminLoginProcedure is the fully-qualified name of the KargoService's AdminLogin RPC.
 	KargoServiceAdminLoginProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin"
+	// KargoServiceCreateAPITokenProcedure is the fully-qualified name of the KargoService's
+	// CreateAPIToken RPC.
+	KargoServiceCreateAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateAPIToken"
+	// KargoServiceListAPITokensProcedure is the fully-qualified name of the KargoService's
+	// ListAPITokens RPC.
+	KargoServiceListAPITokensProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListAPITokens"
+	// KargoServiceRevokeAPITokenProcedure is the fully-qualified name of the KargoService's
+	// RevokeAPIToken RPC.
+	KargoServiceRevokeAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RevokeAPIToken"
 	// KargoServiceCreateResourceProcedure is the fully-qualified name of the KargoService's
 	// CreateResource RPC.
 	KargoServiceCreateResourceProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateResource"
@@ -80,6 +89,11 @@ const (
 	// KargoServiceRefreshStageProcedure is the fully-qualified name of the KargoService's RefreshStage
 	// RPC.
 	KargoServiceRefreshStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshStage"
+	// KargoServiceLockStageProcedure is the fully-qualified name of the KargoService's LockStage RPC.
+	KargoServiceLockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/LockStage"
+	// KargoServiceUnlockStageProcedure is the fully-qualified name of the KargoService's UnlockStage
+	// RPC.
+	KargoServiceUnlockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UnlockStage"
 	// KargoServiceListPromotionsProcedure is the fully-qualified name of the KargoService's
 	// ListPromotions RPC.
 	KargoServiceListPromotionsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListPromotions"
@@ -92,6 +106,9 @@ const (
 	// KargoServiceWatchPromotionProcedure is the fully-qualified name of the KargoService's
 	// WatchPromotion RPC.
 	KargoServiceWatchPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotion"
+	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
+	// AbortPromotion RPC.
+	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
 	// KargoServiceCreateProjectProcedure is the fully-qualified name of the KargoService's
 	// CreateProject RPC.
 	KargoServiceCreateProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateProject"
@@ -104,9 +121,21 @@ const (
 	// KargoServiceSetAutoPromotionForStageProcedure is the fully-qualified name of the KargoService's
 	// SetAutoPromotionForStage RPC.
 	KargoServiceSetAutoPromotionForStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/SetAutoPromotionForStage"
+	// KargoServiceGetPipelineProcedure is the fully-qualified name of the KargoService's GetPipeline
+	// RPC.
+	KargoServiceGetPipelineProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPipeline"
+	// KargoServiceExportProjectProcedure is the fully-qualified name of the KargoService's
+	// ExportProject RPC.
+	KargoServiceExportProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ExportProject"
+	// KargoServiceImportProjectProcedure is the fully-qualified name of the KargoService's
+	// ImportProject RPC.
+	KargoServiceImportProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ImportProject"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
+	// KargoServiceWatchFreightProcedure is the fully-qualified name of the KargoService's WatchFreight
+	// RPC.
+	KargoServiceWatchFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchFreight"
 	// KargoServiceDeleteFreightProcedure is the fully-qualified name of the KargoService's
 	// DeleteFreight RPC.
 	KargoServiceDeleteFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteFreight"
@@ -116,6 +145,9 @@ const (
 	// KargoServiceUpdateFreightAliasProcedure is the fully-qualified name of the KargoService's
 	// UpdateFreightAlias RPC.
 	KargoServiceUpdateFreightAliasProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateFreightAlias"
+	// KargoServiceDiffFreightProcedure is the fully-qualified name of the KargoService's DiffFreight
+	// RPC.
+	KargoServiceDiffFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DiffFreight"
 	// KargoServiceListWarehousesProcedure is the fully-qualified name of the KargoService's
 	// ListWarehouses RPC.
 	KargoServiceListWarehousesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListWarehouses"
@@ -145,6 +177,9 @@ type KargoServiceClient interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
+	ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error)
+	RevokeAPIToken(context.Context, *connect.Request[v1alpha1.RevokeAPITokenRequest]) (*connect.Response[v1alpha1.RevokeAPITokenResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
@@ -160,19 +195,27 @@ type KargoServiceClient interface {
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionsResponse], error)
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
+	GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error)
+	ExportProject(context.Context, *connect.Request[v1alpha1.ExportProjectRequest]) (*connect.Response[v1alpha1.ExportProjectResponse], error)
+	ImportProject(context.Context, *connect.Request[v1alpha1.ImportProjectRequest]) (*connect.Response[v1alpha1.ImportProjectResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchFreightResponse], error)
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
+	DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchWarehousesResponse], error)
@@ -212,6 +255,21 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceAdminLoginProcedure,
 			opts...,
 		),
+		createAPIToken: connect.NewClient[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceCreateAPITokenProcedure,
+			opts...,
+		),
+		listAPITokens: connect.NewClient[v1alpha1.ListAPITokensRequest, v1alpha1.ListAPITokensResponse](
+			httpClient,
+			baseURL+KargoServiceListAPITokensProcedure,
+			opts...,
+		),
+		revokeAPIToken: connect.NewClient[v1alpha1.RevokeAPITokenRequest, v1alpha1.RevokeAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceRevokeAPITokenProcedure,
+			opts...,
+		),
 		createResource: connect.NewClient[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse](
 			httpClient,
 			baseURL+KargoServiceCreateResourceProcedure,
@@ -277,6 +335,16 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceRefreshStageProcedure,
 			opts...,
 		),
+		lockStage: connect.NewClient[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse](
+			httpClient,
+			baseURL+KargoServiceLockStageProcedure,
+			opts...,
+		),
+		unlockStage: connect.NewClient[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse](
+			httpClient,
+			baseURL+KargoServiceUnlockStageProcedure,
+			opts...,
+		),
 		listPromotions: connect.NewClient[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse](
 			httpClient,
 			baseURL+KargoServiceListPromotionsProcedure,
@@ -297,6 +365,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceWatchPromotionProcedure,
 			opts...,
 		),
+		abortPromotion: connect.NewClient[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceAbortPromotionProcedure,
+			opts...,
+		),
 		createProject: connect.NewClient[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse](
 			httpClient,
 			baseURL+KargoServiceCreateProjectProcedure,
@@ -317,11 +390,31 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceSetAutoPromotionForStageProcedure,
 			opts...,
 		),
+		getPipeline: connect.NewClient[v1alpha1.GetPipelineRequest, v1alpha1.GetPipelineResponse](
+			httpClient,
+			baseURL+KargoServiceGetPipelineProcedure,
+			opts...,
+		),
+		exportProject: connect.NewClient[v1alpha1.ExportProjectRequest, v1alpha1.ExportProjectResponse](
+			httpClient,
+			baseURL+KargoServiceExportProjectProcedure,
+			opts...,
+		),
+		importProject: connect.NewClient[v1alpha1.ImportProjectRequest, v1alpha1.ImportProjectResponse](
+			httpClient,
+			baseURL+KargoServiceImportProjectProcedure,
+			opts...,
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
 			opts...,
 		),
+		watchFreight: connect.NewClient[v1alpha1.WatchFreightRequest, v1alpha1.WatchFreightResponse](
+			httpClient,
+			baseURL+KargoServiceWatchFreightProcedure,
+			opts...,
+		),
 		deleteFreight: connect.NewClient[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteFreightProcedure,
@@ -337,6 +430,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceUpdateFreightAliasProcedure,
 			opts...,
 		),
+		diffFreight: connect.NewClient[v1alpha1.DiffFreightRequest, v1alpha1.DiffFreightResponse](
+			httpClient,
+			baseURL+KargoServiceDiffFreightProcedure,
+			opts...,
+		),
 		listWarehouses: connect.NewClient[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse](
 			httpClient,
 			baseURL+KargoServiceListWarehousesProcedure,
@@ -381,6 +479,9 @@ type kargoServiceClient struct {
 	getConfig                *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
 	getPublicConfig          *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
 	adminLogin               *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
+	createAPIToken           *connect.Client[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse]
+	listAPITokens            *connect.Client[v1alpha1.ListAPITokensRequest, v1alpha1.ListAPITokensResponse]
+	revokeAPIToken           *connect.Client[v1alpha1.RevokeAPITokenRequest, v1alpha1.RevokeAPITokenResponse]
 	createResource           *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
 	createOrUpdateResource   *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
 	updateResource           *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
@@ -394,18 +495,26 @@ type kargoServiceClient struct {
 	promoteStage             *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
 	promoteSubscribers       *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
 	refreshStage             *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	lockStage                *connect.Client[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse]
+	unlockStage              *connect.Client[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse]
 	listPromotions           *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
 	watchPromotions          *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
 	getPromotion             *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion           *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	abortPromotion           *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
 	createProject            *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
 	listProjects             *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
 	deleteProject            *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
 	setAutoPromotionForStage *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
+	getPipeline              *connect.Client[v1alpha1.GetPipelineRequest, v1alpha1.GetPipelineResponse]
+	exportProject            *connect.Client[v1alpha1.ExportProjectRequest, v1alpha1.ExportProjectResponse]
+	importProject            *connect.Client[v1alpha1.ImportProjectRequest, v1alpha1.ImportProjectResponse]
 	queryFreight             *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
+	watchFreight             *connect.Client[v1alpha1.WatchFreightRequest, v1alpha1.WatchFreightResponse]
 	deleteFreight            *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
 	approveFreight           *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
 	updateFreightAlias       *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
+	diffFreight              *connect.Client[v1alpha1.DiffFreightRequest, v1alpha1.DiffFreightResponse]
 	listWarehouses           *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
 	getWarehouse             *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
 	watchWarehouses          *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
@@ -435,6 +544,21 @@ func (c *kargoServiceClient) AdminLogin(ctx context.Context, req *connect.Reques
 	return c.adminLogin.CallUnary(ctx, req)
 }
 
+// CreateAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken.
+func (c *kargoServiceClient) CreateAPIToken(ctx context.Context, req *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return c.createAPIToken.CallUnary(ctx, req)
+}
+
+// ListAPITokens calls akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens.
+func (c *kargoServiceClient) ListAPITokens(ctx context.Context, req *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error) {
+	return c.listAPITokens.CallUnary(ctx, req)
+}
+
+// RevokeAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.RevokeAPIToken.
+func (c *kargoServiceClient) RevokeAPIToken(ctx context.Context, req *connect.Request[v1alpha1.RevokeAPITokenRequest]) (*connect.Response[v1alpha1.RevokeAPITokenResponse], error) {
+	return c.revokeAPIToken.CallUnary(ctx, req)
+}
+
 // CreateResource calls akuity.io.kargo.service.v1alpha1.KargoService.CreateResource.
 func (c *kargoServiceClient) CreateResource(ctx context.Context, req *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return c.createResource.CallUnary(ctx, req)
@@ -501,6 +625,16 @@ func (c *kargoServiceClient) RefreshStage(ctx context.Context, req *connect.Requ
 	return c.refreshStage.CallUnary(ctx, req)
 }
 
+// LockStage calls akuity.io.kargo.service.v1alpha1.KargoService.LockStage.
+func (c *kargoServiceClient) LockStage(ctx context.Context, req *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return c.lockStage.CallUnary(ctx, req)
+}
+
+// UnlockStage calls akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage.
+func (c *kargoServiceClient) UnlockStage(ctx context.Context, req *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return c.unlockStage.CallUnary(ctx, req)
+}
+
 // ListPromotions calls akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions.
 func (c *kargoServiceClient) ListPromotions(ctx context.Context, req *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return c.listPromotions.CallUnary(ctx, req)
@@ -521,6 +655,11 @@ func (c *kargoServiceClient) WatchPromotion(ctx context.Context, req *connect.Re
 	return c.watchPromotion.CallServerStream(ctx, req)
 }
 
+// AbortPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion.
+func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return c.abortPromotion.CallUnary(ctx, req)
+}
+
 // CreateProject calls akuity.io.kargo.service.v1alpha1.KargoService.CreateProject.
 func (c *kargoServiceClient) CreateProject(ctx context.Context, req *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error) {
 	return c.createProject.CallUnary(ctx, req)
@@ -542,11 +681,31 @@ func (c *kargoServiceClient) SetAutoPromotionForStage(ctx context.Context, req *
 	return c.setAutoPromotionForStage.CallUnary(ctx, req)
 }
 
+// GetPipeline calls akuity.io.kargo.service.v1alpha1.KargoService.GetPipeline.
+func (c *kargoServiceClient) GetPipeline(ctx context.Context, req *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error) {
+	return c.getPipeline.CallUnary(ctx, req)
+}
+
+// ExportProject calls akuity.io.kargo.service.v1alpha1.KargoService.ExportProject.
+func (c *kargoServiceClient) ExportProject(ctx context.Context, req *connect.Request[v1alpha1.ExportProjectRequest]) (*connect.Response[v1alpha1.ExportProjectResponse], error) {
+	return c.exportProject.CallUnary(ctx, req)
+}
+
+// ImportProject calls akuity.io.kargo.service.v1alpha1.KargoService.ImportProject.
+func (c *kargoServiceClient) ImportProject(ctx context.Context, req *connect.Request[v1alpha1.ImportProjectRequest]) (*connect.Response[v1alpha1.ImportProjectResponse], error) {
+	return c.importProject.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
 }
 
+// WatchFreight calls akuity.io.kargo.service.v1alpha1.KargoService.WatchFreight.
+func (c *kargoServiceClient) WatchFreight(ctx context.Context, req *connect.Request[v1alpha1.WatchFreightRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchFreightResponse], error) {
+	return c.watchFreight.CallServerStream(ctx, req)
+}
+
 // DeleteFreight calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight.
 func (c *kargoServiceClient) DeleteFreight(ctx context.Context, req *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return c.deleteFreight.CallUnary(ctx, req)
@@ -562,6 +721,11 @@ func (c *kargoServiceClient) UpdateFreightAlias(ctx context.Context, req *connec
 	return c.updateFreightAlias.CallUnary(ctx, req)
 }
 
+// DiffFreight calls akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight.
+func (c *kargoServiceClient) DiffFreight(ctx context.Context, req *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error) {
+	return c.diffFreight.CallUnary(ctx, req)
+}
+
 // ListWarehouses calls akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses.
 func (c *kargoServiceClient) ListWarehouses(ctx context.Context, req *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return c.listWarehouses.CallUnary(ctx, req)
@@ -604,6 +768,9 @@ type KargoServiceHandler interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
+	ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error)
+	RevokeAPIToken(context.Context, *connect.Request[v1alpha1.RevokeAPITokenRequest]) (*connect.Response[v1alpha1.RevokeAPITokenResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
@@ -619,19 +786,27 @@ type KargoServiceHandler interface {
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest], *connect.ServerStream[v1alpha1.WatchPromotionsResponse]) error
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
 	CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
+	GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error)
+	ExportProject(context.Context, *connect.Request[v1alpha1.ExportProjectRequest]) (*connect.Response[v1alpha1.ExportProjectResponse], error)
+	ImportProject(context.Context, *connect.Request[v1alpha1.ImportProjectRequest]) (*connect.Response[v1alpha1.ImportProjectResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest], *connect.ServerStream[v1alpha1.WatchFreightResponse]) error
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
+	DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest], *connect.ServerStream[v1alpha1.WatchWarehousesResponse]) error
@@ -667,6 +842,21 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.AdminLogin,
 		opts...,
 	)
+	kargoServiceCreateAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceCreateAPITokenProcedure,
+		svc.CreateAPIToken,
+		opts...,
+	)
+	kargoServiceListAPITokensHandler := connect.NewUnaryHandler(
+		KargoServiceListAPITokensProcedure,
+		svc.ListAPITokens,
+		opts...,
+	)
+	kargoServiceRevokeAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceRevokeAPITokenProcedure,
+		svc.RevokeAPIToken,
+		opts...,
+	)
 	kargoServiceCreateResourceHandler := connect.NewUnaryHandler(
 		KargoServiceCreateResourceProcedure,
 		svc.CreateResource,
@@ -732,6 +922,16 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.RefreshStage,
 		opts...,
 	)
+	kargoServiceLockStageHandler := connect.NewUnaryHandler(
+		KargoServiceLockStageProcedure,
+		svc.LockStage,
+		opts...,
+	)
+	kargoServiceUnlockStageHandler := connect.NewUnaryHandler(
+		KargoServiceUnlockStageProcedure,
+		svc.UnlockStage,
+		opts...,
+	)
 	kargoServiceListPromotionsHandler := connect.NewUnaryHandler(
 		KargoServiceListPromotionsProcedure,
 		svc.ListPromotions,
@@ -752,6 +952,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.WatchPromotion,
 		opts...,
 	)
+	kargoServiceAbortPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceAbortPromotionProcedure,
+		svc.AbortPromotion,
+		opts...,
+	)
 	kargoServiceCreateProjectHandler := connect.NewUnaryHandler(
 		KargoServiceCreateProjectProcedure,
 		svc.CreateProject,
@@ -772,11 +977,31 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.SetAutoPromotionForStage,
 		opts...,
 	)
+	kargoServiceGetPipelineHandler := connect.NewUnaryHandler(
+		KargoServiceGetPipelineProcedure,
+		svc.GetPipeline,
+		opts...,
+	)
+	kargoServiceExportProjectHandler := connect.NewUnaryHandler(
+		KargoServiceExportProjectProcedure,
+		svc.ExportProject,
+		opts...,
+	)
+	kargoServiceImportProjectHandler := connect.NewUnaryHandler(
+		KargoServiceImportProjectProcedure,
+		svc.ImportProject,
+		opts...,
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
 		opts...,
 	)
+	kargoServiceWatchFreightHandler := connect.NewServerStreamHandler(
+		KargoServiceWatchFreightProcedure,
+		svc.WatchFreight,
+		opts...,
+	)
 	kargoServiceDeleteFreightHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteFreightProcedure,
 		svc.DeleteFreight,
@@ -792,6 +1017,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.UpdateFreightAlias,
 		opts...,
 	)
+	kargoServiceDiffFreightHandler := connect.NewUnaryHandler(
+		KargoServiceDiffFreightProcedure,
+		svc.DiffFreight,
+		opts...,
+	)
 	kargoServiceListWarehousesHandler := connect.NewUnaryHandler(
 		KargoServiceListWarehousesProcedure,
 		svc.ListWarehouses,
@@ -837,6 +1067,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPublicConfigHandler.ServeHTTP(w, r)
 		case KargoServiceAdminLoginProcedure:
 			kargoServiceAdminLoginHandler.ServeHTTP(w, r)
+		case KargoServiceCreateAPITokenProcedure:
+			kargoServiceCreateAPITokenHandler.ServeHTTP(w, r)
+		case KargoServiceListAPITokensProcedure:
+			kargoServiceListAPITokensHandler.ServeHTTP(w, r)
+		case KargoServiceRevokeAPITokenProcedure:
+			kargoServiceRevokeAPITokenHandler.ServeHTTP(w, r)
 		case KargoServiceCreateResourceProcedure:
 			kargoServiceCreateResourceHandler.ServeHTTP(w, r)
 		case KargoServiceCreateOrUpdateResourceProcedure:
@@ -863,6 +1099,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServicePromoteSubscribersHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshStageProcedure:
 			kargoServiceRefreshStageHandler.ServeHTTP(w, r)
+		case KargoServiceLockStageProcedure:
+			kargoServiceLockStageHandler.ServeHTTP(w, r)
+		case KargoServiceUnlockStageProcedure:
+			kargoServiceUnlockStageHandler.ServeHTTP(w, r)
 		case KargoServiceListPromotionsProcedure:
 			kargoServiceListPromotionsHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionsProcedure:
@@ -871,6 +1111,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionProcedure:
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceAbortPromotionProcedure:
+			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceCreateProjectProcedure:
 			kargoServiceCreateProjectHandler.ServeHTTP(w, r)
 		case KargoServiceListProjectsProcedure:
@@ -879,14 +1121,24 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceSetAutoPromotionForStageProcedure:
 			kargoServiceSetAutoPromotionForStageHandler.ServeHTTP(w, r)
+		case KargoServiceGetPipelineProcedure:
+			kargoServiceGetPipelineHandler.ServeHTTP(w, r)
+		case KargoServiceExportProjectProcedure:
+			kargoServiceExportProjectHandler.ServeHTTP(w, r)
+		case KargoServiceImportProjectProcedure:
+			kargoServiceImportProjectHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
+		case KargoServiceWatchFreightProcedure:
+			kargoServiceWatchFreightHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteFreightProcedure:
 			kargoServiceDeleteFreightHandler.ServeHTTP(w, r)
 		case KargoServiceApproveFreightProcedure:
 			kargoServiceApproveFreightHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateFreightAliasProcedure:
 			kargoServiceUpdateFreightAliasHandler.ServeHTTP(w, r)
+		case KargoServiceDiffFreightProcedure:
+			kargoServiceDiffFreightHandler.ServeHTTP(w, r)
 		case KargoServiceListWarehousesProcedure:
 			kargoServiceListWarehousesHandler.ServeHTTP(w, r)
 		case KargoServiceGetWarehouseProcedure:
@@ -926,6 +1178,18 @@ func (UnimplementedKargoServiceHandler) AdminLogin(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AdminLogin is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ListAPITokens(context.Context, *connect.Request[v1alpha1.ListAPITokensRequest]) (*connect.Response[v1alpha1.ListAPITokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) RevokeAPIToken(context.Context, *connect.Request[v1alpha1.RevokeAPITokenRequest]) (*connect.Response[v1alpha1.RevokeAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RevokeAPIToken is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateResource is not implemented"))
 }
@@ -978,6 +1242,14 @@ func (UnimplementedKargoServiceHandler) RefreshStage(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.LockStage is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions is not implemented"))
 }
@@ -994,6 +1266,10 @@ func (UnimplementedKargoServiceHandler) WatchPromotion(context.Context, *connect
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateProject(context.Context, *connect.Request[v1alpha1.CreateProjectRequest]) (*connect.Response[v1alpha1.CreateProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateProject is not implemented"))
 }
@@ -1010,10 +1286,26 @@ func (UnimplementedKargoServiceHandler) SetAutoPromotionForStage(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetPipeline is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ExportProject(context.Context, *connect.Request[v1alpha1.ExportProjectRequest]) (*connect.Response[v1alpha1.ExportProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ExportProject is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ImportProject(context.Context, *connect.Request[v1alpha1.ImportProjectRequest]) (*connect.Response[v1alpha1.ImportProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ImportProject is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) WatchFreight(context.Context, *connect.Request[v1alpha1.WatchFreightRequest], *connect.ServerStream[v1alpha1.WatchFreightResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchFreight is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight is not implemented"))
 }
@@ -1026,6 +1318,10 @@ func (UnimplementedKargoServiceHandler) UpdateFreightAlias(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateFreightAlias is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) DiffFreight(context.Context, *connect.Request[v1alpha1.DiffFreightRequest]) (*connect.Response[v1alpha1.DiffFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses is not implemented"))
 }
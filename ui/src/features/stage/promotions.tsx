@@ -3,6 +3,7 @@ import {
   faCircleCheck,
   faCircleExclamation,
   faCircleNotch,
+  faCircleStop,
   faHourglassStart
 } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
@@ -117,6 +118,16 @@ export const Promotions = () => {
                 />
               </Popover>
             );
+          case 'Aborted':
+            return (
+              <Popover
+                content={promotion.status?.message}
+                title={promotion.status?.phase}
+                placement='right'
+              >
+                <FontAwesomeIcon color='#aaa' icon={faCircleStop} size='lg' />
+              </Popover>
+            );
           case 'Running':
             return (
               <Tooltip title={promotion.status?.phase} placement='right'>
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { MethodKind } from "@bufbuild/protobuf";
-import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
+import { AbortPromotionRequest, AbortPromotionResponse, AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateAPITokenRequest, CreateAPITokenResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, DiffFreightRequest, DiffFreightResponse, ExportProjectRequest, ExportProjectResponse, GetConfigRequest, GetConfigResponse, GetPipelineRequest, GetPipelineResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ImportProjectRequest, ImportProjectResponse, ListAPITokensRequest, ListAPITokensResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, LockStageRequest, LockStageResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, RevokeAPITokenRequest, RevokeAPITokenResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UnlockStageRequest, UnlockStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
 
 export const typeName = "akuity.io.kargo.service.v1alpha1.KargoService";
 
@@ -77,6 +77,57 @@ export const adminLogin = createQueryService({
   },
 }).adminLogin;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+ */
+export const createAPIToken = createQueryService({
+  service: {
+    methods: {
+      createAPIToken: {
+        name: "CreateAPIToken",
+        kind: MethodKind.Unary,
+        I: CreateAPITokenRequest,
+        O: CreateAPITokenResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).createAPIToken;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens
+ */
+export const listAPITokens = createQueryService({
+  service: {
+    methods: {
+      listAPITokens: {
+        name: "ListAPITokens",
+        kind: MethodKind.Unary,
+        I: ListAPITokensRequest,
+        O: ListAPITokensResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).listAPITokens;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeAPIToken
+ */
+export const revokeAPIToken = createQueryService({
+  service: {
+    methods: {
+      revokeAPIToken: {
+        name: "RevokeAPIToken",
+        kind: MethodKind.Unary,
+        I: RevokeAPITokenRequest,
+        O: RevokeAPITokenResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).revokeAPIToken;
+
 /**
  * TODO(devholic): Add ApplyResource API
  * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -284,6 +335,40 @@ export const refreshStage = createQueryService({
   },
 }).refreshStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.LockStage
+ */
+export const lockStage = createQueryService({
+  service: {
+    methods: {
+      lockStage: {
+        name: "LockStage",
+        kind: MethodKind.Unary,
+        I: LockStageRequest,
+        O: LockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).lockStage;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage
+ */
+export const unlockStage = createQueryService({
+  service: {
+    methods: {
+      unlockStage: {
+        name: "UnlockStage",
+        kind: MethodKind.Unary,
+        I: UnlockStageRequest,
+        O: UnlockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).unlockStage;
+
 /**
  * Promotion APIs 
  *
@@ -320,6 +405,23 @@ export const getPromotion = createQueryService({
   },
 }).getPromotion;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion
+ */
+export const abortPromotion = createQueryService({
+  service: {
+    methods: {
+      abortPromotion: {
+        name: "AbortPromotion",
+        kind: MethodKind.Unary,
+        I: AbortPromotionRequest,
+        O: AbortPromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).abortPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateProject
  */
@@ -388,6 +490,57 @@ export const setAutoPromotionForStage = createQueryService({
   },
 }).setAutoPromotionForStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPipeline
+ */
+export const getPipeline = createQueryService({
+  service: {
+    methods: {
+      getPipeline: {
+        name: "GetPipeline",
+        kind: MethodKind.Unary,
+        I: GetPipelineRequest,
+        O: GetPipelineResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).getPipeline;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExportProject
+ */
+export const exportProject = createQueryService({
+  service: {
+    methods: {
+      exportProject: {
+        name: "ExportProject",
+        kind: MethodKind.Unary,
+        I: ExportProjectRequest,
+        O: ExportProjectResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).exportProject;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ImportProject
+ */
+export const importProject = createQueryService({
+  service: {
+    methods: {
+      importProject: {
+        name: "ImportProject",
+        kind: MethodKind.Unary,
+        I: ImportProjectRequest,
+        O: ImportProjectResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).importProject;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
  */
@@ -456,6 +609,23 @@ export const updateFreightAlias = createQueryService({
   },
 }).updateFreightAlias;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight
+ */
+export const diffFreight = createQueryService({
+  service: {
+    methods: {
+      diffFreight: {
+        name: "DiffFreight",
+        kind: MethodKind.Unary,
+        I: DiffFreightRequest,
+        O: DiffFreightResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).diffFreight;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
  */
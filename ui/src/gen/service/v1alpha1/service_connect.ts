@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
+import { AbortPromotionRequest, AbortPromotionResponse, AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateAPITokenRequest, CreateAPITokenResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, DiffFreightRequest, DiffFreightResponse, ExportProjectRequest, ExportProjectResponse, GetConfigRequest, GetConfigResponse, GetPipelineRequest, GetPipelineResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ImportProjectRequest, ImportProjectResponse, ListAPITokensRequest, ListAPITokensResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, LockStageRequest, LockStageResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, RevokeAPITokenRequest, RevokeAPITokenResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UnlockStageRequest, UnlockStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchFreightRequest, WatchFreightResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -48,6 +48,33 @@ export const KargoService = {
       O: AdminLoginResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+     */
+    createAPIToken: {
+      name: "CreateAPIToken",
+      I: CreateAPITokenRequest,
+      O: CreateAPITokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListAPITokens
+     */
+    listAPITokens: {
+      name: "ListAPITokens",
+      I: ListAPITokensRequest,
+      O: ListAPITokensResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeAPIToken
+     */
+    revokeAPIToken: {
+      name: "RevokeAPIToken",
+      I: RevokeAPITokenRequest,
+      O: RevokeAPITokenResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * TODO(devholic): Add ApplyResource API
      * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -168,6 +195,24 @@ export const KargoService = {
       O: RefreshStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.LockStage
+     */
+    lockStage: {
+      name: "LockStage",
+      I: LockStageRequest,
+      O: LockStageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage
+     */
+    unlockStage: {
+      name: "UnlockStage",
+      I: UnlockStageRequest,
+      O: UnlockStageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Promotion APIs 
      *
@@ -206,6 +251,15 @@ export const KargoService = {
       O: WatchPromotionResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion
+     */
+    abortPromotion: {
+      name: "AbortPromotion",
+      I: AbortPromotionRequest,
+      O: AbortPromotionResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateProject
      */
@@ -242,6 +296,33 @@ export const KargoService = {
       O: SetAutoPromotionForStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPipeline
+     */
+    getPipeline: {
+      name: "GetPipeline",
+      I: GetPipelineRequest,
+      O: GetPipelineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ExportProject
+     */
+    exportProject: {
+      name: "ExportProject",
+      I: ExportProjectRequest,
+      O: ExportProjectResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ImportProject
+     */
+    importProject: {
+      name: "ImportProject",
+      I: ImportProjectRequest,
+      O: ImportProjectResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
      */
@@ -251,6 +332,15 @@ export const KargoService = {
       O: QueryFreightResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.WatchFreight
+     */
+    watchFreight: {
+      name: "WatchFreight",
+      I: WatchFreightRequest,
+      O: WatchFreightResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight
      */
@@ -278,6 +368,15 @@ export const KargoService = {
       O: UpdateFreightAliasResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DiffFreight
+     */
+    diffFreight: {
+      name: "DiffFreight",
+      I: DiffFreightRequest,
+      O: DiffFreightResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
      */
@@ -1153,6 +1153,21 @@ export class ListStagesRequest extends Message<ListStagesRequest> {
    */
   project = "";
 
+  /**
+   * @generated from field: string label_selector = 2;
+   */
+  labelSelector = "";
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
   constructor(data?: PartialMessage<ListStagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1162,6 +1177,9 @@ export class ListStagesRequest extends Message<ListStagesRequest> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStagesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStagesRequest {
@@ -1190,6 +1208,11 @@ export class ListStagesResponse extends Message<ListStagesResponse> {
    */
   stages: Stage[] = [];
 
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
   constructor(data?: PartialMessage<ListStagesResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1199,6 +1222,7 @@ export class ListStagesResponse extends Message<ListStagesResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStagesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stages", kind: "message", T: Stage, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStagesResponse {
@@ -1566,6 +1590,11 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
    */
   freight = "";
 
+  /**
+   * @generated from field: bool force = 4;
+   */
+  force = false;
+
   constructor(data?: PartialMessage<PromoteStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1577,6 +1606,7 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStageRequest {
@@ -1799,6 +1829,178 @@ export class RefreshStageResponse extends Message<RefreshStageResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageRequest
+ */
+export class LockStageRequest extends Message<LockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+
+  constructor(data?: PartialMessage<LockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "until", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageRequest | PlainMessage<LockStageRequest> | undefined, b: LockStageRequest | PlainMessage<LockStageRequest> | undefined): boolean {
+    return proto3.util.equals(LockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageResponse
+ */
+export class LockStageResponse extends Message<LockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<LockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageResponse | PlainMessage<LockStageResponse> | undefined, b: LockStageResponse | PlainMessage<LockStageResponse> | undefined): boolean {
+    return proto3.util.equals(LockStageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageRequest
+ */
+export class UnlockStageRequest extends Message<UnlockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UnlockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined, b: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined): boolean {
+    return proto3.util.equals(UnlockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageResponse
+ */
+export class UnlockStageResponse extends Message<UnlockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<UnlockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined, b: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined): boolean {
+    return proto3.util.equals(UnlockStageResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.TypedPromotionPolicySpec
  */
@@ -1868,6 +2070,26 @@ export class ListPromotionsRequest extends Message<ListPromotionsRequest> {
    */
   stage?: string;
 
+  /**
+   * @generated from field: string label_selector = 3;
+   */
+  labelSelector = "";
+
+  /**
+   * @generated from field: repeated string phases = 4;
+   */
+  phases: string[] = [];
+
+  /**
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 6;
+   */
+  pageToken = "";
+
   constructor(data?: PartialMessage<ListPromotionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1878,6 +2100,10 @@ export class ListPromotionsRequest extends Message<ListPromotionsRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "phases", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromotionsRequest {
@@ -1906,6 +2132,11 @@ export class ListPromotionsResponse extends Message<ListPromotionsResponse> {
    */
   promotions: Promotion[] = [];
 
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
   constructor(data?: PartialMessage<ListPromotionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1915,6 +2146,7 @@ export class ListPromotionsResponse extends Message<ListPromotionsResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListPromotionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromotionsResponse {
@@ -2186,6 +2418,82 @@ export class WatchPromotionResponse extends Message<WatchPromotionResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionRequest
+ */
+export class AbortPromotionRequest extends Message<AbortPromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<AbortPromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined, b: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionResponse
+ */
+export class AbortPromotionResponse extends Message<AbortPromotionResponse> {
+  constructor(data?: PartialMessage<AbortPromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined, b: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.SetAutoPromotionForStageRequest
  */
@@ -2343,11 +2651,24 @@ export class CreateProjectResponse extends Message<CreateProjectResponse> {
 }
 
 /**
- * explicitly empty 
- *
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectsRequest
  */
 export class ListProjectsRequest extends Message<ListProjectsRequest> {
+  /**
+   * @generated from field: string label_selector = 1;
+   */
+  labelSelector = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
   constructor(data?: PartialMessage<ListProjectsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2356,6 +2677,9 @@ export class ListProjectsRequest extends Message<ListProjectsRequest> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListProjectsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectsRequest {
@@ -2384,6 +2708,11 @@ export class ListProjectsResponse extends Message<ListProjectsResponse> {
    */
   projects: Project[] = [];
 
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
   constructor(data?: PartialMessage<ListProjectsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2393,6 +2722,7 @@ export class ListProjectsResponse extends Message<ListProjectsResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListProjectsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "projects", kind: "message", T: Project, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectsResponse {
@@ -2483,464 +2813,1394 @@ export class DeleteProjectResponse extends Message<DeleteProjectResponse> {
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPipelineRequest
  */
-export class QueryFreightRequest extends Message<QueryFreightRequest> {
+export class GetPipelineRequest extends Message<GetPipelineRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
-  /**
-   * @generated from field: string stage = 2;
-   */
-  stage = "";
-
-  /**
-   * @generated from field: string group_by = 3;
-   */
-  groupBy = "";
-
-  /**
-   * @generated from field: string group = 4;
-   */
-  group = "";
-
-  /**
-   * @generated from field: string order_by = 5;
-   */
-  orderBy = "";
-
-  /**
-   * @generated from field: bool reverse = 6;
-   */
-  reverse = false;
-
-  constructor(data?: PartialMessage<QueryFreightRequest>) {
+  constructor(data?: PartialMessage<GetPipelineRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetPipelineRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "order_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "reverse", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightRequest {
-    return new QueryFreightRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined, b: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined): boolean {
-    return proto3.util.equals(QueryFreightRequest, a, b);
+  static equals(a: GetPipelineRequest | PlainMessage<GetPipelineRequest> | undefined, b: GetPipelineRequest | PlainMessage<GetPipelineRequest> | undefined): boolean {
+    return proto3.util.equals(GetPipelineRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPipelineResponse
  */
-export class QueryFreightResponse extends Message<QueryFreightResponse> {
+export class GetPipelineResponse extends Message<GetPipelineResponse> {
   /**
-   * @generated from field: map<string, akuity.io.kargo.service.v1alpha1.FreightList> groups = 1;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.PipelineNode nodes = 1;
    */
-  groups: { [key: string]: FreightList } = {};
+  nodes: PipelineNode[] = [];
 
-  constructor(data?: PartialMessage<QueryFreightResponse>) {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.PipelineEdge edges = 2;
+   */
+  edges: PipelineEdge[] = [];
+
+  constructor(data?: PartialMessage<GetPipelineResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetPipelineResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FreightList} },
+    { no: 1, name: "nodes", kind: "message", T: PipelineNode, repeated: true },
+    { no: 2, name: "edges", kind: "message", T: PipelineEdge, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightResponse {
-    return new QueryFreightResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined, b: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined): boolean {
-    return proto3.util.equals(QueryFreightResponse, a, b);
+  static equals(a: GetPipelineResponse | PlainMessage<GetPipelineResponse> | undefined, b: GetPipelineResponse | PlainMessage<GetPipelineResponse> | undefined): boolean {
+    return proto3.util.equals(GetPipelineResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteFreightRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.PipelineNode
  */
-export class DeleteFreightRequest extends Message<DeleteFreightRequest> {
+export class PipelineNode extends Message<PipelineNode> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: string kind = 1;
    */
-  project = "";
+  kind = "";
 
   /**
    * @generated from field: string name = 2;
    */
   name = "";
 
-  constructor(data?: PartialMessage<DeleteFreightRequest>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteFreightRequest";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteFreightRequest {
-    return new DeleteFreightRequest().fromBinary(bytes, options);
-  }
+  /**
+   * @generated from field: string current_freight = 3;
+   */
+  currentFreight = "";
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteFreightRequest {
-    return new DeleteFreightRequest().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: string current_freight_alias = 4;
+   */
+  currentFreightAlias = "";
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteFreightRequest {
-    return new DeleteFreightRequest().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: string health = 5;
+   */
+  health = "";
 
-  static equals(a: DeleteFreightRequest | PlainMessage<DeleteFreightRequest> | undefined, b: DeleteFreightRequest | PlainMessage<DeleteFreightRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteFreightRequest, a, b);
-  }
-}
+  /**
+   * @generated from field: string phase = 6;
+   */
+  phase = "";
 
-/**
- * explicitly empty 
- *
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteFreightResponse
- */
-export class DeleteFreightResponse extends Message<DeleteFreightResponse> {
-  constructor(data?: PartialMessage<DeleteFreightResponse>) {
+  constructor(data?: PartialMessage<PipelineNode>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteFreightResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PipelineNode";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "current_freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "current_freight_alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "health", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteFreightResponse {
-    return new DeleteFreightResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PipelineNode {
+    return new PipelineNode().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteFreightResponse {
-    return new DeleteFreightResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PipelineNode {
+    return new PipelineNode().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteFreightResponse {
-    return new DeleteFreightResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PipelineNode {
+    return new PipelineNode().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteFreightResponse | PlainMessage<DeleteFreightResponse> | undefined, b: DeleteFreightResponse | PlainMessage<DeleteFreightResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteFreightResponse, a, b);
+  static equals(a: PipelineNode | PlainMessage<PipelineNode> | undefined, b: PipelineNode | PlainMessage<PipelineNode> | undefined): boolean {
+    return proto3.util.equals(PipelineNode, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.FreightList
+ * @generated from message akuity.io.kargo.service.v1alpha1.PipelineEdge
  */
-export class FreightList extends Message<FreightList> {
+export class PipelineEdge extends Message<PipelineEdge> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   * @generated from field: string from_kind = 1;
    */
-  freight: Freight[] = [];
+  fromKind = "";
 
-  constructor(data?: PartialMessage<FreightList>) {
+  /**
+   * @generated from field: string from_name = 2;
+   */
+  fromName = "";
+
+  /**
+   * @generated from field: string to_kind = 3;
+   */
+  toKind = "";
+
+  /**
+   * @generated from field: string to_name = 4;
+   */
+  toName = "";
+
+  constructor(data?: PartialMessage<PipelineEdge>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FreightList";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PipelineEdge";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "freight", kind: "message", T: Freight, repeated: true },
+    { no: 1, name: "from_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightList {
-    return new FreightList().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PipelineEdge {
+    return new PipelineEdge().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightList {
-    return new FreightList().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PipelineEdge {
+    return new PipelineEdge().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightList {
-    return new FreightList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PipelineEdge {
+    return new PipelineEdge().fromJsonString(jsonString, options);
   }
 
-  static equals(a: FreightList | PlainMessage<FreightList> | undefined, b: FreightList | PlainMessage<FreightList> | undefined): boolean {
-    return proto3.util.equals(FreightList, a, b);
+  static equals(a: PipelineEdge | PlainMessage<PipelineEdge> | undefined, b: PipelineEdge | PlainMessage<PipelineEdge> | undefined): boolean {
+    return proto3.util.equals(PipelineEdge, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateFreightAliasRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExportProjectRequest
  */
-export class UpdateFreightAliasRequest extends Message<UpdateFreightAliasRequest> {
+export class ExportProjectRequest extends Message<ExportProjectRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
-   * @generated from field: string freight = 2;
+   * @generated from field: bool include_freight = 2;
    */
-  freight = "";
+  includeFreight = false;
 
   /**
-   * @generated from field: string alias = 3;
+   * @generated from field: bool include_credentials = 3;
    */
-  alias = "";
+  includeCredentials = false;
 
-  constructor(data?: PartialMessage<UpdateFreightAliasRequest>) {
+  constructor(data?: PartialMessage<ExportProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateFreightAliasRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExportProjectRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_freight", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "include_credentials", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFreightAliasRequest {
-    return new UpdateFreightAliasRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFreightAliasRequest {
-    return new UpdateFreightAliasRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFreightAliasRequest {
-    return new UpdateFreightAliasRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportProjectRequest {
+    return new ExportProjectRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateFreightAliasRequest | PlainMessage<UpdateFreightAliasRequest> | undefined, b: UpdateFreightAliasRequest | PlainMessage<UpdateFreightAliasRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateFreightAliasRequest, a, b);
+  static equals(a: ExportProjectRequest | PlainMessage<ExportProjectRequest> | undefined, b: ExportProjectRequest | PlainMessage<ExportProjectRequest> | undefined): boolean {
+    return proto3.util.equals(ExportProjectRequest, a, b);
   }
 }
 
 /**
- * explicitly empty 
- *
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateFreightAliasResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ExportProjectResponse
  */
-export class UpdateFreightAliasResponse extends Message<UpdateFreightAliasResponse> {
-  constructor(data?: PartialMessage<UpdateFreightAliasResponse>) {
+export class ExportProjectResponse extends Message<ExportProjectResponse> {
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.bytes manifest = 1;
+   */
+  manifest?: bytes;
+
+  constructor(data?: PartialMessage<ExportProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateFreightAliasResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ExportProjectResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "manifest", kind: "message", T: bytes },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFreightAliasResponse {
-    return new UpdateFreightAliasResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportProjectResponse {
+    return new ExportProjectResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFreightAliasResponse {
-    return new UpdateFreightAliasResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportProjectResponse {
+    return new ExportProjectResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFreightAliasResponse {
-    return new UpdateFreightAliasResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportProjectResponse {
+    return new ExportProjectResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateFreightAliasResponse | PlainMessage<UpdateFreightAliasResponse> | undefined, b: UpdateFreightAliasResponse | PlainMessage<UpdateFreightAliasResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateFreightAliasResponse, a, b);
+  static equals(a: ExportProjectResponse | PlainMessage<ExportProjectResponse> | undefined, b: ExportProjectResponse | PlainMessage<ExportProjectResponse> | undefined): boolean {
+    return proto3.util.equals(ExportProjectResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ImportProjectRequest
  */
-export class ListWarehousesRequest extends Message<ListWarehousesRequest> {
+export class ImportProjectRequest extends Message<ImportProjectRequest> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: akuity.io.kargo.service.v1alpha1.bytes manifest = 1;
+   */
+  manifest?: bytes;
+
+  /**
+   * @generated from field: string project = 2;
    */
   project = "";
 
-  constructor(data?: PartialMessage<ListWarehousesRequest>) {
+  constructor(data?: PartialMessage<ImportProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ImportProjectRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "manifest", kind: "message", T: bytes },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportProjectRequest {
+    return new ImportProjectRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportProjectRequest {
+    return new ImportProjectRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
-    return new ListWarehousesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportProjectRequest {
+    return new ImportProjectRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined, b: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined): boolean {
-    return proto3.util.equals(ListWarehousesRequest, a, b);
+  static equals(a: ImportProjectRequest | PlainMessage<ImportProjectRequest> | undefined, b: ImportProjectRequest | PlainMessage<ImportProjectRequest> | undefined): boolean {
+    return proto3.util.equals(ImportProjectRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ImportProjectResponse
  */
-export class ListWarehousesResponse extends Message<ListWarehousesResponse> {
+export class ImportProjectResponse extends Message<ImportProjectResponse> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouses = 1;
+   * @generated from field: string project = 1;
    */
-  warehouses: Warehouse[] = [];
+  project = "";
 
-  constructor(data?: PartialMessage<ListWarehousesResponse>) {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ImportedResource resources = 2;
+   */
+  resources: ImportedResource[] = [];
+
+  constructor(data?: PartialMessage<ImportProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ImportProjectResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouses", kind: "message", T: Warehouse, repeated: true },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resources", kind: "message", T: ImportedResource, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportProjectResponse {
+    return new ImportProjectResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportProjectResponse {
+    return new ImportProjectResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
-    return new ListWarehousesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportProjectResponse {
+    return new ImportProjectResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined, b: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined): boolean {
-    return proto3.util.equals(ListWarehousesResponse, a, b);
+  static equals(a: ImportProjectResponse | PlainMessage<ImportProjectResponse> | undefined, b: ImportProjectResponse | PlainMessage<ImportProjectResponse> | undefined): boolean {
+    return proto3.util.equals(ImportProjectResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ImportedResource
  */
-export class GetWarehouseRequest extends Message<GetWarehouseRequest> {
+export class ImportedResource extends Message<ImportedResource> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: string kind = 1;
    */
-  project = "";
+  kind = "";
 
   /**
    * @generated from field: string name = 2;
    */
   name = "";
 
-  constructor(data?: PartialMessage<GetWarehouseRequest>) {
+  /**
+   * @generated from field: string action = 3;
+   */
+  action = "";
+
+  constructor(data?: PartialMessage<ImportedResource>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ImportedResource";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportedResource {
+    return new ImportedResource().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportedResource {
+    return new ImportedResource().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
-    return new GetWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportedResource {
+    return new ImportedResource().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined, b: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(GetWarehouseRequest, a, b);
+  static equals(a: ImportedResource | PlainMessage<ImportedResource> | undefined, b: ImportedResource | PlainMessage<ImportedResource> | undefined): boolean {
+    return proto3.util.equals(ImportedResource, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
  */
-export class GetWarehouseResponse extends Message<GetWarehouseResponse> {
+export class QueryFreightRequest extends Message<QueryFreightRequest> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: string project = 1;
    */
-  warehouse?: Warehouse;
+  project = "";
 
-  constructor(data?: PartialMessage<GetWarehouseResponse>) {
+  /**
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string group_by = 3;
+   */
+  groupBy = "";
+
+  /**
+   * @generated from field: string group = 4;
+   */
+  group = "";
+
+  /**
+   * @generated from field: string order_by = 5;
+   */
+  orderBy = "";
+
+  /**
+   * @generated from field: bool reverse = 6;
+   */
+  reverse = false;
+
+  /**
+   * @generated from field: string label_selector = 7;
+   */
+  labelSelector = "";
+
+  constructor(data?: PartialMessage<QueryFreightRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "order_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "reverse", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
-    return new GetWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightRequest {
+    return new QueryFreightRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined, b: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(GetWarehouseResponse, a, b);
+  static equals(a: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined, b: QueryFreightRequest | PlainMessage<QueryFreightRequest> | undefined): boolean {
+    return proto3.util.equals(QueryFreightRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightResponse
  */
-export class WatchWarehousesRequest extends Message<WatchWarehousesRequest> {
+export class QueryFreightResponse extends Message<QueryFreightResponse> {
   /**
-   * @generated from field: string project = 1;
+   * @generated from field: map<string, akuity.io.kargo.service.v1alpha1.FreightList> groups = 1;
+   */
+  groups: { [key: string]: FreightList } = {};
+
+  constructor(data?: PartialMessage<QueryFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FreightList} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryFreightResponse {
+    return new QueryFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined, b: QueryFreightResponse | PlainMessage<QueryFreightResponse> | undefined): boolean {
+    return proto3.util.equals(QueryFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchFreightRequest
+ */
+export class WatchFreightRequest extends Message<WatchFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string warehouse = 2;
+   */
+  warehouse = "";
+
+  /**
+   * @generated from field: string stage = 3;
+   */
+  stage = "";
+
+  constructor(data?: PartialMessage<WatchFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchFreightRequest {
+    return new WatchFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchFreightRequest {
+    return new WatchFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchFreightRequest {
+    return new WatchFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchFreightRequest | PlainMessage<WatchFreightRequest> | undefined, b: WatchFreightRequest | PlainMessage<WatchFreightRequest> | undefined): boolean {
+    return proto3.util.equals(WatchFreightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchFreightResponse
+ */
+export class WatchFreightResponse extends Message<WatchFreightResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   */
+  freight?: Freight;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  constructor(data?: PartialMessage<WatchFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "message", T: Freight },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchFreightResponse {
+    return new WatchFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchFreightResponse {
+    return new WatchFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchFreightResponse {
+    return new WatchFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchFreightResponse | PlainMessage<WatchFreightResponse> | undefined, b: WatchFreightResponse | PlainMessage<WatchFreightResponse> | undefined): boolean {
+    return proto3.util.equals(WatchFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteFreightRequest
+ */
+export class DeleteFreightRequest extends Message<DeleteFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteFreightRequest {
+    return new DeleteFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteFreightRequest {
+    return new DeleteFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteFreightRequest {
+    return new DeleteFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteFreightRequest | PlainMessage<DeleteFreightRequest> | undefined, b: DeleteFreightRequest | PlainMessage<DeleteFreightRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteFreightRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteFreightResponse
+ */
+export class DeleteFreightResponse extends Message<DeleteFreightResponse> {
+  constructor(data?: PartialMessage<DeleteFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteFreightResponse {
+    return new DeleteFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteFreightResponse {
+    return new DeleteFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteFreightResponse {
+    return new DeleteFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteFreightResponse | PlainMessage<DeleteFreightResponse> | undefined, b: DeleteFreightResponse | PlainMessage<DeleteFreightResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.FreightList
+ */
+export class FreightList extends Message<FreightList> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   */
+  freight: Freight[] = [];
+
+  constructor(data?: PartialMessage<FreightList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.FreightList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "message", T: Freight, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightList {
+    return new FreightList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightList {
+    return new FreightList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightList {
+    return new FreightList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightList | PlainMessage<FreightList> | undefined, b: FreightList | PlainMessage<FreightList> | undefined): boolean {
+    return proto3.util.equals(FreightList, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateFreightAliasRequest
+ */
+export class UpdateFreightAliasRequest extends Message<UpdateFreightAliasRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: string alias = 3;
+   */
+  alias = "";
+
+  constructor(data?: PartialMessage<UpdateFreightAliasRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateFreightAliasRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFreightAliasRequest {
+    return new UpdateFreightAliasRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFreightAliasRequest {
+    return new UpdateFreightAliasRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFreightAliasRequest {
+    return new UpdateFreightAliasRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateFreightAliasRequest | PlainMessage<UpdateFreightAliasRequest> | undefined, b: UpdateFreightAliasRequest | PlainMessage<UpdateFreightAliasRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateFreightAliasRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateFreightAliasResponse
+ */
+export class UpdateFreightAliasResponse extends Message<UpdateFreightAliasResponse> {
+  constructor(data?: PartialMessage<UpdateFreightAliasResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateFreightAliasResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFreightAliasResponse {
+    return new UpdateFreightAliasResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFreightAliasResponse {
+    return new UpdateFreightAliasResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFreightAliasResponse {
+    return new UpdateFreightAliasResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateFreightAliasResponse | PlainMessage<UpdateFreightAliasResponse> | undefined, b: UpdateFreightAliasResponse | PlainMessage<UpdateFreightAliasResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateFreightAliasResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
+ */
+export class ListWarehousesRequest extends Message<ListWarehousesRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string label_selector = 2;
+   */
+  labelSelector = "";
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListWarehousesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesRequest {
+    return new ListWarehousesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined, b: ListWarehousesRequest | PlainMessage<ListWarehousesRequest> | undefined): boolean {
+    return proto3.util.equals(ListWarehousesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesResponse
+ */
+export class ListWarehousesResponse extends Message<ListWarehousesResponse> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouses = 1;
+   */
+  warehouses: Warehouse[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListWarehousesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListWarehousesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouses", kind: "message", T: Warehouse, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWarehousesResponse {
+    return new ListWarehousesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined, b: ListWarehousesResponse | PlainMessage<ListWarehousesResponse> | undefined): boolean {
+    return proto3.util.equals(ListWarehousesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseRequest
+ */
+export class GetWarehouseRequest extends Message<GetWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<GetWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseRequest {
+    return new GetWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined, b: GetWarehouseRequest | PlainMessage<GetWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(GetWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseResponse
+ */
+export class GetWarehouseResponse extends Message<GetWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<GetWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GetWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWarehouseResponse {
+    return new GetWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined, b: GetWarehouseResponse | PlainMessage<GetWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(GetWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest
+ */
+export class WatchWarehousesRequest extends Message<WatchWarehousesRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<WatchWarehousesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
+    return new WatchWarehousesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined, b: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined): boolean {
+    return proto3.util.equals(WatchWarehousesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse
+ */
+export class WatchWarehousesResponse extends Message<WatchWarehousesResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  constructor(data?: PartialMessage<WatchWarehousesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
+    return new WatchWarehousesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined, b: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined): boolean {
+    return proto3.util.equals(WatchWarehousesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec
+ */
+export class TypedWarehouseSpec extends Message<TypedWarehouseSpec> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec spec = 3;
+   */
+  spec?: WarehouseSpec;
+
+  constructor(data?: PartialMessage<TypedWarehouseSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "spec", kind: "message", T: WarehouseSpec },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
+    return new TypedWarehouseSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined, b: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined): boolean {
+    return proto3.util.equals(TypedWarehouseSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest
+ */
+export class CreateWarehouseRequest extends Message<CreateWarehouseRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest.warehouse
+   */
+  warehouse: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
+     */
+    value: TypedWarehouseSpec;
+    case: "typed";
+  } | {
+    /**
+     * @generated from field: string yaml = 2;
+     */
+    value: string;
+    case: "yaml";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<CreateWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
+    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
+    return new CreateWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined, b: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse
+ */
+export class CreateWarehouseResponse extends Message<CreateWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<CreateWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
+    return new CreateWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined, b: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest
+ */
+export class UpdateWarehouseRequest extends Message<UpdateWarehouseRequest> {
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest.warehouse
+   */
+  warehouse: {
+    /**
+     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
+     */
+    value: TypedWarehouseSpec;
+    case: "typed";
+  } | {
+    /**
+     * @generated from field: string yaml = 2;
+     */
+    value: string;
+    case: "yaml";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<UpdateWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
+    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
+    return new UpdateWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined, b: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse
+ */
+export class UpdateWarehouseResponse extends Message<UpdateWarehouseResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   */
+  warehouse?: Warehouse;
+
+  constructor(data?: PartialMessage<UpdateWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
+    return new UpdateWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined, b: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest
+ */
+export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteWarehouseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
+    return new DeleteWarehouseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined, b: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWarehouseRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse
+ */
+export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
+  constructor(data?: PartialMessage<DeleteWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
+    return new DeleteWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined, b: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest
+ */
+export class RefreshWarehouseRequest extends Message<RefreshWarehouseRequest> {
+  /**
+   * @generated from field: string project = 1;
    */
   project = "";
 
@@ -2949,536 +4209,848 @@ export class WatchWarehousesRequest extends Message<WatchWarehousesRequest> {
    */
   name = "";
 
-  constructor(data?: PartialMessage<WatchWarehousesRequest>) {
+  constructor(data?: PartialMessage<RefreshWarehouseRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesRequest {
-    return new WatchWarehousesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
+    return new RefreshWarehouseRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined, b: WatchWarehousesRequest | PlainMessage<WatchWarehousesRequest> | undefined): boolean {
-    return proto3.util.equals(WatchWarehousesRequest, a, b);
+  static equals(a: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined, b: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined): boolean {
+    return proto3.util.equals(RefreshWarehouseRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse
  */
-export class WatchWarehousesResponse extends Message<WatchWarehousesResponse> {
+export class RefreshWarehouseResponse extends Message<RefreshWarehouseResponse> {
   /**
    * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
    */
   warehouse?: Warehouse;
 
+  constructor(data?: PartialMessage<RefreshWarehouseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
+    return new RefreshWarehouseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined, b: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined): boolean {
+    return proto3.util.equals(RefreshWarehouseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightRequest
+ */
+export class ApproveFreightRequest extends Message<ApproveFreightRequest> {
   /**
-   * @generated from field: string type = 2;
+   * @generated from field: string project = 1;
    */
-  type = "";
+  project = "";
 
-  constructor(data?: PartialMessage<WatchWarehousesResponse>) {
+  /**
+   * @generated from field: string id = 2;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string stage = 3;
+   */
+  stage = "";
+
+  constructor(data?: PartialMessage<ApproveFreightRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
-    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchWarehousesResponse {
-    return new WatchWarehousesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
+    return new ApproveFreightRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined, b: WatchWarehousesResponse | PlainMessage<WatchWarehousesResponse> | undefined): boolean {
-    return proto3.util.equals(WatchWarehousesResponse, a, b);
+  static equals(a: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined, b: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveFreightRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightResponse
  */
-export class TypedWarehouseSpec extends Message<TypedWarehouseSpec> {
+export class ApproveFreightResponse extends Message<ApproveFreightResponse> {
+  constructor(data?: PartialMessage<ApproveFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
+    return new ApproveFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined, b: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DiffFreightRequest
+ */
+export class DiffFreightRequest extends Message<DiffFreightRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: string from = 2;
    */
-  name = "";
+  from = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec spec = 3;
+   * @generated from field: string to = 3;
    */
-  spec?: WarehouseSpec;
+  to = "";
 
-  constructor(data?: PartialMessage<TypedWarehouseSpec>) {
+  constructor(data?: PartialMessage<DiffFreightRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DiffFreightRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "spec", kind: "message", T: WarehouseSpec },
+    { no: 2, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TypedWarehouseSpec {
-    return new TypedWarehouseSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffFreightRequest {
+    return new DiffFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffFreightRequest | PlainMessage<DiffFreightRequest> | undefined, b: DiffFreightRequest | PlainMessage<DiffFreightRequest> | undefined): boolean {
+    return proto3.util.equals(DiffFreightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.DiffFreightResponse
+ */
+export class DiffFreightResponse extends Message<DiffFreightResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ImageDiff images = 1;
+   */
+  images: ImageDiff[] = [];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ChartDiff charts = 2;
+   */
+  charts: ChartDiff[] = [];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.GitCommitDiff commits = 3;
+   */
+  commits: GitCommitDiff[] = [];
+
+  constructor(data?: PartialMessage<DiffFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DiffFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "images", kind: "message", T: ImageDiff, repeated: true },
+    { no: 2, name: "charts", kind: "message", T: ChartDiff, repeated: true },
+    { no: 3, name: "commits", kind: "message", T: GitCommitDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffFreightResponse {
+    return new DiffFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffFreightResponse | PlainMessage<DiffFreightResponse> | undefined, b: DiffFreightResponse | PlainMessage<DiffFreightResponse> | undefined): boolean {
+    return proto3.util.equals(DiffFreightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ImageDiff
+ */
+export class ImageDiff extends Message<ImageDiff> {
+  /**
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string change = 2;
+   */
+  change = "";
+
+  /**
+   * @generated from field: string from_tag = 3;
+   */
+  fromTag = "";
+
+  /**
+   * @generated from field: string to_tag = 4;
+   */
+  toTag = "";
+
+  /**
+   * @generated from field: string from_digest = 5;
+   */
+  fromDigest = "";
+
+  /**
+   * @generated from field: string to_digest = 6;
+   */
+  toDigest = "";
+
+  /**
+   * @generated from field: string from_git_repo_url = 7;
+   */
+  fromGitRepoUrl = "";
+
+  /**
+   * @generated from field: string to_git_repo_url = 8;
+   */
+  toGitRepoUrl = "";
+
+  /**
+   * @generated from field: string source_diff_url = 9;
+   */
+  sourceDiffUrl = "";
+
+  constructor(data?: PartialMessage<ImageDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ImageDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "from_digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "to_digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "from_git_repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "to_git_repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "source_diff_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageDiff {
+    return new ImageDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageDiff {
+    return new ImageDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageDiff {
+    return new ImageDiff().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined, b: TypedWarehouseSpec | PlainMessage<TypedWarehouseSpec> | undefined): boolean {
-    return proto3.util.equals(TypedWarehouseSpec, a, b);
+  static equals(a: ImageDiff | PlainMessage<ImageDiff> | undefined, b: ImageDiff | PlainMessage<ImageDiff> | undefined): boolean {
+    return proto3.util.equals(ImageDiff, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ChartDiff
  */
-export class CreateWarehouseRequest extends Message<CreateWarehouseRequest> {
+export class ChartDiff extends Message<ChartDiff> {
   /**
-   * @generated from oneof akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest.warehouse
+   * @generated from field: string repo_url = 1;
    */
-  warehouse: {
-    /**
-     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
-     */
-    value: TypedWarehouseSpec;
-    case: "typed";
-  } | {
-    /**
-     * @generated from field: string yaml = 2;
-     */
-    value: string;
-    case: "yaml";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  repoUrl = "";
 
-  constructor(data?: PartialMessage<CreateWarehouseRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string change = 3;
+   */
+  change = "";
+
+  /**
+   * @generated from field: string from_version = 4;
+   */
+  fromVersion = "";
+
+  /**
+   * @generated from field: string to_version = 5;
+   */
+  toVersion = "";
+
+  constructor(data?: PartialMessage<ChartDiff>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ChartDiff";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
-    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "change", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "from_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "to_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartDiff {
+    return new ChartDiff().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartDiff {
+    return new ChartDiff().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseRequest {
-    return new CreateWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartDiff {
+    return new ChartDiff().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined, b: CreateWarehouseRequest | PlainMessage<CreateWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWarehouseRequest, a, b);
+  static equals(a: ChartDiff | PlainMessage<ChartDiff> | undefined, b: ChartDiff | PlainMessage<ChartDiff> | undefined): boolean {
+    return proto3.util.equals(ChartDiff, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.GitCommitDiff
  */
-export class CreateWarehouseResponse extends Message<CreateWarehouseResponse> {
+export class GitCommitDiff extends Message<GitCommitDiff> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: string repo_url = 1;
    */
-  warehouse?: Warehouse;
+  repoUrl = "";
 
-  constructor(data?: PartialMessage<CreateWarehouseResponse>) {
+  /**
+   * @generated from field: string change = 2;
+   */
+  change = "";
+
+  /**
+   * @generated from field: string from_id = 3;
+   */
+  fromId = "";
+
+  /**
+   * @generated from field: string to_id = 4;
+   */
+  toId = "";
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.CommitLogEntry log = 5;
+   */
+  log: CommitLogEntry[] = [];
+
+  constructor(data?: PartialMessage<GitCommitDiff>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GitCommitDiff";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "log", kind: "message", T: CommitLogEntry, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCommitDiff {
+    return new GitCommitDiff().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitCommitDiff {
+    return new GitCommitDiff().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWarehouseResponse {
-    return new CreateWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitCommitDiff {
+    return new GitCommitDiff().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined, b: CreateWarehouseResponse | PlainMessage<CreateWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWarehouseResponse, a, b);
+  static equals(a: GitCommitDiff | PlainMessage<GitCommitDiff> | undefined, b: GitCommitDiff | PlainMessage<GitCommitDiff> | undefined): boolean {
+    return proto3.util.equals(GitCommitDiff, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.CommitLogEntry
  */
-export class UpdateWarehouseRequest extends Message<UpdateWarehouseRequest> {
+export class CommitLogEntry extends Message<CommitLogEntry> {
   /**
-   * @generated from oneof akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest.warehouse
+   * @generated from field: string id = 1;
    */
-  warehouse: {
-    /**
-     * @generated from field: akuity.io.kargo.service.v1alpha1.TypedWarehouseSpec typed = 1;
-     */
-    value: TypedWarehouseSpec;
-    case: "typed";
-  } | {
-    /**
-     * @generated from field: string yaml = 2;
-     */
-    value: string;
-    case: "yaml";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  id = "";
 
-  constructor(data?: PartialMessage<UpdateWarehouseRequest>) {
+  /**
+   * @generated from field: string author = 2;
+   */
+  author = "";
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * @generated from field: repeated string issue_keys = 4;
+   */
+  issueKeys: string[] = [];
+
+  constructor(data?: PartialMessage<CommitLogEntry>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CommitLogEntry";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "typed", kind: "message", T: TypedWarehouseSpec, oneof: "warehouse" },
-    { no: 2, name: "yaml", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "warehouse" },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "author", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "issue_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CommitLogEntry {
+    return new CommitLogEntry().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CommitLogEntry {
+    return new CommitLogEntry().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseRequest {
-    return new UpdateWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CommitLogEntry {
+    return new CommitLogEntry().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined, b: UpdateWarehouseRequest | PlainMessage<UpdateWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateWarehouseRequest, a, b);
+  static equals(a: CommitLogEntry | PlainMessage<CommitLogEntry> | undefined, b: CommitLogEntry | PlainMessage<CommitLogEntry> | undefined): boolean {
+    return proto3.util.equals(CommitLogEntry, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.APIToken
  */
-export class UpdateWarehouseResponse extends Message<UpdateWarehouseResponse> {
+export class APIToken extends Message<APIToken> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: string id = 1;
    */
-  warehouse?: Warehouse;
+  id = "";
 
-  constructor(data?: PartialMessage<UpdateWarehouseResponse>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string project = 3;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string service_account = 4;
+   */
+  serviceAccount = "";
+
+  /**
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 6;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expiry_time = 7;
+   */
+  expiryTime?: Timestamp;
+
+  constructor(data?: PartialMessage<APIToken>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UpdateWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.APIToken";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "service_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "create_time", kind: "message", T: Timestamp },
+    { no: 7, name: "expiry_time", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIToken {
+    return new APIToken().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIToken {
+    return new APIToken().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWarehouseResponse {
-    return new UpdateWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIToken {
+    return new APIToken().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined, b: UpdateWarehouseResponse | PlainMessage<UpdateWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateWarehouseResponse, a, b);
+  static equals(a: APIToken | PlainMessage<APIToken> | undefined, b: APIToken | PlainMessage<APIToken> | undefined): boolean {
+    return proto3.util.equals(APIToken, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest
  */
-export class DeleteWarehouseRequest extends Message<DeleteWarehouseRequest> {
+export class CreateAPITokenRequest extends Message<CreateAPITokenRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: string service_account = 2;
+   */
+  serviceAccount = "";
+
+  /**
+   * @generated from field: string name = 3;
    */
   name = "";
 
-  constructor(data?: PartialMessage<DeleteWarehouseRequest>) {
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[] = [];
+
+  /**
+   * @generated from field: string ttl = 5;
+   */
+  ttl = "";
+
+  constructor(data?: PartialMessage<CreateAPITokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPITokenRequest {
+    return new CreateAPITokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPITokenRequest {
+    return new CreateAPITokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseRequest {
-    return new DeleteWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPITokenRequest {
+    return new CreateAPITokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined, b: DeleteWarehouseRequest | PlainMessage<DeleteWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteWarehouseRequest, a, b);
+  static equals(a: CreateAPITokenRequest | PlainMessage<CreateAPITokenRequest> | undefined, b: CreateAPITokenRequest | PlainMessage<CreateAPITokenRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAPITokenRequest, a, b);
   }
 }
 
 /**
- * explicitly empty 
- *
- * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse
  */
-export class DeleteWarehouseResponse extends Message<DeleteWarehouseResponse> {
-  constructor(data?: PartialMessage<DeleteWarehouseResponse>) {
+export class CreateAPITokenResponse extends Message<CreateAPITokenResponse> {
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.APIToken api_token = 1;
+   */
+  apiToken?: APIToken;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<CreateAPITokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.DeleteWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_token", kind: "message", T: APIToken },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPITokenResponse {
+    return new CreateAPITokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPITokenResponse {
+    return new CreateAPITokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWarehouseResponse {
-    return new DeleteWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPITokenResponse {
+    return new CreateAPITokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined, b: DeleteWarehouseResponse | PlainMessage<DeleteWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteWarehouseResponse, a, b);
+  static equals(a: CreateAPITokenResponse | PlainMessage<CreateAPITokenResponse> | undefined, b: CreateAPITokenResponse | PlainMessage<CreateAPITokenResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAPITokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListAPITokensRequest
  */
-export class RefreshWarehouseRequest extends Message<RefreshWarehouseRequest> {
+export class ListAPITokensRequest extends Message<ListAPITokensRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: string service_account = 2;
    */
-  name = "";
+  serviceAccount = "";
 
-  constructor(data?: PartialMessage<RefreshWarehouseRequest>) {
+  constructor(data?: PartialMessage<ListAPITokensRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListAPITokensRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPITokensRequest {
+    return new ListAPITokensRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPITokensRequest {
+    return new ListAPITokensRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseRequest {
-    return new RefreshWarehouseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPITokensRequest {
+    return new ListAPITokensRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined, b: RefreshWarehouseRequest | PlainMessage<RefreshWarehouseRequest> | undefined): boolean {
-    return proto3.util.equals(RefreshWarehouseRequest, a, b);
+  static equals(a: ListAPITokensRequest | PlainMessage<ListAPITokensRequest> | undefined, b: ListAPITokensRequest | PlainMessage<ListAPITokensRequest> | undefined): boolean {
+    return proto3.util.equals(ListAPITokensRequest, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListAPITokensResponse
  */
-export class RefreshWarehouseResponse extends Message<RefreshWarehouseResponse> {
+export class ListAPITokensResponse extends Message<ListAPITokensResponse> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse warehouse = 1;
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.APIToken api_tokens = 1;
    */
-  warehouse?: Warehouse;
+  apiTokens: APIToken[] = [];
 
-  constructor(data?: PartialMessage<RefreshWarehouseResponse>) {
+  constructor(data?: PartialMessage<ListAPITokensResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListAPITokensResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "warehouse", kind: "message", T: Warehouse },
+    { no: 1, name: "api_tokens", kind: "message", T: APIToken, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPITokensResponse {
+    return new ListAPITokensResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPITokensResponse {
+    return new ListAPITokensResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshWarehouseResponse {
-    return new RefreshWarehouseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPITokensResponse {
+    return new ListAPITokensResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined, b: RefreshWarehouseResponse | PlainMessage<RefreshWarehouseResponse> | undefined): boolean {
-    return proto3.util.equals(RefreshWarehouseResponse, a, b);
+  static equals(a: ListAPITokensResponse | PlainMessage<ListAPITokensResponse> | undefined, b: ListAPITokensResponse | PlainMessage<ListAPITokensResponse> | undefined): boolean {
+    return proto3.util.equals(ListAPITokensResponse, a, b);
   }
 }
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeAPITokenRequest
  */
-export class ApproveFreightRequest extends Message<ApproveFreightRequest> {
+export class RevokeAPITokenRequest extends Message<RevokeAPITokenRequest> {
   /**
    * @generated from field: string project = 1;
    */
   project = "";
 
   /**
-   * @generated from field: string id = 2;
+   * @generated from field: string service_account = 2;
    */
-  id = "";
+  serviceAccount = "";
 
   /**
-   * @generated from field: string stage = 3;
+   * @generated from field: string id = 3;
    */
-  stage = "";
+  id = "";
 
-  constructor(data?: PartialMessage<ApproveFreightRequest>) {
+  constructor(data?: PartialMessage<RevokeAPITokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightRequest";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RevokeAPITokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightRequest {
-    return new ApproveFreightRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeAPITokenRequest {
+    return new RevokeAPITokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
-    return new ApproveFreightRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeAPITokenRequest {
+    return new RevokeAPITokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightRequest {
-    return new ApproveFreightRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeAPITokenRequest {
+    return new RevokeAPITokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined, b: ApproveFreightRequest | PlainMessage<ApproveFreightRequest> | undefined): boolean {
-    return proto3.util.equals(ApproveFreightRequest, a, b);
+  static equals(a: RevokeAPITokenRequest | PlainMessage<RevokeAPITokenRequest> | undefined, b: RevokeAPITokenRequest | PlainMessage<RevokeAPITokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeAPITokenRequest, a, b);
   }
 }
 
 /**
- * explicitly empty 
- *
- * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeAPITokenResponse
  */
-export class ApproveFreightResponse extends Message<ApproveFreightResponse> {
-  constructor(data?: PartialMessage<ApproveFreightResponse>) {
+export class RevokeAPITokenResponse extends Message<RevokeAPITokenResponse> {
+  constructor(data?: PartialMessage<RevokeAPITokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApproveFreightResponse";
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RevokeAPITokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightResponse {
-    return new ApproveFreightResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeAPITokenResponse {
+    return new RevokeAPITokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
-    return new ApproveFreightResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeAPITokenResponse {
+    return new RevokeAPITokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFreightResponse {
-    return new ApproveFreightResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeAPITokenResponse {
+    return new RevokeAPITokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined, b: ApproveFreightResponse | PlainMessage<ApproveFreightResponse> | undefined): boolean {
-    return proto3.util.equals(ApproveFreightResponse, a, b);
+  static equals(a: RevokeAPITokenResponse | PlainMessage<RevokeAPITokenResponse> | undefined, b: RevokeAPITokenResponse | PlainMessage<RevokeAPITokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeAPITokenResponse, a, b);
   }
 }
 
@@ -26,6 +26,11 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
    */
   sourceUpdates: ArgoCDSourceUpdate[] = [];
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncOperation sync_operation = 4;
+   */
+  syncOperation?: ArgoCDSyncOperation;
+
   constructor(data?: PartialMessage<ArgoCDAppUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -37,6 +42,7 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
     { no: 1, name: "app_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "app_namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "source_updates", kind: "message", T: ArgoCDSourceUpdate, repeated: true },
+    { no: 4, name: "sync_operation", kind: "message", T: ArgoCDSyncOperation, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppUpdate {
@@ -283,6 +289,116 @@ export class ArgoCDSourceUpdate extends Message<ArgoCDSourceUpdate> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncOperation
+ */
+export class ArgoCDSyncOperation extends Message<ArgoCDSyncOperation> {
+  /**
+   * @generated from field: bool prune = 1;
+   */
+  prune = false;
+
+  /**
+   * @generated from field: bool force = 2;
+   */
+  force = false;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncResource resources = 3;
+   */
+  resources: ArgoCDSyncResource[] = [];
+
+  /**
+   * @generated from field: repeated string sync_options = 4;
+   */
+  syncOptions: string[] = [];
+
+  constructor(data?: PartialMessage<ArgoCDSyncOperation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncOperation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "prune", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "resources", kind: "message", T: ArgoCDSyncResource, repeated: true },
+    { no: 4, name: "sync_options", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDSyncOperation {
+    return new ArgoCDSyncOperation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDSyncOperation {
+    return new ArgoCDSyncOperation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDSyncOperation {
+    return new ArgoCDSyncOperation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDSyncOperation | PlainMessage<ArgoCDSyncOperation> | undefined, b: ArgoCDSyncOperation | PlainMessage<ArgoCDSyncOperation> | undefined): boolean {
+    return proto3.util.equals(ArgoCDSyncOperation, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncResource
+ */
+export class ArgoCDSyncResource extends Message<ArgoCDSyncResource> {
+  /**
+   * @generated from field: optional string group = 1;
+   */
+  group?: string;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional string namespace = 4;
+   */
+  namespace?: string;
+
+  constructor(data?: PartialMessage<ArgoCDSyncResource>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSyncResource";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDSyncResource {
+    return new ArgoCDSyncResource().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDSyncResource {
+    return new ArgoCDSyncResource().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDSyncResource {
+    return new ArgoCDSyncResource().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDSyncResource | PlainMessage<ArgoCDSyncResource> | undefined, b: ArgoCDSyncResource | PlainMessage<ArgoCDSyncResource> | undefined): boolean {
+    return proto3.util.equals(ArgoCDSyncResource, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KargoRenderPromotionMechanism
  */
@@ -644,6 +760,16 @@ export class Health extends Message<Health> {
    */
   argocdApps: ArgoCDAppState[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadStatus workloads = 4;
+   */
+  workloads: WorkloadStatus[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus flux_resources = 5;
+   */
+  fluxResources: FluxResourceStatus[] = [];
+
   constructor(data?: PartialMessage<Health>) {
     super();
     proto3.util.initPartial(data, this);
@@ -655,6 +781,8 @@ export class Health extends Message<Health> {
     { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "argocd_apps", jsonName: "argoCDApps", kind: "message", T: ArgoCDAppState, repeated: true },
+    { no: 4, name: "workloads", kind: "message", T: WorkloadStatus, repeated: true },
+    { no: 5, name: "flux_resources", kind: "message", T: FluxResourceStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Health {
@@ -674,6 +802,134 @@ export class Health extends Message<Health> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus
+ */
+export class FluxResourceStatus extends Message<FluxResourceStatus> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string namespace = 2;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string status = 4;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string revision = 5;
+   */
+  revision = "";
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<FluxResourceStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "revision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxResourceStatus | PlainMessage<FluxResourceStatus> | undefined, b: FluxResourceStatus | PlainMessage<FluxResourceStatus> | undefined): boolean {
+    return proto3.util.equals(FluxResourceStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadStatus
+ */
+export class WorkloadStatus extends Message<WorkloadStatus> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string namespace = 2;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string status = 4;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<WorkloadStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkloadStatus {
+    return new WorkloadStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkloadStatus {
+    return new WorkloadStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkloadStatus {
+    return new WorkloadStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkloadStatus | PlainMessage<WorkloadStatus> | undefined, b: WorkloadStatus | PlainMessage<WorkloadStatus> | undefined): boolean {
+    return proto3.util.equals(WorkloadStatus, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
  */
@@ -1497,6 +1753,11 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
    */
   argocdAppUpdates: ArgoCDAppUpdate[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate flux_updates = 3;
+   */
+  fluxUpdates: FluxUpdate[] = [];
+
   constructor(data?: PartialMessage<PromotionMechanisms>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1507,6 +1768,7 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "git_repo_updates", kind: "message", T: GitRepoUpdate, repeated: true },
     { no: 2, name: "argocd_app_updates", jsonName: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 3, name: "flux_updates", kind: "message", T: FluxUpdate, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
@@ -1527,339 +1789,1178 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate
  */
-export class PromotionPolicy extends Message<PromotionPolicy> {
+export class FluxUpdate extends Message<FluxUpdate> {
   /**
-   * @generated from field: string stage = 4;
+   * @generated from field: string kind = 1;
    */
-  stage = "";
+  kind = "";
 
   /**
-   * @generated from field: bool auto_promotion_enabled = 5;
+   * @generated from field: string namespace = 2;
    */
-  autoPromotionEnabled = false;
+  namespace = "";
 
-  constructor(data?: PartialMessage<PromotionPolicy>) {
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate helm_release = 4;
+   */
+  helmRelease?: FluxHelmReleaseUpdate;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxOCIRepositoryUpdate oci_repository = 5;
+   */
+  ociRepository?: FluxOCIRepositoryUpdate;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate kustomization = 6;
+   */
+  kustomization?: FluxKustomizationUpdate;
+
+  constructor(data?: PartialMessage<FluxUpdate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "auto_promotion_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "helm_release", kind: "message", T: FluxHelmReleaseUpdate, opt: true },
+    { no: 5, name: "oci_repository", kind: "message", T: FluxOCIRepositoryUpdate, opt: true },
+    { no: 6, name: "kustomization", kind: "message", T: FluxKustomizationUpdate, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
-    return proto3.util.equals(PromotionPolicy, a, b);
+  static equals(a: FluxUpdate | PlainMessage<FluxUpdate> | undefined, b: FluxUpdate | PlainMessage<FluxUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxUpdate, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate
  */
-export class PromotionSpec extends Message<PromotionSpec> {
+export class FluxHelmReleaseUpdate extends Message<FluxHelmReleaseUpdate> {
   /**
-   * @generated from field: string stage = 1;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmChartUpdate chart = 1;
    */
-  stage = "";
+  chart?: FluxHelmChartUpdate;
 
   /**
-   * @generated from field: string freight = 2;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate images = 2;
    */
-  freight = "";
+  images: FluxHelmImageUpdate[] = [];
 
-  constructor(data?: PartialMessage<PromotionSpec>) {
+  constructor(data?: PartialMessage<FluxHelmReleaseUpdate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "chart", kind: "message", T: FluxHelmChartUpdate, opt: true },
+    { no: 2, name: "images", kind: "message", T: FluxHelmImageUpdate, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
-    return proto3.util.equals(PromotionSpec, a, b);
+  static equals(a: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined, b: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxHelmReleaseUpdate, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmChartUpdate
  */
-export class PromotionStatus extends Message<PromotionStatus> {
-  /**
-   * @generated from field: string phase = 1;
-   */
-  phase = "";
-
+export class FluxHelmChartUpdate extends Message<FluxHelmChartUpdate> {
   /**
-   * @generated from field: string message = 2;
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
    */
-  message = "";
+  repoUrl = "";
 
   /**
-   * @generated from field: map<string, string> metadata = 3;
+   * @generated from field: string name = 2;
    */
-  metadata: { [key: string]: string } = {};
+  name = "";
 
-  constructor(data?: PartialMessage<PromotionStatus>) {
+  constructor(data?: PartialMessage<FluxHelmChartUpdate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmChartUpdate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmChartUpdate {
+    return new FluxHelmChartUpdate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmChartUpdate {
+    return new FluxHelmChartUpdate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmChartUpdate {
+    return new FluxHelmChartUpdate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
-    return proto3.util.equals(PromotionStatus, a, b);
+  static equals(a: FluxHelmChartUpdate | PlainMessage<FluxHelmChartUpdate> | undefined, b: FluxHelmChartUpdate | PlainMessage<FluxHelmChartUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxHelmChartUpdate, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate
  */
-export class RepoSubscription extends Message<RepoSubscription> {
+export class FluxHelmImageUpdate extends Message<FluxHelmImageUpdate> {
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   * @generated from field: string image = 1;
    */
-  git?: GitSubscription;
+  image = "";
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   * @generated from field: string key = 2;
    */
-  image?: ImageSubscription;
+  key = "";
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   * @generated from field: string value = 3;
    */
-  chart?: ChartSubscription;
+  value = "";
+
+  constructor(data?: PartialMessage<FluxHelmImageUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxHelmImageUpdate | PlainMessage<FluxHelmImageUpdate> | undefined, b: FluxHelmImageUpdate | PlainMessage<FluxHelmImageUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxHelmImageUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxOCIRepositoryUpdate
+ */
+export class FluxOCIRepositoryUpdate extends Message<FluxOCIRepositoryUpdate> {
+  /**
+   * @generated from field: bool use_digest = 1;
+   */
+  useDigest = false;
+
+  constructor(data?: PartialMessage<FluxOCIRepositoryUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxOCIRepositoryUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "use_digest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxOCIRepositoryUpdate {
+    return new FluxOCIRepositoryUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxOCIRepositoryUpdate {
+    return new FluxOCIRepositoryUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxOCIRepositoryUpdate {
+    return new FluxOCIRepositoryUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxOCIRepositoryUpdate | PlainMessage<FluxOCIRepositoryUpdate> | undefined, b: FluxOCIRepositoryUpdate | PlainMessage<FluxOCIRepositoryUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxOCIRepositoryUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate
+ */
+export class FluxKustomizationUpdate extends Message<FluxKustomizationUpdate> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizeImageUpdate images = 1;
+   */
+  images: FluxKustomizeImageUpdate[] = [];
+
+  constructor(data?: PartialMessage<FluxKustomizationUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "images", kind: "message", T: FluxKustomizeImageUpdate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxKustomizationUpdate | PlainMessage<FluxKustomizationUpdate> | undefined, b: FluxKustomizationUpdate | PlainMessage<FluxKustomizationUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxKustomizationUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizeImageUpdate
+ */
+export class FluxKustomizeImageUpdate extends Message<FluxKustomizeImageUpdate> {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image = "";
+
+  /**
+   * @generated from field: bool use_digest = 2;
+   */
+  useDigest = false;
+
+  constructor(data?: PartialMessage<FluxKustomizeImageUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizeImageUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "use_digest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxKustomizeImageUpdate {
+    return new FluxKustomizeImageUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxKustomizeImageUpdate {
+    return new FluxKustomizeImageUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxKustomizeImageUpdate {
+    return new FluxKustomizeImageUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxKustomizeImageUpdate | PlainMessage<FluxKustomizeImageUpdate> | undefined, b: FluxKustomizeImageUpdate | PlainMessage<FluxKustomizeImageUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxKustomizeImageUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ */
+export class PromotionPolicy extends Message<PromotionPolicy> {
+  /**
+   * @generated from field: string stage = 4;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: bool auto_promotion_enabled = 5;
+   */
+  autoPromotionEnabled = false;
+
+  constructor(data?: PartialMessage<PromotionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "auto_promotion_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
+    return proto3.util.equals(PromotionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ */
+export class PromotionSpec extends Message<PromotionSpec> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight = "";
+
+  constructor(data?: PartialMessage<PromotionSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
+    return proto3.util.equals(PromotionSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ */
+export class PromotionStatus extends Message<PromotionStatus> {
+  /**
+   * @generated from field: string phase = 1;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * @generated from field: map<string, string> metadata = 3;
+   */
+  metadata: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt attempts = 5;
+   */
+  attempts: PromotionAttempt[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookStatus hooks = 6;
+   */
+  hooks: PromotionHookStatus[] = [];
+
+  constructor(data?: PartialMessage<PromotionStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "started_at", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "attempts", kind: "message", T: PromotionAttempt, repeated: true },
+    { no: 6, name: "hooks", kind: "message", T: PromotionHookStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
+    return proto3.util.equals(PromotionStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookStatus
+ */
+export class PromotionHookStatus extends Message<PromotionHookStatus> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string job_name = 3;
+   */
+  jobName = "";
+
+  /**
+   * @generated from field: string phase = 4;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<PromotionHookStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "job_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHookStatus {
+    return new PromotionHookStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHookStatus {
+    return new PromotionHookStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHookStatus {
+    return new PromotionHookStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionHookStatus | PlainMessage<PromotionHookStatus> | undefined, b: PromotionHookStatus | PlainMessage<PromotionHookStatus> | undefined): boolean {
+    return proto3.util.equals(PromotionHookStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt
+ */
+export class PromotionAttempt extends Message<PromotionAttempt> {
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 1;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<PromotionAttempt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "finished_at", kind: "message", T: Timestamp },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionAttempt | PlainMessage<PromotionAttempt> | undefined, b: PromotionAttempt | PlainMessage<PromotionAttempt> | undefined): boolean {
+    return proto3.util.equals(PromotionAttempt, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+ */
+export class RepoSubscription extends Message<RepoSubscription> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription git = 1;
+   */
+  git?: GitSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription image = 2;
+   */
+  image?: ImageSubscription;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription chart = 3;
+   */
+  chart?: ChartSubscription;
+
+  constructor(data?: PartialMessage<RepoSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
+    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
+    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
+    return new RepoSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
+    return proto3.util.equals(RepoSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ */
+export class Stage extends Message<Stage> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   */
+  spec?: StageSpec;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   */
+  status?: StageStatus;
+
+  constructor(data?: PartialMessage<Stage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
+    { no: 4, name: "spec", kind: "message", T: StageSpec },
+    { no: 5, name: "status", kind: "message", T: StageStatus },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
+    return new Stage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
+    return new Stage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Stage {
+    return new Stage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Stage | PlainMessage<Stage> | undefined, b: Stage | PlainMessage<Stage> | undefined): boolean {
+    return proto3.util.equals(Stage, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageList
+ */
+export class StageList extends Message<StageList> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Stage items = 2;
+   */
+  items: Stage[] = [];
+
+  constructor(data?: PartialMessage<StageList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ListMeta },
+    { no: 2, name: "items", kind: "message", T: Stage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageList {
+    return new StageList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageList {
+    return new StageList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageList {
+    return new StageList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
+    return proto3.util.equals(StageList, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+ */
+export class StageSpec extends Message<StageSpec> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions subscriptions = 1;
+   */
+  subscriptions?: Subscriptions;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms promotion_mechanisms = 2;
+   */
+  promotionMechanisms?: PromotionMechanisms;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Verification verification = 3;
+   */
+  verification?: Verification;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.StagePromotionPolicy promotion_policy = 4;
+   */
+  promotionPolicy?: StagePromotionPolicy;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy approval_policy = 5;
+   */
+  approvalPolicy?: ApprovalPolicy;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.StageLock lock = 6;
+   */
+  lock?: StageLock;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks health_checks = 7;
+   */
+  healthChecks?: HealthChecks;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHooks hooks = 8;
+   */
+  hooks?: PromotionHooks;
+
+  constructor(data?: PartialMessage<StageSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
+    { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
+    { no: 3, name: "verification", kind: "message", T: Verification, opt: true },
+    { no: 4, name: "promotion_policy", kind: "message", T: StagePromotionPolicy, opt: true },
+    { no: 5, name: "approval_policy", kind: "message", T: ApprovalPolicy, opt: true },
+    { no: 6, name: "lock", kind: "message", T: StageLock, opt: true },
+    { no: 7, name: "health_checks", kind: "message", T: HealthChecks, opt: true },
+    { no: 8, name: "hooks", kind: "message", T: PromotionHooks, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
+    return new StageSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageSpec {
+    return new StageSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageSpec {
+    return new StageSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageSpec | PlainMessage<StageSpec> | undefined, b: StageSpec | PlainMessage<StageSpec> | undefined): boolean {
+    return proto3.util.equals(StageSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHooks
+ */
+export class PromotionHooks extends Message<PromotionHooks> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook pre_promotion = 1;
+   */
+  prePromotion: PromotionHook[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook post_promotion = 2;
+   */
+  postPromotion: PromotionHook[] = [];
+
+  constructor(data?: PartialMessage<PromotionHooks>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHooks";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pre_promotion", kind: "message", T: PromotionHook, repeated: true },
+    { no: 2, name: "post_promotion", kind: "message", T: PromotionHook, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHooks {
+    return new PromotionHooks().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHooks {
+    return new PromotionHooks().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHooks {
+    return new PromotionHooks().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionHooks | PlainMessage<PromotionHooks> | undefined, b: PromotionHooks | PlainMessage<PromotionHooks> | undefined): boolean {
+    return proto3.util.equals(PromotionHooks, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
+ */
+export class PromotionHook extends Message<PromotionHook> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.bytes job_template = 2;
+   */
+  jobTemplate?: bytes;
 
-  constructor(data?: PartialMessage<RepoSubscription>) {
+  constructor(data?: PartialMessage<PromotionHook>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
-    { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
-    { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_template", kind: "message", T: bytes },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHook {
+    return new PromotionHook().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoSubscription {
-    return new RepoSubscription().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RepoSubscription | PlainMessage<RepoSubscription> | undefined, b: RepoSubscription | PlainMessage<RepoSubscription> | undefined): boolean {
-    return proto3.util.equals(RepoSubscription, a, b);
+  static equals(a: PromotionHook | PlainMessage<PromotionHook> | undefined, b: PromotionHook | PlainMessage<PromotionHook> | undefined): boolean {
+    return proto3.util.equals(PromotionHook, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks
  */
-export class Stage extends Message<Stage> {
+export class HealthChecks extends Message<HealthChecks> {
   /**
-   * @generated from field: string api_version = 1;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadHealthCheck workloads = 1;
    */
-  apiVersion = "";
+  workloads: WorkloadHealthCheck[] = [];
+
+  constructor(data?: PartialMessage<HealthChecks>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HealthChecks";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workloads", kind: "message", T: WorkloadHealthCheck, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthChecks {
+    return new HealthChecks().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: HealthChecks | PlainMessage<HealthChecks> | undefined, b: HealthChecks | PlainMessage<HealthChecks> | undefined): boolean {
+    return proto3.util.equals(HealthChecks, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadHealthCheck
+ */
+export class WorkloadHealthCheck extends Message<WorkloadHealthCheck> {
   /**
-   * @generated from field: string kind = 2;
+   * @generated from field: string kind = 1;
    */
   kind = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   * @generated from field: string namespace = 2;
    */
-  metadata?: ObjectMeta;
+  namespace = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec spec = 4;
+   * @generated from field: string name = 3;
    */
-  spec?: StageSpec;
+  name = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus status = 5;
+   * @generated from field: map<string, string> selector = 4;
    */
-  status?: StageStatus;
+  selector: { [key: string]: string } = {};
 
-  constructor(data?: PartialMessage<Stage>) {
+  constructor(data?: PartialMessage<WorkloadHealthCheck>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Stage";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.WorkloadHealthCheck";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
-    { no: 4, name: "spec", kind: "message", T: StageSpec },
-    { no: 5, name: "status", kind: "message", T: StageStatus },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Stage {
-    return new Stage().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkloadHealthCheck {
+    return new WorkloadHealthCheck().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Stage {
-    return new Stage().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkloadHealthCheck {
+    return new WorkloadHealthCheck().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Stage {
-    return new Stage().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkloadHealthCheck {
+    return new WorkloadHealthCheck().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Stage | PlainMessage<Stage> | undefined, b: Stage | PlainMessage<Stage> | undefined): boolean {
-    return proto3.util.equals(Stage, a, b);
+  static equals(a: WorkloadHealthCheck | PlainMessage<WorkloadHealthCheck> | undefined, b: WorkloadHealthCheck | PlainMessage<WorkloadHealthCheck> | undefined): boolean {
+    return proto3.util.equals(WorkloadHealthCheck, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageList
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageLock
  */
-export class StageList extends Message<StageList> {
+export class StageLock extends Message<StageLock> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   * @generated from field: string locked_by = 1;
    */
-  metadata?: ListMeta;
+  lockedBy = "";
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Stage items = 2;
+   * @generated from field: optional google.protobuf.Timestamp locked_at = 2;
    */
-  items: Stage[] = [];
+  lockedAt?: Timestamp;
 
-  constructor(data?: PartialMessage<StageList>) {
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+
+  constructor(data?: PartialMessage<StageLock>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageList";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageLock";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "metadata", kind: "message", T: ListMeta },
-    { no: 2, name: "items", kind: "message", T: Stage, repeated: true },
+    { no: 1, name: "locked_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "locked_at", kind: "message", T: Timestamp, opt: true },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "until", kind: "message", T: Timestamp, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageList {
-    return new StageList().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageLock {
+    return new StageLock().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageList {
-    return new StageList().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageList {
-    return new StageList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
-    return proto3.util.equals(StageList, a, b);
+  static equals(a: StageLock | PlainMessage<StageLock> | undefined, b: StageLock | PlainMessage<StageLock> | undefined): boolean {
+    return proto3.util.equals(StageLock, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy
  */
-export class StageSpec extends Message<StageSpec> {
+export class ApprovalPolicy extends Message<ApprovalPolicy> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions subscriptions = 1;
+   * @generated from field: int32 required_approvals = 1;
    */
-  subscriptions?: Subscriptions;
+  requiredApprovals = 0;
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms promotion_mechanisms = 2;
+   * @generated from field: repeated string allowed_groups = 2;
    */
-  promotionMechanisms?: PromotionMechanisms;
+  allowedGroups: string[] = [];
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Verification verification = 3;
+   * @generated from field: repeated string allowed_emails = 3;
    */
-  verification?: Verification;
+  allowedEmails: string[] = [];
 
-  constructor(data?: PartialMessage<StageSpec>) {
+  constructor(data?: PartialMessage<ApprovalPolicy>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
-    { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
-    { no: 3, name: "verification", kind: "message", T: Verification, opt: true },
+    { no: 1, name: "required_approvals", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "allowed_groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "allowed_emails", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
-    return new StageSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageSpec {
-    return new StageSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageSpec {
-    return new StageSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StageSpec | PlainMessage<StageSpec> | undefined, b: StageSpec | PlainMessage<StageSpec> | undefined): boolean {
-    return proto3.util.equals(StageSpec, a, b);
+  static equals(a: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined, b: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined): boolean {
+    return proto3.util.equals(ApprovalPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StagePromotionPolicy
+ */
+export class StagePromotionPolicy extends Message<StagePromotionPolicy> {
+  /**
+   * @generated from field: optional string timeout = 1;
+   */
+  timeout?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy retry = 2;
+   */
+  retry?: PromotionRetryPolicy;
+
+  constructor(data?: PartialMessage<StagePromotionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StagePromotionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "retry", kind: "message", T: PromotionRetryPolicy, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StagePromotionPolicy {
+    return new StagePromotionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StagePromotionPolicy {
+    return new StagePromotionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StagePromotionPolicy {
+    return new StagePromotionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StagePromotionPolicy | PlainMessage<StagePromotionPolicy> | undefined, b: StagePromotionPolicy | PlainMessage<StagePromotionPolicy> | undefined): boolean {
+    return proto3.util.equals(StagePromotionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy
+ */
+export class PromotionRetryPolicy extends Message<PromotionRetryPolicy> {
+  /**
+   * @generated from field: int32 limit = 1;
+   */
+  limit = 0;
+
+  /**
+   * @generated from field: optional string backoff = 2;
+   */
+  backoff?: string;
+
+  constructor(data?: PartialMessage<PromotionRetryPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "backoff", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionRetryPolicy | PlainMessage<PromotionRetryPolicy> | undefined, b: PromotionRetryPolicy | PlainMessage<PromotionRetryPolicy> | undefined): boolean {
+    return proto3.util.equals(PromotionRetryPolicy, a, b);
   }
 }
 
@@ -1956,6 +3057,11 @@ export class FreightStatus extends Message<FreightStatus> {
    */
   approvedFor: { [key: string]: ApprovedStage } = {};
 
+  /**
+   * @generated from field: map<string, github.com.akuity.kargo.pkg.api.v1alpha1.PendingApproval> pending_approval_for = 3;
+   */
+  pendingApprovalFor: { [key: string]: PendingApproval } = {};
+
   constructor(data?: PartialMessage<FreightStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1966,6 +3072,7 @@ export class FreightStatus extends Message<FreightStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "verified_in", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VerifiedStage} },
     { no: 2, name: "approved_for", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ApprovedStage} },
+    { no: 3, name: "pending_approval_for", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: PendingApproval} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightStatus {
@@ -1989,6 +3096,11 @@ export class FreightStatus extends Message<FreightStatus> {
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
  */
 export class VerifiedStage extends Message<VerifiedStage> {
+  /**
+   * @generated from field: optional google.protobuf.Timestamp verified_at = 1;
+   */
+  verifiedAt?: Timestamp;
+
   constructor(data?: PartialMessage<VerifiedStage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1997,6 +3109,7 @@ export class VerifiedStage extends Message<VerifiedStage> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verified_at", kind: "message", T: Timestamp, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifiedStage {
@@ -2020,6 +3133,16 @@ export class VerifiedStage extends Message<VerifiedStage> {
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
  */
 export class ApprovedStage extends Message<ApprovedStage> {
+  /**
+   * @generated from field: optional google.protobuf.Timestamp approved_at = 1;
+   */
+  approvedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Approval approvals = 2;
+   */
+  approvals: Approval[] = [];
+
   constructor(data?: PartialMessage<ApprovedStage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2028,6 +3151,8 @@ export class ApprovedStage extends Message<ApprovedStage> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approved_at", kind: "message", T: Timestamp, opt: true },
+    { no: 2, name: "approvals", kind: "message", T: Approval, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovedStage {
@@ -2047,6 +3172,86 @@ export class ApprovedStage extends Message<ApprovedStage> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PendingApproval
+ */
+export class PendingApproval extends Message<PendingApproval> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Approval approvals = 1;
+   */
+  approvals: Approval[] = [];
+
+  constructor(data?: PartialMessage<PendingApproval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PendingApproval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approvals", kind: "message", T: Approval, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PendingApproval {
+    return new PendingApproval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PendingApproval {
+    return new PendingApproval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PendingApproval {
+    return new PendingApproval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PendingApproval | PlainMessage<PendingApproval> | undefined, b: PendingApproval | PlainMessage<PendingApproval> | undefined): boolean {
+    return proto3.util.equals(PendingApproval, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Approval
+ */
+export class Approval extends Message<Approval> {
+  /**
+   * @generated from field: string approver = 1;
+   */
+  approver = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp approved_at = 2;
+   */
+  approvedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Approval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Approval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "approved_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Approval {
+    return new Approval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Approval | PlainMessage<Approval> | undefined, b: Approval | PlainMessage<Approval> | undefined): boolean {
+    return proto3.util.equals(Approval, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
  */
@@ -2081,6 +3286,11 @@ export class FreightReference extends Message<FreightReference> {
    */
   verificationInfo?: VerificationInfo;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FreightConstituent constituents = 8;
+   */
+  constituents: FreightConstituent[] = [];
+
   constructor(data?: PartialMessage<FreightReference>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2095,6 +3305,7 @@ export class FreightReference extends Message<FreightReference> {
     { no: 5, name: "images", kind: "message", T: Image, repeated: true },
     { no: 6, name: "charts", kind: "message", T: Chart, repeated: true },
     { no: 7, name: "verification_info", kind: "message", T: VerificationInfo, opt: true },
+    { no: 8, name: "constituents", kind: "message", T: FreightConstituent, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightReference {
@@ -2114,6 +3325,49 @@ export class FreightReference extends Message<FreightReference> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightConstituent
+ */
+export class FreightConstituent extends Message<FreightConstituent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string warehouse = 2;
+   */
+  warehouse = "";
+
+  constructor(data?: PartialMessage<FreightConstituent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightConstituent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightConstituent {
+    return new FreightConstituent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightConstituent {
+    return new FreightConstituent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightConstituent {
+    return new FreightConstituent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightConstituent | PlainMessage<FreightConstituent> | undefined, b: FreightConstituent | PlainMessage<FreightConstituent> | undefined): boolean {
+    return proto3.util.equals(FreightConstituent, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus
  */
@@ -2190,6 +3444,11 @@ export class StageSubscription extends Message<StageSubscription> {
    */
   name = "";
 
+  /**
+   * @generated from field: optional string min_soak_duration = 2;
+   */
+  minSoakDuration?: string;
+
   constructor(data?: PartialMessage<StageSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2199,6 +3458,7 @@ export class StageSubscription extends Message<StageSubscription> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_soak_duration", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSubscription {
@@ -2232,6 +3492,16 @@ export class Subscriptions extends Message<Subscriptions> {
    */
   warehouse = "";
 
+  /**
+   * @generated from field: string upstream_stages_mode = 4;
+   */
+  upstreamStagesMode = "";
+
+  /**
+   * @generated from field: repeated string warehouses = 5;
+   */
+  warehouses: string[] = [];
+
   constructor(data?: PartialMessage<Subscriptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2242,6 +3512,8 @@ export class Subscriptions extends Message<Subscriptions> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "upstream_stages", kind: "message", T: StageSubscription, repeated: true },
     { no: 3, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "upstream_stages_mode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "warehouses", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscriptions {